@@ -0,0 +1,82 @@
+package main
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/nanovms/ops/lepton"
+)
+
+// lepton selects the kernel, boot image and packages for a build through the
+// process global lepton.AltGOARCH, while Pulumi calls Create concurrently.
+// archGuard lets operations for the same architecture run concurrently, an
+// operation for another architecture waits until all of them are done.
+type archGuard struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	arch    string
+	saved   string
+	holders int
+}
+
+var altGOARCH = newArchGuard()
+
+func newArchGuard() *archGuard {
+	g := &archGuard{}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+// use sets lepton.AltGOARCH for arch until the returned function is called and
+// then restores the previous value. An empty arch builds for the host.
+func (g *archGuard) use(arch string) (release func()) {
+	arch = altGOARCHFor(arch)
+	g.mu.Lock()
+	for g.holders > 0 && g.arch != arch {
+		g.cond.Wait()
+	}
+	if g.holders == 0 {
+		g.saved = lepton.AltGOARCH
+		g.arch = arch
+		lepton.AltGOARCH = arch
+	}
+	g.holders++
+	g.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.mu.Lock()
+			g.holders--
+			if g.holders == 0 {
+				lepton.AltGOARCH = g.saved
+				g.cond.Broadcast()
+			}
+			g.mu.Unlock()
+		})
+	}
+}
+
+// goarch returns the Go name, arm64 or amd64, of arch, which is named like in
+// Go (arm64), in elf binaries (aarch64, x86_64) or in ops (arm). An empty arch
+// is the host architecture.
+func goarch(arch string) string {
+	switch {
+	case arch == "":
+		return runtime.GOARCH
+	case strings.Contains(arch, "arm") || arch == "aarch64":
+		return "arm64"
+	default:
+		return "amd64"
+	}
+}
+
+// altGOARCHFor returns the lepton.AltGOARCH to build and run for arch, "" for
+// the host architecture.
+func altGOARCHFor(arch string) string {
+	if arch := goarch(arch); arch != runtime.GOARCH {
+		return arch
+	}
+	return ""
+}
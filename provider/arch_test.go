@@ -0,0 +1,186 @@
+package main
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestArchGuard(t *testing.T) {
+	previous := lepton.AltGOARCH
+	g := newArchGuard()
+	other, otherAlias := "arm64", "arm"
+	if runtime.GOARCH == "arm64" {
+		other, otherAlias = "amd64", "x86_64"
+	}
+
+	release := g.use(other)
+	// Operations for the same architecture share it, whatever it is called.
+	releaseSame := g.use(otherAlias)
+	if lepton.AltGOARCH != other {
+		t.Fatalf("AltGOARCH is %q while using %s", lepton.AltGOARCH, other)
+	}
+
+	acquired := make(chan string)
+	go func() {
+		defer g.use(runtime.GOARCH)()
+		acquired <- lepton.AltGOARCH
+	}()
+	select {
+	case <-acquired:
+		t.Fatalf("the host architecture is used while %s is in use", other)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+	releaseSame()
+	select {
+	case got := <-acquired:
+		if got != "" {
+			t.Fatalf("AltGOARCH is %q while using the host architecture", got)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the host architecture is not used after %s is released", other)
+	}
+
+	// The host architecture is the same as none.
+	releaseHost := g.use(runtime.GOARCH)
+	released := make(chan struct{})
+	go func() {
+		defer g.use("")()
+		close(released)
+	}()
+	select {
+	case <-released:
+	case <-time.After(5 * time.Second):
+		t.Fatal("building for the host waits for building for the host architecture")
+	}
+	releaseHost()
+
+	defer g.use("")()
+	if lepton.AltGOARCH != previous {
+		t.Fatalf("AltGOARCH is %q after the builds, want %q", lepton.AltGOARCH, previous)
+	}
+}
+
+func TestAltGOARCHFor(t *testing.T) {
+	want := map[string]string{"amd64": "amd64", "arm64": "arm64"}
+	want[runtime.GOARCH] = ""
+	for arch, goarch := range map[string]string{
+		"amd64":   "amd64",
+		"x86_64":  "amd64",
+		"arm64":   "arm64",
+		"arm":     "arm64",
+		"aarch64": "arm64",
+	} {
+		if got := altGOARCHFor(arch); got != want[goarch] {
+			t.Errorf("AltGOARCH for %s is %q, want %q", arch, got, want[goarch])
+		}
+	}
+	if got := altGOARCHFor(""); got != "" {
+		t.Errorf("AltGOARCH for the host is %q, want \"\"", got)
+	}
+}
+
+// TestArchConcurrentBuilds builds images and package images for both
+// architectures into two ops homes concurrently, each build must see its own
+// architecture, ops home and kernel.
+func TestArchConcurrentBuilds(t *testing.T) {
+	cloud := useFakeCloud(t)
+	cloud.delay = 2 * time.Millisecond
+	server := newTestServer(t, p.ConfigureRequest{Args: property.NewMap(map[string]property.Value{
+		"kernelVersion": property.New("0.1.54"),
+	})})
+
+	homes := []string{newOpsHome(t), newOpsHome(t)}
+	elfs := map[string]string{
+		"amd64": filepath.Join(t.TempDir(), "app-amd64"),
+		"arm":   filepath.Join(t.TempDir(), "app-arm64"),
+	}
+	writeElf(t, elfs["amd64"], elf.EM_X86_64)
+	writeElf(t, elfs["arm"], elf.EM_AARCH64)
+	pkg := t.TempDir()
+	if err := os.WriteFile(filepath.Join(pkg, "package.manifest"), []byte(`{"Program":"app"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	type build struct {
+		home, arch string
+		token      string
+		inputs     map[string]property.Value
+	}
+	builds := map[string]build{}
+	for i, home := range homes {
+		for arch := range elfs {
+			for j := range 5 {
+				name := fmt.Sprintf("app-%d-%s-%d", i, arch, j)
+				builds[name] = build{home, arch, "nanovms:index:Image", map[string]property.Value{
+					"name":     property.New(name),
+					"elf":      property.New(elfs[arch]),
+					"provider": property.New("gcp"),
+					"opsHome":  property.New(home),
+				}}
+				name = fmt.Sprintf("pkg-%d-%s-%d", i, arch, j)
+				builds[name] = build{home, arch, "nanovms:index:PackageImage", map[string]property.Value{
+					"name":         property.New(name),
+					"packagePath":  property.New(pkg),
+					"architecture": property.New(goarch(arch)),
+					"provider":     property.New("gcp"),
+					"opsHome":      property.New(home),
+				}}
+			}
+		}
+	}
+
+	var wg sync.WaitGroup
+	for name, b := range builds {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			urn := resource.CreateURN(name, b.token, "", "project", "stack")
+			_, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(b.inputs)})
+			if err != nil {
+				t.Errorf("failed to build %s: %v", name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(cloud.observed) != len(builds) {
+		t.Errorf("observed %d builds, want %d", len(cloud.observed), len(builds))
+	}
+	for _, o := range cloud.observed {
+		b := builds[o.name]
+		wantAltGOARCH := map[string]string{"amd64": "amd64", "arm": "arm64"}[b.arch]
+		if wantAltGOARCH == runtime.GOARCH {
+			wantAltGOARCH = ""
+		}
+		if o.altGOARCH != wantAltGOARCH {
+			t.Errorf("%s built with AltGOARCH %q, want %q", o.name, o.altGOARCH, wantAltGOARCH)
+		}
+		if want := filepath.Join(b.home, ".ops"); o.opsHome != want {
+			t.Errorf("%s built with ops home %v, want %v", o.name, o.opsHome, want)
+		}
+		release := "0.1.54"
+		if b.arch == "arm" {
+			release += "-arm"
+		}
+		config := cloud.builds[o.name]
+		if kernel, want := config.Kernel, filepath.Join(b.home, ".ops", release, "kernel.img"); kernel != want {
+			t.Errorf("%s built with kernel %v, want %v", o.name, kernel, want)
+		}
+		if boot, want := config.Boot, filepath.Join(b.home, ".ops", release, "boot.img"); boot != want {
+			t.Errorf("%s built with boot image %v, want %v", o.name, boot, want)
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// The types below mirror the nanovms ops types.Config structure so it can be
+// exposed in the schema. The json tags match the ops field names (encoding/json
+// matches them case-insensitively), which allows merging the typed configuration
+// into a types.Config by (un)marshalling. They use the same name as the pulumi
+// tags, as the encoder of infer also reads json tags and would otherwise add a
+// property without a name.
+
+type OpsConfig struct {
+	Args                      []string          `pulumi:"args,optional" json:"args,omitempty"`
+	BaseVolumeSz              string            `pulumi:"baseVolumeSz,optional" json:"baseVolumeSz,omitempty"`
+	Boot                      string            `pulumi:"boot,optional" json:"boot,omitempty"`
+	Uefi                      bool              `pulumi:"uefi,optional" json:"uefi,omitempty"`
+	CloudConfig               *OpsCloudConfig   `pulumi:"cloudConfig,optional" json:"cloudConfig,omitempty"`
+	TargetConfig              map[string]string `pulumi:"targetConfig,optional" json:"targetConfig,omitempty"`
+	Debugflags                []string          `pulumi:"debugflags,optional" json:"debugflags,omitempty"`
+	Dirs                      []string          `pulumi:"dirs,optional" json:"dirs,omitempty"`
+	Env                       map[string]string `pulumi:"env,optional" json:"env,omitempty"`
+	Files                     []string          `pulumi:"files,optional" json:"files,omitempty"`
+	Kernel                    string            `pulumi:"kernel,optional" json:"kernel,omitempty"`
+	KlibDir                   string            `pulumi:"klibDir,optional" json:"klibDir,omitempty"`
+	Klibs                     []string          `pulumi:"klibs,optional" json:"klibs,omitempty"`
+	MapDirs                   map[string]string `pulumi:"mapDirs,optional" json:"mapDirs,omitempty"`
+	Mounts                    map[string]string `pulumi:"mounts,optional" json:"mounts,omitempty"`
+	NameServers               []string          `pulumi:"nameServers,optional" json:"nameServers,omitempty"`
+	NanosVersion              string            `pulumi:"nanosVersion,optional" json:"nanosVersion,omitempty"`
+	NightlyBuild              bool              `pulumi:"nightlyBuild,optional" json:"nightlyBuild,omitempty"`
+	NoTrace                   []string          `pulumi:"noTrace,optional" json:"noTrace,omitempty"`
+	ManifestPassthrough       map[string]any    `pulumi:"manifestPassthrough,optional" json:"manifestPassthrough,omitempty"`
+	RebootOnExit              bool              `pulumi:"rebootOnExit,optional" json:"rebootOnExit,omitempty"`
+	RunConfig                 *OpsRunConfig     `pulumi:"runConfig,optional" json:"runConfig,omitempty"`
+	LocalFilesParentDirectory string            `pulumi:"localFilesParentDirectory,optional" json:"localFilesParentDirectory,omitempty"`
+	TargetRoot                string            `pulumi:"targetRoot,optional" json:"targetRoot,omitempty"`
+	TFSv4                     bool              `pulumi:"tfsv4,optional" json:"tfsv4,omitempty"`
+	Version                   string            `pulumi:"version,optional" json:"version,omitempty"`
+	Language                  string            `pulumi:"language,optional" json:"language,omitempty"`
+	Description               string            `pulumi:"description,optional" json:"description,omitempty"`
+}
+
+func (c *OpsConfig) Annotate(a infer.Annotator) {
+	a.Describe(&c, "The nanovms ops configuration, mirrors the ops JSON configuration file")
+	a.Describe(&c.Args, "The arguments passed to the program when the image is launched")
+	a.Describe(&c.BaseVolumeSz, "The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS")
+	a.Describe(&c.Boot, "The path to the boot image")
+	a.Describe(&c.Uefi, "If the image should support booting via UEFI")
+	a.Describe(&c.CloudConfig, "The cloud provider specific configuration")
+	a.Describe(&c.TargetConfig, "Configuration specific to the target provider")
+	a.Describe(&c.Debugflags, "The kernel debug flags")
+	a.Describe(&c.Dirs, "Local directories to include into the image")
+	a.Describe(&c.Env, "Environment variables for the image runtime")
+	a.Describe(&c.Files, "Local files to include into the image")
+	a.Describe(&c.Kernel, "The path to the kernel image")
+	a.Describe(&c.KlibDir, "The host location of the klibs")
+	a.Describe(&c.Klibs, "The klibs to include into the image (e.g. 'tls', 'ntp')")
+	a.Describe(&c.MapDirs, "Local directories (keys) to map to a path in the image (values)")
+	a.Describe(&c.Mounts, "Volumes (keys) to mount at a path in the image (values)")
+	a.Describe(&c.NameServers, "The DNS servers to use, defaults to '8.8.8.8'")
+	a.Describe(&c.NanosVersion, "The nanos kernel version")
+	a.Describe(&c.NightlyBuild, "If the nightly kernel build should be used")
+	a.Describe(&c.NoTrace, "Syscalls to exclude from tracing")
+	a.Describe(&c.ManifestPassthrough, "Options passed straight through to the image manifest")
+	a.Describe(&c.RebootOnExit, "If the image should reboot automatically when an error occurs")
+	a.Describe(&c.RunConfig, "The runtime configuration")
+	a.Describe(&c.LocalFilesParentDirectory, "The parent directory of the files and directories specified in files and dirs")
+	a.Describe(&c.TargetRoot, "The target root filesystem")
+	a.Describe(&c.TFSv4, "If the deprecated TFS version 4 encoding should be used")
+	a.Describe(&c.Version, "The version of the image")
+	a.Describe(&c.Language, "The language of the program")
+	a.Describe(&c.Description, "The description of the image")
+}
+
+type OpsCloudConfig struct {
+	BucketName       string          `pulumi:"bucketName,optional" json:"bucketName,omitempty"`
+	BucketNamespace  string          `pulumi:"bucketNamespace,optional" json:"bucketNamespace,omitempty"`
+	ConfidentialVM   bool            `pulumi:"confidentialVM,optional" json:"confidentialVM,omitempty"`
+	DedicatedHostID  string          `pulumi:"dedicatedHostID,optional" json:"dedicatedHostID,omitempty"`
+	DomainName       string          `pulumi:"domainName,optional" json:"domainName,omitempty"`
+	StaticIP         string          `pulumi:"staticIP,optional" json:"staticIP,omitempty"`
+	EnableIPv6       bool            `pulumi:"enableIPv6,optional" json:"enableIPv6,omitempty"`
+	Flavor           string          `pulumi:"flavor,optional" json:"flavor,omitempty"`
+	ImageType        string          `pulumi:"imageType,optional" json:"imageType,omitempty"`
+	InstanceProfile  string          `pulumi:"instanceProfile,optional" json:"instanceProfile,omitempty"`
+	KMS              string          `pulumi:"kms,optional" json:"kms,omitempty"`
+	Platform         string          `pulumi:"platform,optional" json:"platform,omitempty"`
+	ProjectID        string          `pulumi:"projectID,optional" json:"projectID,omitempty"`
+	RootVolume       *OpsCloudVolume `pulumi:"rootVolume,optional" json:"rootVolume,omitempty"`
+	SecurityGroup    string          `pulumi:"securityGroup,optional" json:"securityGroup,omitempty"`
+	SkipImportVerify bool            `pulumi:"skipImportVerify,optional" json:"skipImportVerify,omitempty"`
+	Spot             bool            `pulumi:"spot,optional" json:"spot,omitempty"`
+	Subnet           string          `pulumi:"subnet,optional" json:"subnet,omitempty"`
+	Tags             []OpsTag        `pulumi:"tags,optional" json:"tags,omitempty"`
+	UserData         string          `pulumi:"userData,optional" json:"userData,omitempty"`
+	VPC              string          `pulumi:"vpc,optional" json:"vpc,omitempty"`
+	Zone             string          `pulumi:"zone,optional" json:"zone,omitempty"`
+}
+
+func (c *OpsCloudConfig) Annotate(a infer.Annotator) {
+	a.Describe(&c, "The cloud provider specific configuration")
+	a.Describe(&c.BucketName, "The bucket to store the image artifacts in")
+	a.Describe(&c.BucketNamespace, "The bucket namespace, required for oci")
+	a.Describe(&c.ConfidentialVM, "If confidential computing should be enabled")
+	a.Describe(&c.DedicatedHostID, "The ID of the dedicated host to run on")
+	a.Describe(&c.DomainName, "The domain name to create a DNS record for")
+	a.Describe(&c.StaticIP, "The static public IP to assign")
+	a.Describe(&c.EnableIPv6, "If IPv6 should be enabled when creating a VPC")
+	a.Describe(&c.Flavor, "The instance flavor or machine type")
+	a.Describe(&c.ImageType, "The image type")
+	a.Describe(&c.InstanceProfile, "The IAM instance profile (aws)")
+	a.Describe(&c.KMS, "The KMS key to encrypt images with, 'default' or an arn (aws)")
+	a.Describe(&c.Platform, "The cloud platform")
+	a.Describe(&c.ProjectID, "The project ID (gcp)")
+	a.Describe(&c.RootVolume, "Settings for the root volume")
+	a.Describe(&c.SecurityGroup, "The security group")
+	a.Describe(&c.SkipImportVerify, "Skip verifying that a vm importer role exists (aws)")
+	a.Describe(&c.Spot, "If spot provisioning should be used")
+	a.Describe(&c.Subnet, "The subnet")
+	a.Describe(&c.Tags, "Tags (labels) for images and instances")
+	a.Describe(&c.UserData, "User data passed to the instance")
+	a.Describe(&c.VPC, "The VPC")
+	a.Describe(&c.Zone, "The zone or region")
+}
+
+type OpsCloudVolume struct {
+	Name       string `pulumi:"name,optional" json:"name"`
+	Iops       int64  `pulumi:"iops,optional" json:"iops"`
+	Size       int64  `pulumi:"size,optional" json:"size"`
+	Throughput int64  `pulumi:"throughput,optional" json:"throughput"`
+	Typeof     string `pulumi:"typeof,optional" json:"typeof"`
+}
+
+func (v *OpsCloudVolume) Annotate(a infer.Annotator) {
+	a.Describe(&v, "Cloud volume settings")
+	a.Describe(&v.Name, "The name of the volume")
+	a.Describe(&v.Iops, "The provisioned IOPS")
+	a.Describe(&v.Size, "The size of the volume in GB")
+	a.Describe(&v.Throughput, "The provisioned throughput")
+	a.Describe(&v.Typeof, "The volume type")
+}
+
+type OpsTag struct {
+	Key              string `pulumi:"key" json:"key"`
+	Value            string `pulumi:"value" json:"value"`
+	ImageLabel       *bool  `pulumi:"imageLabel,optional" json:"-"`
+	InstanceLabel    *bool  `pulumi:"instanceLabel,optional" json:"-"`
+	InstanceNetwork  *bool  `pulumi:"instanceNetwork,optional" json:"-"`
+	InstanceMetadata *bool  `pulumi:"instanceMetadata,optional" json:"-"`
+}
+
+func (t *OpsTag) Annotate(a infer.Annotator) {
+	a.Describe(&t, "A tag (label) for images and instances")
+	a.Describe(&t.Key, "The tag key")
+	a.Describe(&t.Value, "The tag value")
+	a.Describe(&t.ImageLabel, "If the tag should be used as an image label")
+	a.Describe(&t.InstanceLabel, "If the tag should be used as an instance label")
+	a.Describe(&t.InstanceNetwork, "If the tag value should be used as an instance network tag")
+	a.Describe(&t.InstanceMetadata, "If the tag should be used as instance metadata")
+}
+
+func (t OpsTag) MarshalJSON() ([]byte, error) {
+	tag := types.Tag{Key: t.Key, Value: t.Value}
+	if t.ImageLabel != nil || t.InstanceLabel != nil || t.InstanceNetwork != nil || t.InstanceMetadata != nil {
+		tag.Attribute = &types.TagAttribute{
+			ImageLabel:       t.ImageLabel,
+			InstanceLabel:    t.InstanceLabel,
+			InstanceNetwork:  t.InstanceNetwork,
+			InstanceMetadata: t.InstanceMetadata,
+		}
+	}
+	return json.Marshal(tag)
+}
+
+type OpsRunConfig struct {
+	Accel                        bool     `pulumi:"accel,optional" json:"accel,omitempty"`
+	AtExit                       string   `pulumi:"atExit,optional" json:"atExit,omitempty"`
+	Bridged                      bool     `pulumi:"bridged,optional" json:"bridged,omitempty"`
+	BridgeIPAddress              string   `pulumi:"bridgeIPAddress,optional" json:"bridgeIPAddress,omitempty"`
+	BridgeName                   string   `pulumi:"bridgeName,optional" json:"bridgeName,omitempty"`
+	CanIPForward                 bool     `pulumi:"canIPForward,optional" json:"canIPForward,omitempty"`
+	CPUs                         int      `pulumi:"cpus,optional" json:"cpus,omitempty"`
+	GPUs                         int      `pulumi:"gpus,optional" json:"gpus,omitempty"`
+	GPUType                      string   `pulumi:"gpuType,optional" json:"gpuType,omitempty"`
+	Debug                        bool     `pulumi:"debug,optional" json:"debug,omitempty"`
+	Gateway                      string   `pulumi:"gateway,optional" json:"gateway,omitempty"`
+	GdbPort                      int      `pulumi:"gdbPort,optional" json:"gdbPort,omitempty"`
+	InstanceGroup                string   `pulumi:"instanceGroup,optional" json:"instanceGroup,omitempty"`
+	InstanceName                 string   `pulumi:"instanceName,optional" json:"instanceName,omitempty"`
+	IPAddress                    string   `pulumi:"ipAddress,optional" json:"ipAddress,omitempty"`
+	IPv6Address                  string   `pulumi:"ipv6Address,optional" json:"ipv6Address,omitempty"`
+	Memory                       string   `pulumi:"memory,optional" json:"memory,omitempty"`
+	Mgmt                         string   `pulumi:"mgmt,optional" json:"mgmt,omitempty"`
+	Vga                          bool     `pulumi:"vga,optional" json:"vga,omitempty"`
+	Mounts                       []string `pulumi:"mounts,optional" json:"mounts,omitempty"`
+	AttachVolumeOnInstanceCreate bool     `pulumi:"attachVolumeOnInstanceCreate,optional" json:"attachVolumeOnInstanceCreate,omitempty"`
+	NetMask                      string   `pulumi:"netMask,optional" json:"netMask,omitempty"`
+	Nics                         []OpsNic `pulumi:"nics,optional" json:"nics,omitempty"`
+	Ports                        []string `pulumi:"ports,optional" json:"ports,omitempty"`
+	QMP                          bool     `pulumi:"qmp,optional" json:"qmp,omitempty"`
+	ShowDebug                    bool     `pulumi:"showDebug,optional" json:"showDebug,omitempty"`
+	ShowErrors                   bool     `pulumi:"showErrors,optional" json:"showErrors,omitempty"`
+	ShowWarnings                 bool     `pulumi:"showWarnings,optional" json:"showWarnings,omitempty"`
+	TapName                      string   `pulumi:"tapName,optional" json:"tapName,omitempty"`
+	UDPPorts                     []string `pulumi:"udpPorts,optional" json:"udpPorts,omitempty"`
+	Verbose                      bool     `pulumi:"verbose,optional" json:"verbose,omitempty"`
+	VolumeSizeInGb               int      `pulumi:"volumeSizeInGb,optional" json:"volumeSizeInGb,omitempty"`
+	ThreadsPerCore               int64    `pulumi:"threadsPerCore,optional" json:"threadsPerCore,omitempty"`
+}
+
+func (c *OpsRunConfig) Annotate(a infer.Annotator) {
+	a.Describe(&c, "The runtime configuration")
+	a.Describe(&c.Accel, "If hardware acceleration should be enabled")
+	a.Describe(&c.AtExit, "A hook to run after the instance stops")
+	a.Describe(&c.Bridged, "If bridged networking should be used")
+	a.Describe(&c.BridgeIPAddress, "The IP address of the bridge")
+	a.Describe(&c.BridgeName, "The name of the bridge")
+	a.Describe(&c.CanIPForward, "If IP forwarding should be enabled (gcp)")
+	a.Describe(&c.CPUs, "The number of CPU cores")
+	a.Describe(&c.GPUs, "The number of GPUs")
+	a.Describe(&c.GPUType, "The GPU type")
+	a.Describe(&c.Debug, "If debugging should be enabled")
+	a.Describe(&c.Gateway, "The gateway IP address")
+	a.Describe(&c.GdbPort, "The port for the gdb server")
+	a.Describe(&c.InstanceGroup, "The instance group")
+	a.Describe(&c.InstanceName, "The name of the instance")
+	a.Describe(&c.IPAddress, "The static IP address")
+	a.Describe(&c.IPv6Address, "The static IPv6 address")
+	a.Describe(&c.Memory, "The amount of memory, optionally suffixed with 'M' or 'G'")
+	a.Describe(&c.Mgmt, "The management port for QMP access (onprem)")
+	a.Describe(&c.Vga, "If a VGA output device should be emulated")
+	a.Describe(&c.Mounts, "Volumes to mount, in the form '<volume>:<path>'")
+	a.Describe(&c.AttachVolumeOnInstanceCreate, "If the volumes in mounts should be attached when the instance is created")
+	a.Describe(&c.NetMask, "The network mask")
+	a.Describe(&c.Nics, "Pre-configured network cards (proxmox)")
+	a.Describe(&c.Ports, "The TCP ports to expose")
+	a.Describe(&c.QMP, "If the QMP interface should be enabled (onprem)")
+	a.Describe(&c.ShowDebug, "If debug messages should be shown")
+	a.Describe(&c.ShowErrors, "If errors should be shown")
+	a.Describe(&c.ShowWarnings, "If warnings should be shown")
+	a.Describe(&c.TapName, "The name of the tap device")
+	a.Describe(&c.UDPPorts, "The UDP ports to expose")
+	a.Describe(&c.Verbose, "If verbose logging should be enabled")
+	a.Describe(&c.VolumeSizeInGb, "The volume size in GB (openstack)")
+	a.Describe(&c.ThreadsPerCore, "The number of threads per physical core")
+}
+
+type OpsNic struct {
+	IPAddress   string `pulumi:"ipAddress,optional" json:"ipAddress,omitempty"`
+	IPv6Address string `pulumi:"ipv6Address,optional" json:"ipv6Address,omitempty"`
+	NetMask     string `pulumi:"netMask,optional" json:"netMask,omitempty"`
+	Gateway     string `pulumi:"gateway,optional" json:"gateway,omitempty"`
+	BridgeName  string `pulumi:"bridgeName,optional" json:"bridgeName,omitempty"`
+}
+
+func (n *OpsNic) Annotate(a infer.Annotator) {
+	a.Describe(&n, "A network card configuration")
+	a.Describe(&n.IPAddress, "The IP address")
+	a.Describe(&n.IPv6Address, "The IPv6 address")
+	a.Describe(&n.NetMask, "The network mask")
+	a.Describe(&n.Gateway, "The gateway IP address")
+	a.Describe(&n.BridgeName, "The name of the bridge")
+}
+
+// mergeConfig merges the typed configuration into config and then merges the
+// (deprecated) JSON encoded string configuration on top of that.
+func mergeConfig(ctx context.Context, config *types.Config, typed *OpsConfig, raw string) error {
+	if defaults := infer.GetConfig[Config](ctx).CloudConfig; defaults != nil {
+		b, err := json.Marshal(defaults)
+		if err != nil {
+			return fmt.Errorf("cannot marshal provider cloudConfig: %w", err)
+		}
+		if err := json.Unmarshal(b, &config.CloudConfig); err != nil {
+			return fmt.Errorf("cannot unmarshal provider cloudConfig: %w", err)
+		}
+	}
+	if typed == nil && raw == "" {
+		p.GetLogger(ctx).Debug("no config provided, using defaults")
+		return nil
+	}
+	if typed != nil {
+		b, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Errorf("cannot marshal opsConfig: %w", err)
+		}
+		if err := json.Unmarshal(b, config); err != nil {
+			return fmt.Errorf("cannot unmarshal opsConfig: %w", err)
+		}
+	}
+	if raw != "" {
+		if err := json.Unmarshal([]byte(raw), config); err != nil {
+			return fmt.Errorf("cannot unmarshal config: %w", err)
+		}
+	}
+	return nil
+}
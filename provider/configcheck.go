@@ -0,0 +1,472 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// configIssue is a problem in a configuration. field is the path of Go field
+// names in types.Config, which are the same in OpsConfig, and index selects an
+// element of the last field (e.g. "[0]").
+type configIssue struct {
+	field  []string
+	index  string
+	reason string
+}
+
+// kernelSelection describes the nanos release an image is built with, to find
+// the klibs it provides.
+type kernelSelection struct {
+	version string
+	arch    string
+	opsHome string
+	// latest is set if the latest release is used, which may not be
+	// downloaded yet.
+	latest bool
+}
+
+// checkConfig validates the typed opsConfig and the JSON encoded config of
+// inputs: unknown fields, values ops would reject or misinterpret, local paths
+// that don't exist and klibs the kernel doesn't have. The failures point at the
+// property in opsConfig, or include the path inside config.
+func checkConfig(ctx context.Context, inputs property.Map, typed *OpsConfig, kernel kernelSelection) []p.CheckFailure {
+	var fails []p.CheckFailure
+
+	typedValue, hasTyped := inputs.GetOk("opsConfig")
+	rawValue, hasRaw := inputs.GetOk("config")
+	if hasRaw && !rawValue.IsComputed() && !rawValue.IsString() {
+		fails = append(fails, p.CheckFailure{
+			Property: "config",
+			Reason:   "config must be a (JSON encoded) string",
+		})
+		hasRaw = false
+	}
+
+	// Local paths are resolved with the merged configuration, the parent
+	// directory or target root may be set in either of them.
+	merged := &types.Config{}
+	var raw string
+	if hasRaw && rawValue.IsString() {
+		raw = rawValue.AsString()
+	}
+	_ = mergeConfig(ctx, merged, typed, raw)
+
+	klibsDir := klibsDirectory(merged, kernel)
+
+	// Unknown fields of opsConfig are reported by pruneOpsConfig.
+	if hasTyped && typed != nil && !hasComputed(typedValue) {
+		c := &types.Config{}
+		if err := mergeConfig(ctx, c, typed, ""); err == nil {
+			issues, warnings := validateConfig(c, merged, klibsDir)
+			for _, issue := range issues {
+				fails = append(fails, p.CheckFailure{
+					Property: "opsConfig." + pulumiFieldPath(reflect.TypeOf(OpsConfig{}), issue.field) + issue.index,
+					Reason:   issue.reason,
+				})
+			}
+			for _, warning := range warnings {
+				p.GetLogger(ctx).Warningf("opsConfig.%s%s: %s", pulumiFieldPath(reflect.TypeOf(OpsConfig{}), warning.field), warning.index, warning.reason)
+			}
+		}
+	}
+
+	if hasRaw && rawValue.IsString() {
+		var generic any
+		if err := json.Unmarshal([]byte(raw), &generic); err != nil {
+			return append(fails, p.CheckFailure{
+				Property: "config",
+				Reason:   fmt.Sprintf("invalid config: %v", err),
+			})
+		}
+		for _, field := range unknownFields(generic, reflect.TypeOf(types.Config{}), "json", "") {
+			fails = append(fails, p.CheckFailure{
+				Property: "config",
+				Reason:   fmt.Sprintf("%s: unknown field", field),
+			})
+		}
+		c := &types.Config{}
+		if err := json.Unmarshal([]byte(raw), c); err != nil {
+			return append(fails, p.CheckFailure{
+				Property: "config",
+				Reason:   fmt.Sprintf("invalid config: %v", err),
+			})
+		}
+		issues, warnings := validateConfig(c, merged, klibsDir)
+		for _, issue := range issues {
+			fails = append(fails, p.CheckFailure{
+				Property: "config",
+				Reason:   fmt.Sprintf("%s%s: %s", strings.Join(issue.field, "."), issue.index, issue.reason),
+			})
+		}
+		for _, warning := range warnings {
+			p.GetLogger(ctx).Warningf("config: %s%s: %s", strings.Join(warning.field, "."), warning.index, warning.reason)
+		}
+	}
+
+	return fails
+}
+
+// validateConfig returns the problems in c and the files and directories to
+// include that don't exist, which may be created during the deployment. Local
+// paths are resolved with the settings of merged, klibs are checked against
+// klibsDir if it is known.
+func validateConfig(c *types.Config, merged *types.Config, klibsDir string) (issues []configIssue, warnings []configIssue) {
+
+	for i, port := range c.RunConfig.Ports {
+		if reason := checkPortSpec(port); reason != "" {
+			issues = append(issues, configIssue{[]string{"RunConfig", "Ports"}, index(i), reason})
+		}
+	}
+	for i, port := range c.RunConfig.UDPPorts {
+		if reason := checkPortSpec(port); reason != "" {
+			issues = append(issues, configIssue{[]string{"RunConfig", "UDPPorts"}, index(i), reason})
+		}
+	}
+	if c.RunConfig.GdbPort != 0 && (c.RunConfig.GdbPort < 1 || c.RunConfig.GdbPort > 65535) {
+		issues = append(issues, configIssue{[]string{"RunConfig", "GdbPort"}, "", fmt.Sprintf("port %d out of range 1-65535", c.RunConfig.GdbPort)})
+	}
+
+	if c.RunConfig.Memory != "" {
+		if size, err := lepton.RAMInBytes(c.RunConfig.Memory); err != nil || size <= 0 {
+			issues = append(issues, configIssue{[]string{"RunConfig", "Memory"}, "", fmt.Sprintf("invalid memory size %q, expected e.g. '512M' or '2G'", c.RunConfig.Memory)})
+		}
+	}
+	if c.BaseVolumeSz != "" {
+		if size, err := lepton.RAMInBytes(c.BaseVolumeSz); err != nil || size <= 0 {
+			issues = append(issues, configIssue{[]string{"BaseVolumeSz"}, "", fmt.Sprintf("invalid size %q, expected e.g. '100m' or '1g'", c.BaseVolumeSz)})
+		}
+	}
+	if c.RunConfig.CPUs < 0 {
+		issues = append(issues, configIssue{[]string{"RunConfig", "CPUs"}, "", "number of CPUs must not be negative"})
+	}
+	if c.RunConfig.GPUs < 0 {
+		issues = append(issues, configIssue{[]string{"RunConfig", "GPUs"}, "", "number of GPUs must not be negative"})
+	}
+	if c.RunConfig.ThreadsPerCore < 0 {
+		issues = append(issues, configIssue{[]string{"RunConfig", "ThreadsPerCore"}, "", "threads per core must not be negative"})
+	}
+
+	for i, file := range c.Files {
+		if missing, reason := checkIncludedPath(rootedPath(merged, file), false); missing {
+			warnings = append(warnings, configIssue{[]string{"Files"}, index(i), reason})
+		} else if reason != "" {
+			issues = append(issues, configIssue{[]string{"Files"}, index(i), reason})
+		}
+	}
+	for i, dir := range c.Dirs {
+		if missing, reason := checkIncludedPath(localPath(merged, dir), true); missing {
+			warnings = append(warnings, configIssue{[]string{"Dirs"}, index(i), reason})
+		} else if reason != "" {
+			issues = append(issues, configIssue{[]string{"Dirs"}, index(i), reason})
+		}
+	}
+	for _, local := range sortedKeys(c.MapDirs) {
+		if missing, reason := checkIncludedPath(rootedPath(merged, local), true); missing {
+			warnings = append(warnings, configIssue{[]string{"MapDirs"}, fmt.Sprintf("[%q]", local), reason})
+		} else if reason != "" {
+			issues = append(issues, configIssue{[]string{"MapDirs"}, fmt.Sprintf("[%q]", local), reason})
+		}
+	}
+	for _, field := range []struct {
+		name  string
+		value string
+		dir   bool
+	}{{"Kernel", c.Kernel, false}, {"Boot", c.Boot, false}, {"KlibDir", c.KlibDir, true}} {
+		if field.value == "" {
+			continue
+		}
+		if reason := checkLocalPath(field.value, field.dir); reason != "" {
+			issues = append(issues, configIssue{[]string{field.name}, "", reason})
+		}
+	}
+
+	if klibsDir != "" {
+		for i, klib := range c.Klibs {
+			if _, err := os.Stat(path.Join(klibsDir, klib)); err != nil {
+				issues = append(issues, configIssue{[]string{"Klibs"}, index(i), fmt.Sprintf("unknown klib %q, not found in %s", klib, klibsDir)})
+			}
+		}
+	}
+
+	return issues, warnings
+}
+
+// checkPortSpec returns why port isn't a port or a range of ports like
+// "8000-8010", or an empty string if it is one.
+func checkPortSpec(port string) string {
+	from, to, isRange := strings.Cut(port, "-")
+	if !isRange {
+		to = from
+	}
+	first, err := strconv.Atoi(from)
+	if err != nil {
+		return fmt.Sprintf("invalid port %q, expected a number or a range like '8000-8010'", port)
+	}
+	last, err := strconv.Atoi(to)
+	if err != nil {
+		return fmt.Sprintf("invalid port %q, expected a number or a range like '8000-8010'", port)
+	}
+	if first < 1 || last > 65535 {
+		return fmt.Sprintf("port %q out of range 1-65535", port)
+	}
+	if first > last {
+		return fmt.Sprintf("invalid port range %q, the first port is larger than the last", port)
+	}
+	return ""
+}
+
+// checkLocalPath returns why name can't be included into an image, or an
+// empty string if it can.
+func checkLocalPath(name string, dir bool) string {
+	info, err := os.Stat(name)
+	if err != nil {
+		return fmt.Sprintf("%s does not exist", name)
+	}
+	if dir && !info.IsDir() {
+		return fmt.Sprintf("%s is not a directory", name)
+	}
+	if !dir && info.IsDir() {
+		return fmt.Sprintf("%s is a directory, use dirs or mapDirs to include directories", name)
+	}
+	return ""
+}
+
+// checkIncludedPath is checkLocalPath for files and directories to include,
+// which may not exist yet if they are created during the deployment, like the
+// elf. The build fails if they are still missing.
+func checkIncludedPath(name string, dir bool) (missing bool, reason string) {
+	if _, err := os.Stat(name); errors.Is(err, fs.ErrNotExist) {
+		return true, fmt.Sprintf("%s does not exist (yet)", name)
+	}
+	return false, checkLocalPath(name, dir)
+}
+
+// klibsDirectory returns the directory with the klibs of the kernel, or an
+// empty string if it isn't known yet, e.g. because the release is downloaded
+// when the image is built.
+func klibsDirectory(config *types.Config, kernel kernelSelection) string {
+	if config.KlibDir != "" {
+		return config.KlibDir
+	}
+	if kernel.latest {
+		return ""
+	}
+	defer useOpsHome(kernel.opsHome)()
+	version := kernel.version
+	if version == "" {
+		version = localReleaseVersion()
+	}
+	dir := path.Join(lepton.GetOpsHome(), releaseDirName(version, kernel.arch), "klibs")
+	if _, err := os.Stat(dir); err != nil {
+		return ""
+	}
+	return dir
+}
+
+// checkKernel returns the kernel an image with inputs is built with, as far as
+// it is known before building.
+func checkKernel(ctx context.Context, inputs property.Map, arch string) kernelSelection {
+	kernel := kernelSelection{arch: arch}
+	if v, ok := inputs.GetOk("opsHome"); ok && v.IsString() {
+		kernel.opsHome = v.AsString()
+	}
+	if v, ok := inputs.GetOk("useLatestKernel"); ok && v.IsBool() && v.AsBool() {
+		kernel.latest = true
+		return kernel
+	}
+	if v, ok := inputs.GetOk("kernelVersion"); ok && v.IsString() {
+		kernel.version = v.AsString()
+	}
+	if kernel.version == "" {
+		kernel.version = infer.GetConfig[Config](ctx).KernelVersion
+	}
+	return kernel
+}
+
+// unknownFields returns the paths of the keys in v that aren't fields of t,
+// matching the names in the struct tag (json or pulumi) like the decoder
+// does. Values that don't match the structure of t are left to the decoder.
+func unknownFields(v any, t reflect.Type, tag string, prefix string) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var unknown []string
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		for _, key := range sortedKeys(m) {
+			field, ok := structField(t, tag, key)
+			if !ok {
+				unknown = append(unknown, prefix+key)
+				continue
+			}
+			unknown = append(unknown, unknownFields(m[key], field.Type, tag, prefix+key+".")...)
+		}
+	case reflect.Slice, reflect.Array:
+		s, ok := v.([]any)
+		if !ok {
+			return nil
+		}
+		for i, elem := range s {
+			unknown = append(unknown, unknownFields(elem, t.Elem(), tag, strings.TrimSuffix(prefix, ".")+index(i)+".")...)
+		}
+	}
+	return unknown
+}
+
+// structField finds the field of t called name in the struct tag. encoding/json
+// matches names case-insensitively, so does this for the json tag.
+func structField(t reflect.Type, tag string, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldName, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if fieldName == "-" {
+			continue
+		}
+		if fieldName == "" {
+			if field.Anonymous {
+				if embedded, ok := structField(field.Type, tag, name); ok {
+					return embedded, true
+				}
+				continue
+			}
+			if tag == "pulumi" {
+				continue
+			}
+			fieldName = field.Name
+		}
+		if fieldName == name || (tag == "json" && strings.EqualFold(fieldName, name)) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// pulumiFieldPath translates a path of Go field names in t to the names in the
+// schema.
+func pulumiFieldPath(t reflect.Type, fields []string) string {
+	names := make([]string, 0, len(fields))
+	for _, name := range fields {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		field, ok := t.FieldByName(name)
+		if !ok {
+			names = append(names, name)
+			continue
+		}
+		pulumiName, _, _ := strings.Cut(field.Tag.Get("pulumi"), ",")
+		names = append(names, pulumiName)
+		t = field.Type
+	}
+	return strings.Join(names, ".")
+}
+
+// pruneOpsConfig removes the fields of the opsConfig in inputs that aren't in
+// the schema and returns a failure for each of them. The decoder would report
+// them all at once on opsConfig, and removing them lets the rest of the
+// configuration be validated.
+func pruneOpsConfig(inputs property.Map) (property.Map, []p.CheckFailure) {
+	v, ok := inputs.GetOk("opsConfig")
+	if !ok {
+		return inputs, nil
+	}
+	pruned, unknown := pruneUnknownFields(v, reflect.TypeOf(OpsConfig{}), "opsConfig.")
+	var fails []p.CheckFailure
+	for _, field := range unknown {
+		fails = append(fails, p.CheckFailure{
+			Property: field,
+			Reason:   "unknown field",
+		})
+	}
+	return inputs.Set("opsConfig", pruned), fails
+}
+
+// pruneUnknownFields removes the keys of maps in v that aren't fields of t in
+// the schema and returns their paths.
+func pruneUnknownFields(v property.Value, t reflect.Type, prefix string) (property.Value, []string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var unknown []string
+	switch {
+	case t.Kind() == reflect.Struct && v.IsMap():
+		m := v.AsMap()
+		for _, key := range sortedKeys(m.AsMap()) {
+			field, ok := structField(t, "pulumi", key)
+			if !ok {
+				unknown = append(unknown, prefix+key)
+				m = m.Delete(key)
+				continue
+			}
+			value, nested := pruneUnknownFields(m.Get(key), field.Type, prefix+key+".")
+			m = m.Set(key, value)
+			unknown = append(unknown, nested...)
+		}
+		return property.New(m).WithSecret(v.Secret()).WithDependencies(v.Dependencies()), unknown
+	case (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && v.IsArray():
+		elems := v.AsArray().AsSlice()
+		for i, elem := range elems {
+			var nested []string
+			elems[i], nested = pruneUnknownFields(elem, t.Elem(), strings.TrimSuffix(prefix, ".")+index(i)+".")
+			unknown = append(unknown, nested...)
+		}
+		return property.New(property.NewArray(elems)).WithSecret(v.Secret()).WithDependencies(v.Dependencies()), unknown
+	}
+	return v, nil
+}
+
+// hasComputed returns if v or any value inside it is unknown.
+func hasComputed(v property.Value) bool {
+	switch {
+	case v.IsComputed():
+		return true
+	case v.IsMap():
+		for _, value := range v.AsMap().All {
+			if hasComputed(value) {
+				return true
+			}
+		}
+	case v.IsArray():
+		for _, value := range v.AsArray().All {
+			if hasComputed(value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func index(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
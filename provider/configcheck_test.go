@@ -0,0 +1,245 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestCheckPortSpec(t *testing.T) {
+	tests := []struct {
+		port string
+		want string
+	}{
+		{port: "80"},
+		{port: "8000-8010"},
+		{port: "65535"},
+		{port: "8080-8080"},
+		{port: "0", want: `port "0" out of range 1-65535`},
+		{port: "65536", want: `port "65536" out of range 1-65535`},
+		{port: "8000-70000", want: `port "8000-70000" out of range 1-65535`},
+		{port: "http", want: `invalid port "http", expected a number or a range like '8000-8010'`},
+		{port: "8000-", want: `invalid port "8000-", expected a number or a range like '8000-8010'`},
+		{port: "", want: `invalid port "", expected a number or a range like '8000-8010'`},
+		{port: "9000-8000", want: `invalid port range "9000-8000", the first port is larger than the last`},
+	}
+	for _, tt := range tests {
+		if got := checkPortSpec(tt.port); got != tt.want {
+			t.Errorf("checkPortSpec(%q) is %q, want %q", tt.port, got, tt.want)
+		}
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   []string
+	}{
+		{name: "known", config: `{"Klibs":["tls"],"RunConfig":{"Ports":["80"]}}`},
+		{name: "case insensitive", config: `{"klibs":["tls"],"runconfig":{"ports":["80"]}}`},
+		{name: "typo", config: `{"Klib":["tls"]}`, want: []string{"Klib"}},
+		{name: "nested", config: `{"RunConfig":{"Prots":["80"],"Memory":"1G"},"Env":{"Klib":"x"}}`, want: []string{"RunConfig.Prots"}},
+		{
+			name:   "in slice",
+			config: `{"CloudConfig":{"Tags":[{"key":"a","value":"b"},{"key":"c","Colour":"red"}]}}`,
+			want:   []string{"CloudConfig.Tags[1].Colour"},
+		},
+		{name: "sorted", config: `{"Zzz":1,"Aaa":2,"Args":[]}`, want: []string{"Aaa", "Zzz"}},
+		{name: "mismatched type", config: `{"RunConfig":"large"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v any
+			if err := json.Unmarshal([]byte(tt.config), &v); err != nil {
+				t.Fatal(err)
+			}
+			if got := unknownFields(v, reflect.TypeOf(types.Config{}), "json", ""); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("unknown fields are %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPulumiFieldPath(t *testing.T) {
+	tests := []struct {
+		fields []string
+		want   string
+	}{
+		{fields: []string{"Klibs"}, want: "klibs"},
+		{fields: []string{"RunConfig", "Ports"}, want: "runConfig.ports"},
+		{fields: []string{"RunConfig", "UDPPorts"}, want: "runConfig.udpPorts"},
+		{fields: []string{"CloudConfig", "Tags", "Key"}, want: "cloudConfig.tags.key"},
+		{fields: []string{"TFSv4"}, want: "tfsv4"},
+		{fields: []string{"Unknown", "Field"}, want: "Unknown.Field"},
+	}
+	for _, tt := range tests {
+		if got := pulumiFieldPath(reflect.TypeOf(OpsConfig{}), tt.fields); got != tt.want {
+			t.Errorf("pulumiFieldPath(%q) is %q, want %q", tt.fields, got, tt.want)
+		}
+	}
+}
+
+func TestPruneOpsConfig(t *testing.T) {
+	inputs := property.NewMap(map[string]property.Value{
+		"name": property.New("web"),
+		"opsConfig": property.New(property.NewMap(map[string]property.Value{
+			"klibs": property.New(property.NewArray([]property.Value{property.New("tls")})),
+			"Klib":  property.New(property.NewArray([]property.Value{property.New("ntp")})),
+			"runConfig": property.New(property.NewMap(map[string]property.Value{
+				"ports": property.New(property.NewArray([]property.Value{property.New("80")})),
+				"prots": property.New(property.NewArray([]property.Value{property.New("80")})),
+			})),
+			"cloudConfig": property.New(property.NewMap(map[string]property.Value{
+				"tags": property.New(property.NewArray([]property.Value{
+					property.New(property.NewMap(map[string]property.Value{
+						"key":    property.New("team"),
+						"value":  property.New("web"),
+						"colour": property.New("red"),
+					})),
+				})),
+			})),
+		})).WithSecret(true),
+	})
+
+	pruned, fails := pruneOpsConfig(inputs)
+	wantFails := []p.CheckFailure{
+		{Property: "opsConfig.Klib", Reason: "unknown field"},
+		{Property: "opsConfig.cloudConfig.tags[0].colour", Reason: "unknown field"},
+		{Property: "opsConfig.runConfig.prots", Reason: "unknown field"},
+	}
+	if !reflect.DeepEqual(fails, wantFails) {
+		t.Errorf("failures are %v, want %v", fails, wantFails)
+	}
+
+	want := property.NewMap(map[string]property.Value{
+		"name": property.New("web"),
+		"opsConfig": property.New(property.NewMap(map[string]property.Value{
+			"klibs": property.New(property.NewArray([]property.Value{property.New("tls")})),
+			"runConfig": property.New(property.NewMap(map[string]property.Value{
+				"ports": property.New(property.NewArray([]property.Value{property.New("80")})),
+			})),
+			"cloudConfig": property.New(property.NewMap(map[string]property.Value{
+				"tags": property.New(property.NewArray([]property.Value{
+					property.New(property.NewMap(map[string]property.Value{
+						"key":   property.New("team"),
+						"value": property.New("web"),
+					})),
+				})),
+			})),
+		})).WithSecret(true),
+	})
+	if !property.New(pruned).Equals(property.New(want)) {
+		t.Errorf("pruned inputs are %v, want %v", pruned, want)
+	}
+
+	if pruned, fails := pruneOpsConfig(property.NewMap(map[string]property.Value{"name": property.New("web")})); len(fails) > 0 || pruned.Len() != 1 {
+		t.Errorf("inputs without opsConfig are pruned to %v with failures %v", pruned, fails)
+	}
+}
+
+func TestCheckConfig(t *testing.T) {
+	home := newOpsHome(t)
+	klibsDir := filepath.Join(home, ".ops", releaseDirName("0.1.54", runtime.GOARCH), "klibs")
+	if err := os.WriteFile(filepath.Join(klibsDir, "tls"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Image", "", "project", "stack")
+
+	list := func(values ...string) property.Value {
+		elems := make([]property.Value, len(values))
+		for i, v := range values {
+			elems[i] = property.New(v)
+		}
+		return property.New(property.NewArray(elems))
+	}
+	tests := []struct {
+		name      string
+		opsConfig map[string]property.Value
+		config    string
+		want      []p.CheckFailure
+	}{
+		{
+			name:      "valid opsConfig",
+			opsConfig: map[string]property.Value{"klibs": list("tls"), "runConfig": property.New(property.NewMap(map[string]property.Value{"ports": list("80", "8000-8010")}))},
+		},
+		{
+			name:   "valid config",
+			config: `{"Klibs":["tls"],"RunConfig":{"Ports":["80","8000-8010"]}}`,
+		},
+		{
+			name:      "opsConfig typo",
+			opsConfig: map[string]property.Value{"Klib": list("tls")},
+			want:      []p.CheckFailure{{Property: "opsConfig.Klib", Reason: "unknown field"}},
+		},
+		{
+			name:   "config typo",
+			config: `{"Klib":["tls"]}`,
+			want:   []p.CheckFailure{{Property: "config", Reason: "Klib: unknown field"}},
+		},
+		{
+			name:      "opsConfig bad ports",
+			opsConfig: map[string]property.Value{"runConfig": property.New(property.NewMap(map[string]property.Value{"ports": list("80", "http"), "udpPorts": list("70000")}))},
+			want: []p.CheckFailure{
+				{Property: "opsConfig.runConfig.ports[1]", Reason: `invalid port "http", expected a number or a range like '8000-8010'`},
+				{Property: "opsConfig.runConfig.udpPorts[0]", Reason: `port "70000" out of range 1-65535`},
+			},
+		},
+		{
+			name:   "config bad ports",
+			config: `{"RunConfig":{"Ports":["80","http"],"UDPPorts":["70000"]}}`,
+			want: []p.CheckFailure{
+				{Property: "config", Reason: `RunConfig.Ports[1]: invalid port "http", expected a number or a range like '8000-8010'`},
+				{Property: "config", Reason: `RunConfig.UDPPorts[0]: port "70000" out of range 1-65535`},
+			},
+		},
+		{
+			name:      "opsConfig unknown klib",
+			opsConfig: map[string]property.Value{"klibs": list("tls", "nope")},
+			want:      []p.CheckFailure{{Property: "opsConfig.klibs[1]", Reason: `unknown klib "nope", not found in ` + klibsDir}},
+		},
+		{
+			name:   "config unknown klib",
+			config: `{"Klibs":["tls","nope"]}`,
+			want:   []p.CheckFailure{{Property: "config", Reason: `Klibs[1]: unknown klib "nope", not found in ` + klibsDir}},
+		},
+		{
+			name:   "invalid config",
+			config: `{"Klibs":`,
+			want:   []p.CheckFailure{{Property: "config", Reason: "invalid config: unexpected end of JSON input"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs := map[string]property.Value{
+				"name":          property.New("web"),
+				"elf":           property.New(filepath.Join(t.TempDir(), "web")),
+				"provider":      property.New("onprem"),
+				"kernelVersion": property.New("0.1.54"),
+				"opsHome":       property.New(home),
+			}
+			if tt.opsConfig != nil {
+				inputs["opsConfig"] = property.New(property.NewMap(tt.opsConfig))
+			}
+			if tt.config != "" {
+				inputs["config"] = property.New(tt.config)
+			}
+			resp, err := server.Check(p.CheckRequest{Urn: urn, Inputs: property.NewMap(inputs)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.Failures) != len(tt.want) || (len(tt.want) > 0 && !reflect.DeepEqual(resp.Failures, tt.want)) {
+				t.Errorf("failures are %v, want %v", resp.Failures, tt.want)
+			}
+		})
+	}
+}
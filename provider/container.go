@@ -0,0 +1,498 @@
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// defaultContainerPath is the PATH used to find the program of a container
+// image that doesn't set one, as in docker.
+const defaultContainerPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+// containerSource reads the files of an OCI image layout or a 'docker save'
+// archive.
+type containerSource interface {
+	open(name string) (io.ReadCloser, error)
+}
+
+// dirSource is an extracted image layout or archive.
+type dirSource string
+
+func (d dirSource) open(name string) (io.ReadCloser, error) {
+	if !fs.ValidPath(name) {
+		return nil, fmt.Errorf("invalid path %s in %s", name, d)
+	}
+	return os.Open(filepath.Join(string(d), filepath.FromSlash(name)))
+}
+
+// tarSource is an image layout or archive as a tarball, optionally gzip
+// compressed. Every open scans the tarball for name, which is fine for the
+// few files read from it.
+type tarSource string
+
+func (t tarSource) open(name string) (io.ReadCloser, error) {
+	f, err := os.Open(string(t))
+	if err != nil {
+		return nil, err
+	}
+	r, err := decompress(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			f.Close()
+			return nil, fmt.Errorf("%s not found in %s: %w", name, t, fs.ErrNotExist)
+		} else if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read %s: %w", t, err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Clean(strings.TrimPrefix(hdr.Name, "./")) == name {
+			return struct {
+				io.Reader
+				io.Closer
+			}{tr, f}, nil
+		}
+	}
+}
+
+// decompress returns a reader of the content of r, uncompressing it if it is
+// gzip compressed.
+func decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	switch {
+	case bytes.HasPrefix(magic, []byte{0x1f, 0x8b}):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, []byte{0x28, 0xb5, 0x2f, 0xfd}):
+		return nil, errors.New("zstd compression is not supported")
+	}
+	return br, nil
+}
+
+func readJSON(src containerSource, name string, v any) ([]byte, error) {
+	r, err := src.open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return data, nil
+}
+
+// ociDescriptor references a blob of an OCI image layout.
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform,omitempty"`
+}
+
+// blob returns the path of the blob in the image layout.
+func (d ociDescriptor) blob() (string, error) {
+	alg, hex, ok := strings.Cut(d.Digest, ":")
+	if !ok || alg == "" || hex == "" || strings.ContainsAny(d.Digest, "/\\") {
+		return "", fmt.Errorf("invalid digest %q", d.Digest)
+	}
+	return path.Join("blobs", alg, hex), nil
+}
+
+// containerConfig is the part of the configuration of a container image used
+// for the package.
+type containerConfig struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Config       struct {
+		Entrypoint []string `json:"Entrypoint"`
+		Cmd        []string `json:"Cmd"`
+		Env        []string `json:"Env"`
+		WorkingDir string   `json:"WorkingDir"`
+	} `json:"config"`
+}
+
+// containerImage is an image for one platform in a container source.
+type containerImage struct {
+	// digest is the digest of the image configuration, which contains the
+	// digests of the uncompressed layers.
+	digest string
+	config containerConfig
+	layers []string
+}
+
+// resolveContainerImage finds the image for arch in src, which is either a
+// 'docker save' archive with a manifest.json or an OCI image layout with an
+// index.json.
+func resolveContainerImage(src containerSource, arch string) (*containerImage, error) {
+	var dockerManifest []struct {
+		Config string   `json:"Config"`
+		Layers []string `json:"Layers"`
+	}
+	_, err := readJSON(src, "manifest.json", &dockerManifest)
+	if err == nil {
+		for _, m := range dockerManifest {
+			img, err := loadContainerImage(src, m.Config, m.Layers)
+			if err != nil {
+				return nil, err
+			}
+			if img.matches(arch) {
+				return img, nil
+			}
+		}
+		return nil, fmt.Errorf("no image for linux/%s in manifest.json", arch)
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	var index struct {
+		Manifests []ociDescriptor `json:"manifests"`
+	}
+	if _, err := readJSON(src, "index.json", &index); errors.Is(err, fs.ErrNotExist) {
+		return nil, errors.New("neither manifest.json nor index.json found, not a docker archive or OCI image layout")
+	} else if err != nil {
+		return nil, err
+	}
+	img, err := resolveOciIndex(src, index.Manifests, arch)
+	if err != nil {
+		return nil, err
+	}
+	if img == nil {
+		return nil, fmt.Errorf("no image for linux/%s in index.json", arch)
+	}
+	return img, nil
+}
+
+// resolveOciIndex returns the first image for arch in manifests, following
+// nested indexes, or nil if there is none.
+func resolveOciIndex(src containerSource, manifests []ociDescriptor, arch string) (*containerImage, error) {
+	for _, d := range manifests {
+		if d.Platform != nil && (d.Platform.OS != "linux" || d.Platform.Architecture != arch) {
+			continue
+		}
+		blob, err := d.blob()
+		if err != nil {
+			return nil, err
+		}
+		var manifest struct {
+			MediaType string          `json:"mediaType"`
+			Manifests []ociDescriptor `json:"manifests"`
+			Config    *ociDescriptor  `json:"config"`
+			Layers    []ociDescriptor `json:"layers"`
+		}
+		if _, err := readJSON(src, blob, &manifest); err != nil {
+			return nil, err
+		}
+		if manifest.Config == nil {
+			// An image index or docker manifest list.
+			img, err := resolveOciIndex(src, manifest.Manifests, arch)
+			if img != nil || err != nil {
+				return img, err
+			}
+			continue
+		}
+
+		config, err := manifest.Config.blob()
+		if err != nil {
+			return nil, err
+		}
+		layers := make([]string, 0, len(manifest.Layers))
+		for _, l := range manifest.Layers {
+			layer, err := l.blob()
+			if err != nil {
+				return nil, err
+			}
+			layers = append(layers, layer)
+		}
+		img, err := loadContainerImage(src, config, layers)
+		if err != nil {
+			return nil, err
+		}
+		if img.matches(arch) {
+			return img, nil
+		}
+	}
+	return nil, nil
+}
+
+func loadContainerImage(src containerSource, config string, layers []string) (*containerImage, error) {
+	img := &containerImage{layers: layers}
+	data, err := readJSON(src, path.Clean(config), &img.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image configuration: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	img.digest = "sha256:" + hex.EncodeToString(sum[:])
+	return img, nil
+}
+
+// matches returns if the image is a linux image for arch.
+func (img *containerImage) matches(arch string) bool {
+	return (img.config.OS == "" || img.config.OS == "linux") && img.config.Architecture == arch
+}
+
+// containerPackage converts the container image for arch in source, an OCI
+// image layout or 'docker save' archive as a directory or tarball, into a
+// local package. The package is named after the digest of the image, so it is
+// only extracted again when the image changes. It returns the path of the
+// package and the digest.
+func containerPackage(ctx context.Context, source string, arch string) (string, string, error) {
+	info, err := os.Stat(source)
+	if err != nil {
+		return "", "", fmt.Errorf("container image %s not found: %w", source, err)
+	}
+	var src containerSource = tarSource(source)
+	if info.IsDir() {
+		src = dirSource(source)
+	}
+
+	img, err := resolveContainerImage(src, arch)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read container image %s: %w", source, err)
+	}
+	version := strings.TrimPrefix(img.digest, "sha256:")[:12]
+	packagePath := path.Join(lepton.GetOpsHome(), "local_packages", arch, "container_"+version)
+	if _, err := os.Stat(path.Join(packagePath, "package.manifest")); err == nil {
+		return packagePath, img.digest, nil
+	}
+
+	p.GetLogger(ctx).Infof("Extracting container image %s (%s) into %s", source, img.digest, packagePath)
+	if err := os.MkdirAll(filepath.Dir(packagePath), 0755); err != nil {
+		return "", "", err
+	}
+	// Extract next to the final location and move the package in place when it
+	// is complete, so a failure doesn't leave a partial package behind.
+	tmp, err := os.MkdirTemp(filepath.Dir(packagePath), "."+filepath.Base(packagePath)+".*")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return "", "", err
+	}
+
+	sysroot := filepath.Join(tmp, "sysroot")
+	if err := os.Mkdir(sysroot, 0755); err != nil {
+		return "", "", err
+	}
+	for _, layer := range img.layers {
+		if err := extractLayer(src, layer, sysroot); err != nil {
+			return "", "", fmt.Errorf("failed to extract layer %s of %s: %w", layer, source, err)
+		}
+	}
+
+	if img.config.Config.WorkingDir != "" && img.config.Config.WorkingDir != "/" {
+		p.GetLogger(ctx).Warningf("working directory %s of container image %s is not applied, the program starts in /", img.config.Config.WorkingDir, source)
+	}
+	manifest, err := img.manifest(sysroot, version)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid container image %s: %w", source, err)
+	}
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal package manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, "package.manifest"), data, 0644); err != nil {
+		return "", "", err
+	}
+
+	if err := os.RemoveAll(packagePath); err != nil {
+		return "", "", err
+	}
+	if err := os.Rename(tmp, packagePath); err != nil {
+		return "", "", err
+	}
+	return packagePath, img.digest, nil
+}
+
+// manifest returns the package manifest for the entrypoint, cmd and env of
+// the image, with the program resolved in the extracted sysroot.
+func (img *containerImage) manifest(sysroot string, version string) (packageManifest, error) {
+	c := img.config.Config
+	argv := c.Cmd
+	if len(c.Entrypoint) > 0 {
+		argv = append(append([]string{}, c.Entrypoint...), c.Cmd...)
+	}
+	if len(argv) == 0 {
+		return packageManifest{}, errors.New("no entrypoint or cmd")
+	}
+
+	env := map[string]string{}
+	for _, e := range c.Env {
+		k, v, _ := strings.Cut(e, "=")
+		env[k] = v
+	}
+	searchPath, ok := env["PATH"]
+	if !ok {
+		searchPath = defaultContainerPath
+	}
+
+	program := argv[0]
+	if !strings.Contains(program, "/") {
+		for _, dir := range strings.Split(searchPath, ":") {
+			candidate := path.Join("/", dir, program)
+			if info, err := os.Stat(sysrootPath(sysroot, candidate)); err == nil && info.Mode().IsRegular() {
+				program = candidate
+				break
+			}
+		}
+	} else if !path.IsAbs(program) {
+		program = path.Join("/", c.WorkingDir, program)
+	}
+	if !path.IsAbs(program) {
+		return packageManifest{}, fmt.Errorf("program %s not found in PATH %s", program, searchPath)
+	}
+
+	info, err := inspectElf(sysrootPath(sysroot, program))
+	if err != nil {
+		return packageManifest{}, fmt.Errorf("program %s: %w", program, err)
+	}
+	if err := info.validate(); err != nil {
+		return packageManifest{}, fmt.Errorf("program %s: %w", program, err)
+	}
+
+	return packageManifest{
+		Program: program,
+		Args:    argv,
+		Env:     env,
+		Version: version,
+	}, nil
+}
+
+// extractLayer applies the layer tarball in src to sysroot, handling the
+// whiteout files of deleted files and opaque directories.
+func extractLayer(src containerSource, layer string, sysroot string) error {
+	f, err := src.open(layer)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := decompress(f)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		if name == "/" {
+			continue
+		}
+		dir, base := path.Split(name)
+		// Resolve the parent directory in the sysroot, so symbolic links in
+		// the image can't point outside of it.
+		parent := sysrootPath(sysroot, dir)
+		if err := os.MkdirAll(parent, 0755); err != nil {
+			return err
+		}
+		target := filepath.Join(parent, base)
+
+		if base == ".wh..wh..opq" {
+			entries, err := os.ReadDir(parent)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if err := os.RemoveAll(filepath.Join(parent, e.Name())); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if deleted, ok := strings.CutPrefix(base, ".wh."); ok {
+			if err := os.RemoveAll(filepath.Join(parent, deleted)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		mode := os.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if info, err := os.Lstat(target); err == nil && info.IsDir() {
+				err = os.Chmod(target, mode|0700)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			// Keep directories writable, later layers may change them.
+			if err := os.Mkdir(target, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_EXCL|os.O_WRONLY, mode)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(out, tr); err != nil {
+				out.Close()
+				return err
+			}
+			if err := out.Close(); err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			if err := os.RemoveAll(target); err != nil {
+				return err
+			}
+			linked := sysrootPath(sysroot, hdr.Linkname)
+			if err := os.Link(linked, target); err != nil {
+				if err := copyFile(linked, target); err != nil {
+					return err
+				}
+			}
+		default:
+			// Devices and fifos can't be used by nanos.
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"debug/elf"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// extractContainerImage extracts the image for arch in src into a sysroot and
+// returns the image and the sysroot.
+func extractContainerImage(t *testing.T, src containerSource, arch string) (*containerImage, string) {
+	t.Helper()
+	img, err := resolveContainerImage(src, arch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sysroot := filepath.Join(t.TempDir(), "sysroot")
+	if err := os.Mkdir(sysroot, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, layer := range img.layers {
+		if err := extractLayer(src, layer, sysroot); err != nil {
+			t.Fatalf("failed to extract layer %s: %v", layer, err)
+		}
+	}
+	return img, sysroot
+}
+
+func readFile(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestContainerDockerSave(t *testing.T) {
+	src := tarSource(filepath.Join("testdata", "docker-save.tar"))
+	if _, err := resolveContainerImage(src, "arm64"); err == nil || !strings.Contains(err.Error(), "no image for linux/arm64") {
+		t.Errorf("found an arm64 image in an amd64 archive: %v", err)
+	}
+	img, sysroot := extractContainerImage(t, src, "amd64")
+
+	manifest, err := img.manifest(sysroot, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The program is looked up in the PATH of the image and the cmd follows
+	// the entrypoint.
+	if manifest.Program != "/usr/local/bin/server" {
+		t.Errorf("program is %v, want /usr/local/bin/server", manifest.Program)
+	}
+	if want := []string{"server", "--port", "8080"}; !reflect.DeepEqual(manifest.Args, want) {
+		t.Errorf("args are %v, want %v", manifest.Args, want)
+	}
+	if want := map[string]string{"PATH": "/usr/local/bin:/usr/bin", "GREETING": "hello=world"}; !reflect.DeepEqual(manifest.Env, want) {
+		t.Errorf("env is %v, want %v", manifest.Env, want)
+	}
+
+	for name, want := range map[string]bool{
+		"etc/hostname": true,
+		// Deleted by a whiteout file.
+		"etc/removed": false,
+		// Replaced by the opaque directory of the second layer.
+		"var/cache/old": false,
+		"var/cache/sub": false,
+		"var/cache/new": true,
+		// Written through the symbolic link lib -> usr/lib.
+		"usr/lib/libapp.so": true,
+		// Written through escape -> ../../../.. and abs -> /etc, which
+		// resolve in the sysroot.
+		"outside":          true,
+		"etc/through-abs":  true,
+		"../outside":       false,
+		"../../outside":    false,
+		"/etc/through-abs": false,
+	} {
+		target := filepath.Join(sysroot, name)
+		if strings.HasPrefix(name, "/") {
+			target = name
+		}
+		_, err := os.Lstat(target)
+		if exists := err == nil; exists != want {
+			t.Errorf("%s exists: %v, want %v", name, exists, want)
+		}
+	}
+
+	server, err := os.Stat(filepath.Join(sysroot, "usr/local/bin/server"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	link, err := os.Stat(filepath.Join(sysroot, "usr/local/bin/server-link"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !os.SameFile(server, link) {
+		t.Errorf("hard link server-link is not linked to server")
+	}
+}
+
+func TestContainerOciLayout(t *testing.T) {
+	src := dirSource(filepath.Join("testdata", "oci-layout"))
+	if _, err := resolveContainerImage(src, "riscv64"); err == nil || !strings.Contains(err.Error(), "no image for linux/riscv64") {
+		t.Errorf("found a riscv64 image in the layout: %v", err)
+	}
+
+	for arch, machine := range map[string]elf.Machine{"amd64": elf.EM_X86_64, "arm64": elf.EM_AARCH64} {
+		t.Run(arch, func(t *testing.T) {
+			img, sysroot := extractContainerImage(t, src, arch)
+			if img.config.Architecture != arch {
+				t.Fatalf("resolved the %s image for %s", img.config.Architecture, arch)
+			}
+			if got := readFile(t, filepath.Join(sysroot, "data", "arch")); got != arch+"\n" {
+				t.Errorf("extracted the layer of %q", got)
+			}
+
+			manifest, err := img.manifest(sysroot, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Without an entrypoint the cmd is the command line.
+			if manifest.Program != "/bin/app" {
+				t.Errorf("program is %v, want /bin/app", manifest.Program)
+			}
+			if want := []string{"/bin/app", "-v"}; !reflect.DeepEqual(manifest.Args, want) {
+				t.Errorf("args are %v, want %v", manifest.Args, want)
+			}
+			if want := map[string]string{"ARCH": arch}; !reflect.DeepEqual(manifest.Env, want) {
+				t.Errorf("env is %v, want %v", manifest.Env, want)
+			}
+			info, err := inspectElf(filepath.Join(sysroot, "bin", "app"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Machine != machine {
+				t.Errorf("program is a %v binary, want %v", info.Machine, machine)
+			}
+		})
+	}
+}
+
+func TestContainerManifest(t *testing.T) {
+	sysroot := t.TempDir()
+	for _, name := range []string{"bin/sh", "usr/bin/app", "srv/tool"} {
+		if err := os.MkdirAll(filepath.Join(sysroot, filepath.Dir(name)), 0755); err != nil {
+			t.Fatal(err)
+		}
+		writeElf(t, filepath.Join(sysroot, name), elf.EM_X86_64)
+	}
+	if err := os.WriteFile(filepath.Join(sysroot, "bin", "script"), []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		entrypoint  []string
+		cmd         []string
+		env         []string
+		workingDir  string
+		wantProgram string
+		wantArgs    []string
+		wantErr     string
+	}{
+		{name: "entrypoint and cmd", entrypoint: []string{"app", "serve"}, cmd: []string{"--verbose"}, wantProgram: "/usr/bin/app", wantArgs: []string{"app", "serve", "--verbose"}},
+		{name: "cmd only", cmd: []string{"/bin/sh", "-c", "true"}, wantProgram: "/bin/sh", wantArgs: []string{"/bin/sh", "-c", "true"}},
+		{name: "entrypoint only", entrypoint: []string{"/usr/bin/app"}, wantProgram: "/usr/bin/app", wantArgs: []string{"/usr/bin/app"}},
+		{name: "path of the image", cmd: []string{"tool"}, env: []string{"PATH=/srv"}, wantProgram: "/srv/tool", wantArgs: []string{"tool"}},
+		{name: "relative to working dir", cmd: []string{"./tool"}, workingDir: "/srv", wantProgram: "/srv/tool", wantArgs: []string{"./tool"}},
+		{name: "not in default path", cmd: []string{"tool"}, wantErr: "not found in PATH"},
+		{name: "no command", wantErr: "no entrypoint or cmd"},
+		{name: "script", cmd: []string{"script"}, wantErr: "is a script"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			img := &containerImage{}
+			img.config.Config.Entrypoint = tt.entrypoint
+			img.config.Config.Cmd = tt.cmd
+			img.config.Config.Env = tt.env
+			img.config.Config.WorkingDir = tt.workingDir
+
+			manifest, err := img.manifest(sysroot, "test")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("got error %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if manifest.Program != tt.wantProgram {
+				t.Errorf("program is %v, want %v", manifest.Program, tt.wantProgram)
+			}
+			if !reflect.DeepEqual(manifest.Args, tt.wantArgs) {
+				t.Errorf("args are %v, want %v", manifest.Args, tt.wantArgs)
+			}
+		})
+	}
+}
@@ -0,0 +1,381 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// The functions below look up images, instances and volumes that are not
+// managed by this stack, e.g. built by another stack or by 'ops' itself.
+
+type GetImages struct{}
+type GetImage struct{}
+type GetInstances struct{}
+type GetInstance struct{}
+type GetVolumes struct{}
+
+var _ = (infer.Fn[GetImagesArgs, GetImagesResult])((*GetImages)(nil))
+var _ = (infer.Fn[GetImageArgs, ImageInfo])((*GetImage)(nil))
+var _ = (infer.Fn[GetInstancesArgs, GetInstancesResult])((*GetInstances)(nil))
+var _ = (infer.Fn[GetInstanceArgs, InstanceInfo])((*GetInstance)(nil))
+var _ = (infer.Fn[GetVolumesArgs, GetVolumesResult])((*GetVolumes)(nil))
+
+func (g *GetImages) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Lists the images of a cloud provider")
+}
+
+func (g *GetImage) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Looks up an image of a cloud provider by name")
+}
+
+func (g *GetInstances) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Lists the instances of a cloud provider")
+}
+
+func (g *GetInstance) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Looks up an instance of a cloud provider by name")
+}
+
+func (g *GetVolumes) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Lists the volumes of a cloud provider")
+}
+
+type GetImagesArgs struct {
+	Provider  string     `pulumi:"provider,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+	Filter    string     `pulumi:"filter,optional"`
+}
+
+func (g *GetImagesArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.Provider, "The cloud provider to list the images of")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as project and zone")
+	a.Describe(&g.Filter, "Only return images matching the filter, the format depends on the cloud provider")
+}
+
+type GetImagesResult struct {
+	Images []ImageInfo `pulumi:"images"`
+}
+
+func (g *GetImagesResult) Annotate(a infer.Annotator) {
+	a.Describe(&g.Images, "The images")
+}
+
+type GetImageArgs struct {
+	Name      string     `pulumi:"name"`
+	Provider  string     `pulumi:"provider,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+}
+
+func (g *GetImageArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.Name, "The name of the image")
+	a.Describe(&g.Provider, "The cloud provider of the image")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as project and zone")
+}
+
+type ImageInfo struct {
+	ID      string   `pulumi:"imageID"`
+	Name    string   `pulumi:"name"`
+	Status  string   `pulumi:"status"`
+	Size    int      `pulumi:"size"`
+	Path    string   `pulumi:"path"`
+	Created string   `pulumi:"created"`
+	Labels  []string `pulumi:"labels"`
+}
+
+func (i *ImageInfo) Annotate(a infer.Annotator) {
+	a.Describe(&i.ID, "The provider ID of the image")
+	a.Describe(&i.Name, "The name of the image")
+	a.Describe(&i.Status, "The status of the image")
+	a.Describe(&i.Size, "The size of the image in bytes")
+	a.Describe(&i.Path, "The (local) path of the image")
+	a.Describe(&i.Created, "The creation time of the image (RFC 3339)")
+	a.Describe(&i.Labels, "The labels of the image")
+}
+
+type GetInstancesArgs struct {
+	Provider  string     `pulumi:"provider,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+}
+
+func (g *GetInstancesArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.Provider, "The cloud provider to list the instances of")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as project and zone")
+}
+
+type GetInstancesResult struct {
+	Instances []InstanceInfo `pulumi:"instances"`
+}
+
+func (g *GetInstancesResult) Annotate(a infer.Annotator) {
+	a.Describe(&g.Instances, "The instances")
+}
+
+type GetInstanceArgs struct {
+	Name      string     `pulumi:"name"`
+	Provider  string     `pulumi:"provider,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+}
+
+func (g *GetInstanceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.Name, "The name of the instance")
+	a.Describe(&g.Provider, "The cloud provider of the instance")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as project and zone")
+}
+
+type InstanceInfo struct {
+	PID        string   `pulumi:"pid"`
+	Name       string   `pulumi:"name"`
+	Status     string   `pulumi:"status"`
+	Image      string   `pulumi:"image"`
+	Created    string   `pulumi:"created"`
+	PublicIPs  []string `pulumi:"public_ips"`
+	PrivateIPs []string `pulumi:"private_ips"`
+	Ports      []string `pulumi:"ports"`
+}
+
+func (i *InstanceInfo) Annotate(a infer.Annotator) {
+	a.Describe(&i.PID, "The provider instance ID")
+	a.Describe(&i.Name, "The name of the instance")
+	a.Describe(&i.Status, "The status of the instance")
+	a.Describe(&i.Image, "The image the instance runs")
+	a.Describe(&i.Created, "The creation time of the instance as reported by the cloud provider")
+	a.Describe(&i.PublicIPs, "The public IP addresses of the instance")
+	a.Describe(&i.PrivateIPs, "The private IP addresses of the instance")
+	a.Describe(&i.Ports, "The ports of the instance")
+}
+
+type GetVolumesArgs struct {
+	Provider  string     `pulumi:"provider,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+}
+
+func (g *GetVolumesArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.Provider, "The cloud provider to list the volumes of")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as project and zone")
+}
+
+type GetVolumesResult struct {
+	Volumes []VolumeInfo `pulumi:"volumes"`
+}
+
+func (g *GetVolumesResult) Annotate(a infer.Annotator) {
+	a.Describe(&g.Volumes, "The volumes")
+}
+
+type VolumeInfo struct {
+	ID         string `pulumi:"volumeID"`
+	Name       string `pulumi:"name"`
+	Size       string `pulumi:"size"`
+	Path       string `pulumi:"path"`
+	Status     string `pulumi:"status"`
+	AttachedTo string `pulumi:"attachedTo"`
+	Created    string `pulumi:"created"`
+}
+
+func (v *VolumeInfo) Annotate(a infer.Annotator) {
+	a.Describe(&v.ID, "The provider ID of the volume")
+	a.Describe(&v.Name, "The name of the volume")
+	a.Describe(&v.Size, "The size of the volume as reported by the cloud provider")
+	a.Describe(&v.Path, "The (local) path of the volume")
+	a.Describe(&v.Status, "The status of the volume")
+	a.Describe(&v.AttachedTo, "The instance the volume is attached to")
+	a.Describe(&v.Created, "The creation time of the volume as reported by the cloud provider")
+}
+
+func (*GetImages) Invoke(ctx context.Context, req infer.FunctionRequest[GetImagesArgs]) (infer.FunctionResponse[GetImagesResult], error) {
+	var resp infer.FunctionResponse[GetImagesResult]
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, req.Input.Provider, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	images, err := provider.GetImages(opsContext, req.Input.Filter)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	resp.Output.Images = make([]ImageInfo, 0, len(images))
+	for _, image := range images {
+		resp.Output.Images = append(resp.Output.Images, imageInfo(image))
+	}
+	return resp, nil
+}
+
+func (*GetImage) Invoke(ctx context.Context, req infer.FunctionRequest[GetImageArgs]) (infer.FunctionResponse[ImageInfo], error) {
+	var resp infer.FunctionResponse[ImageInfo]
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, req.Input.Provider, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	images, err := provider.GetImages(opsContext, "")
+	if err != nil {
+		return resp, fmt.Errorf("failed to list images: %w", err)
+	}
+
+	for _, image := range images {
+		if image.Name == req.Input.Name {
+			resp.Output = imageInfo(image)
+			return resp, nil
+		}
+	}
+	return resp, fmt.Errorf("image with name %v not found", req.Input.Name)
+}
+
+func (*GetInstances) Invoke(ctx context.Context, req infer.FunctionRequest[GetInstancesArgs]) (infer.FunctionResponse[GetInstancesResult], error) {
+	var resp infer.FunctionResponse[GetInstancesResult]
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, req.Input.Provider, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	instances, err := provider.GetInstances(opsContext)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list instances: %w", err)
+	}
+
+	resp.Output.Instances = make([]InstanceInfo, 0, len(instances))
+	for _, instance := range instances {
+		resp.Output.Instances = append(resp.Output.Instances, instanceInfo(instance))
+	}
+	return resp, nil
+}
+
+func (*GetInstance) Invoke(ctx context.Context, req infer.FunctionRequest[GetInstanceArgs]) (infer.FunctionResponse[InstanceInfo], error) {
+	var resp infer.FunctionResponse[InstanceInfo]
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, req.Input.Provider, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	instance, err := provider.GetInstanceByName(opsContext, req.Input.Name)
+	if err != nil {
+		return resp, fmt.Errorf("failed to get instance information: %w", err)
+	}
+
+	resp.Output = instanceInfo(*instance)
+	return resp, nil
+}
+
+func (*GetVolumes) Invoke(ctx context.Context, req infer.FunctionRequest[GetVolumesArgs]) (infer.FunctionResponse[GetVolumesResult], error) {
+	var resp infer.FunctionResponse[GetVolumesResult]
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, req.Input.Provider, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	volumes, err := provider.GetAllVolumes(opsContext)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	resp.Output.Volumes = []VolumeInfo{}
+	if volumes != nil {
+		for _, volume := range *volumes {
+			resp.Output.Volumes = append(resp.Output.Volumes, VolumeInfo{
+				ID:         volume.ID,
+				Name:       volume.Name,
+				Size:       volume.Size,
+				Path:       volume.Path,
+				Status:     volume.Status,
+				AttachedTo: volume.AttachedTo,
+				Created:    volume.CreatedAt,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// lookupProvider creates the cloud provider and ops context for the functions
+// from the configuration inputs. The caller holds the default ops home, see
+// useOpsHome, while it uses the provider.
+func lookupProvider(ctx context.Context, providerName string, typed *OpsConfig, raw string) (lepton.Provider, *lepton.Context, error) {
+	providerName, err := resolveProviderName(ctx, providerName)
+	if err != nil {
+		return nil, nil, err
+	}
+	config := &types.Config{}
+	if err := mergeConfig(ctx, config, typed, raw); err != nil {
+		return nil, nil, err
+	}
+	if config.VolumesDir == "" {
+		config.VolumesDir = localVolumeDir("")
+	}
+
+	provider, err := cloudProvider(providerName, &config.CloudConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create provider: %w", err)
+	}
+	return provider, lepton.NewContext(config), nil
+}
+
+func imageInfo(image lepton.CloudImage) ImageInfo {
+	info := ImageInfo{
+		ID:     image.ID,
+		Name:   image.Name,
+		Status: image.Status,
+		Size:   int(image.Size),
+		Path:   image.Path,
+		Labels: image.Labels,
+	}
+	if !image.Created.IsZero() {
+		info.Created = image.Created.Format(time.RFC3339)
+	}
+	if info.Labels == nil {
+		info.Labels = []string{}
+	}
+	return info
+}
+
+func instanceInfo(instance lepton.CloudInstance) InstanceInfo {
+	info := InstanceInfo{
+		PID:        instance.ID,
+		Name:       instance.Name,
+		Status:     instance.Status,
+		Image:      instance.Image,
+		Created:    instance.Created,
+		PublicIPs:  instance.PublicIps,
+		PrivateIPs: instance.PrivateIps,
+		Ports:      instance.Ports,
+	}
+	if info.PublicIPs == nil {
+		info.PublicIPs = []string{}
+	}
+	if info.PrivateIPs == nil {
+		info.PrivateIPs = []string{}
+	}
+	if info.Ports == nil {
+		info.Ports = []string{}
+	}
+	return info
+}
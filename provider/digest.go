@@ -0,0 +1,107 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/nanovms/ops/types"
+)
+
+// contentDigest computes a SHA-256 digest over the program and all files and
+// directories that are included into the image through Files, Dirs and MapDirs,
+// so changes to their content can be detected even if the paths stay the same.
+func contentDigest(config *types.Config) (string, error) {
+	h := sha256.New()
+
+	if err := digestFile(h, "program", rootedPath(config, config.Program)); err != nil {
+		return "", err
+	}
+	for _, file := range config.Files {
+		if err := digestFile(h, "file", rootedPath(config, file)); err != nil {
+			return "", err
+		}
+	}
+	for _, dir := range config.Dirs {
+		if err := digestDir(h, "dir", localPath(config, dir)); err != nil {
+			return "", err
+		}
+	}
+	mapDirs := make([]string, 0, len(config.MapDirs))
+	for local := range config.MapDirs {
+		mapDirs = append(mapDirs, local)
+	}
+	sort.Strings(mapDirs)
+	for _, local := range mapDirs {
+		fmt.Fprintf(h, "mapdir\x00%s\x00", config.MapDirs[local])
+		if err := digestDir(h, "mapdir", rootedPath(config, local)); err != nil {
+			return "", err
+		}
+	}
+
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// localPath resolves a path relative to LocalFilesParentDirectory, like ops does
+// when adding files to the image.
+func localPath(config *types.Config, p string) string {
+	if config.LocalFilesParentDirectory != "" && !filepath.IsAbs(p) {
+		return filepath.Join(config.LocalFilesParentDirectory, p)
+	}
+	return p
+}
+
+// rootedPath resolves an absolute path inside TargetRoot, like ops does for the
+// program, files and mapped directories, and other paths like localPath.
+func rootedPath(config *types.Config, p string) string {
+	if config.TargetRoot != "" && filepath.IsAbs(p) {
+		return filepath.Join(config.TargetRoot, p)
+	}
+	return localPath(config, p)
+}
+
+func digestFile(h hash.Hash, kind, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("cannot read %s %s: %w", kind, path, err)
+	}
+	defer f.Close()
+
+	fmt.Fprintf(h, "%s\x00%s\x00", kind, path)
+	if _, err := io.Copy(h, f); err != nil {
+		return fmt.Errorf("cannot read %s %s: %w", kind, path, err)
+	}
+	return nil
+}
+
+func digestDir(h hash.Hash, kind, dir string) error {
+	// WalkDir visits entries in lexical order, which keeps the digest stable.
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("cannot read %s %s: %w", kind, path, err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.Type()&fs.ModeSymlink != 0:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return fmt.Errorf("cannot read %s %s: %w", kind, path, err)
+			}
+			fmt.Fprintf(h, "link\x00%s\x00%s\x00", rel, target)
+		case d.IsDir():
+			fmt.Fprintf(h, "dir\x00%s\x00", rel)
+		case d.Type().IsRegular():
+			return digestFile(h, "file", path)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/provider/gcp"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"google.golang.org/api/googleapi"
+)
+
+// stoppedStatuses are the statuses, in lowercase, the cloud providers report
+// for an instance that exists but is not running, e.g. "TERMINATED" on gcp or
+// "off" on digitalocean and hetzner.
+var stoppedStatuses = []string{"stopped", "stopping", "terminated", "suspended", "off", "shutoff", "shutdown", "deallocated", "stopped in place"}
+
+// instanceStopped reports whether an instance with the given status can be
+// started again.
+func instanceStopped(status string) bool {
+	return slices.Contains(stoppedStatuses, strings.ToLower(status))
+}
+
+// instanceGone reports whether an instance with the given status is being
+// deleted. AWS lists terminated instances for a while, but they can't be
+// started again.
+func instanceGone(providerName string, status string) bool {
+	status = strings.ToLower(status)
+	return providerName == "aws" && (status == "terminated" || status == "shutting-down")
+}
+
+// driftChecks are the attributes of an instance whose changes outside Pulumi
+// are detected, per provider. Only onprem, aws, hetzner, oci and scaleway
+// report the name (or, for onprem, the path) of the image of an instance, only
+// the gcp compute API is asked for the machine type. aws and gcp look instances
+// up in the configured zone (region on aws), an instance moved to another zone
+// is not found and created again.
+var driftChecks = map[string][]string{
+	"onprem":   {"image"},
+	"aws":      {"image", "zone"},
+	"gcp":      {"flavor", "zone"},
+	"hetzner":  {"image"},
+	"oci":      {"image"},
+	"scaleway": {"image"},
+}
+
+// undetectableDrift returns the attributes of an instance with config whose
+// changes outside Pulumi the provider can't detect. The flavor and zone are only
+// returned if they are configured, onprem instances have neither.
+func undetectableDrift(providerName string, config *types.Config) []string {
+	checks := driftChecks[providerName]
+	var attributes []string
+	if !slices.Contains(checks, "image") {
+		attributes = append(attributes, "image")
+	}
+	if providerName == "onprem" {
+		return attributes
+	}
+	if config.CloudConfig.Flavor != "" && !slices.Contains(checks, "flavor") {
+		attributes = append(attributes, "flavor")
+	}
+	if config.CloudConfig.Zone != "" && !slices.Contains(checks, "zone") {
+		attributes = append(attributes, "zone")
+	}
+	return attributes
+}
+
+// instanceDetails are the attributes of an instance that ops reports
+// differently, or not at all, depending on the provider. Empty values are
+// unknown.
+type instanceDetails struct {
+	image  string
+	flavor string
+}
+
+// lookupInstanceDetails returns the image and flavor of instance, as far as the
+// provider reports them.
+func lookupInstanceDetails(ctx context.Context, cloudProvider lepton.Provider, providerName string, config *types.Config, instance *lepton.CloudInstance) instanceDetails {
+	var details instanceDetails
+	if slices.Contains(driftChecks[providerName], "image") && instance.Image != "" {
+		details.image = path.Base(instance.Image)
+	}
+
+	// GetInstanceByName does not report the machine type of gcp instances, it
+	// is read from the compute API.
+	if gcloud, ok := cloudProvider.(*gcp.GCloud); ok && gcloud.Service != nil {
+		gcpInstance, err := gcloud.Service.Instances.Get(config.CloudConfig.ProjectID, config.CloudConfig.Zone, instance.Name).Context(ctx).Do()
+		if err != nil {
+			p.GetLogger(ctx).Warningf("failed to get machine type of instance %v: %v", instance.Name, err)
+			return details
+		}
+		details.flavor = path.Base(gcpInstance.MachineType)
+	}
+	return details
+}
+
+// instanceNotFound reports whether err means that the instance does not exist,
+// gcp returns the error of the compute API.
+func instanceNotFound(err error) bool {
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusNotFound
+	}
+	return lepton.IsInstanceNotFoundError(err) || strings.Contains(err.Error(), "instance not found")
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/provider/gcp"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+)
+
+func TestUndetectableDrift(t *testing.T) {
+	tests := []struct {
+		provider string
+		flavor   string
+		zone     string
+		want     []string
+	}{
+		{provider: "onprem", flavor: "large", zone: "local", want: nil},
+		{provider: "aws", want: nil},
+		{provider: "aws", flavor: "t2.micro", zone: "us-east-1", want: []string{"flavor"}},
+		{provider: "gcp", flavor: "e2-small", zone: "us-west1-a", want: []string{"image"}},
+		{provider: "hetzner", flavor: "cx22", zone: "nbg1", want: []string{"flavor", "zone"}},
+		{provider: "hetzner", want: nil},
+		{provider: "digitalocean", flavor: "s-1vcpu-1gb", want: []string{"image", "flavor"}},
+		{provider: "azure", zone: "westeurope", want: []string{"image", "zone"}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s flavor=%q zone=%q", tt.provider, tt.flavor, tt.zone), func(t *testing.T) {
+			config := &types.Config{}
+			config.CloudConfig.Flavor = tt.flavor
+			config.CloudConfig.Zone = tt.zone
+			if got := undetectableDrift(tt.provider, config); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("undetectable drift is %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// driftInputs are the inputs of an instance on provider with the cloud
+// configuration config.
+func driftInputs(provider string, config string) property.Map {
+	return property.NewMap(map[string]property.Value{
+		"name":     property.New("web"),
+		"image":    property.New("web-image"),
+		"provider": property.New(provider),
+		"config":   property.New(config),
+	})
+}
+
+func TestInstanceReadImageDrift(t *testing.T) {
+	cloud := useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+	inputs := driftInputs("aws", `{"CloudConfig":{"ImageName":"web-image"}}`)
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: created.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: read.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.HasChanges {
+		t.Fatalf("unchanged instance differs after a refresh: %+v", diff)
+	}
+
+	// The instance is recreated from another image outside Pulumi.
+	instance := cloud.instances["web"]
+	instance.Image = "other-image"
+	cloud.instances["web"] = instance
+
+	read, err = server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: created.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image := read.Properties.Get("image").AsString(); image != "other-image" {
+		t.Fatalf("image name is %v after a refresh, want other-image", image)
+	}
+	diff, err = server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: read.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasChanges || !diff.DeleteBeforeReplace {
+		t.Fatalf("instance running another image is not replaced: %+v", diff)
+	}
+}
+
+// useFakeGcp makes the resources use gcp with a compute API serving instances
+// until the test ends. Instances that are not in instances are not found.
+func useFakeGcp(t *testing.T, instances map[string]*compute.Instance) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// GET /projects/<project>/zones/<zone>/instances/<name>
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		instance, ok := instances[parts[len(parts)-1]]
+		if r.Method != http.MethodGet || len(parts) < 6 || parts[len(parts)-2] != "instances" || !ok || !strings.HasSuffix(instance.Zone, "/"+parts[len(parts)-3]) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"code":404,"message":"not found"}}`)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(instance); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	service, err := compute.NewService(t.Context(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous := cloudProvider
+	cloudProvider = func(string, *types.ProviderConfig) (lepton.Provider, error) {
+		return &gcp.GCloud{Service: service}, nil
+	}
+	t.Cleanup(func() { cloudProvider = previous })
+}
+
+func TestInstanceReadGcpDrift(t *testing.T) {
+	useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+	inputs := driftInputs("gcp", `{"CloudConfig":{"ImageName":"web-image","ProjectID":"project","Zone":"us-west1-a","Flavor":"e2-small"}}`)
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		instance    *compute.Instance
+		wantFlavor  string
+		wantReplace bool
+	}{
+		{
+			name: "unchanged",
+			instance: &compute.Instance{
+				Name:        "web",
+				Status:      "RUNNING",
+				Zone:        "https://www.googleapis.com/compute/v1/projects/project/zones/us-west1-a",
+				MachineType: "https://www.googleapis.com/compute/v1/projects/project/zones/us-west1-a/machineTypes/e2-small",
+			},
+			wantFlavor: "e2-small",
+		},
+		{
+			name: "flavor",
+			instance: &compute.Instance{
+				Name:        "web",
+				Status:      "RUNNING",
+				Zone:        "https://www.googleapis.com/compute/v1/projects/project/zones/us-west1-a",
+				MachineType: "https://www.googleapis.com/compute/v1/projects/project/zones/us-west1-a/machineTypes/e2-medium",
+			},
+			wantFlavor:  "e2-medium",
+			wantReplace: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useFakeGcp(t, map[string]*compute.Instance{"web": tt.instance})
+			read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: created.Properties, Inputs: inputs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var config types.Config
+			if err := json.Unmarshal([]byte(read.Properties.Get("config").AsString()), &config); err != nil {
+				t.Fatal(err)
+			}
+			if config.CloudConfig.Flavor != tt.wantFlavor {
+				t.Errorf("flavor is %v after a refresh, want %v", config.CloudConfig.Flavor, tt.wantFlavor)
+			}
+			diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: read.Properties, Inputs: inputs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff.HasChanges != tt.wantReplace || diff.DeleteBeforeReplace != tt.wantReplace {
+				t.Errorf("diff after a refresh is %+v, want replace %v", diff, tt.wantReplace)
+			}
+		})
+	}
+
+	// An instance moved to another zone is not found in the configured zone
+	// and created again.
+	useFakeGcp(t, map[string]*compute.Instance{"web": {
+		Name:        "web",
+		Status:      "RUNNING",
+		Zone:        "https://www.googleapis.com/compute/v1/projects/project/zones/us-east1-b",
+		MachineType: "https://www.googleapis.com/compute/v1/projects/project/zones/us-east1-b/machineTypes/e2-small",
+	}})
+	read, err := server.Read(p.ReadRequest{ID: created.ID, Urn: urn, Properties: created.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ID != "" {
+		t.Errorf("instance in another zone is read as %v, want not found", read.ID)
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// elfInfo describes the properties of an elf binary relevant for building a
+// nanos image from it.
+type elfInfo struct {
+	Machine     elf.Machine
+	Class       elf.Class
+	Static      bool
+	Interpreter string
+	Needed      []string
+	RunPath     []string
+}
+
+// inspectElf reads the elf headers of the binary at path.
+func inspectElf(path string) (*elfInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if bytes.HasPrefix(magic, []byte("#!")) {
+		return nil, fmt.Errorf("%s is a script, not an elf binary", path)
+	}
+	if !bytes.Equal(magic, []byte(elf.ELFMAG)) {
+		return nil, fmt.Errorf("%s is not an elf binary", path)
+	}
+
+	ef, err := elf.NewFile(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse elf binary %s: %w", path, err)
+	}
+	defer ef.Close()
+
+	info := &elfInfo{
+		Machine: ef.Machine,
+		Class:   ef.Class,
+		Static:  true,
+	}
+	for _, prog := range ef.Progs {
+		switch prog.Type {
+		case elf.PT_INTERP:
+			data, err := io.ReadAll(prog.Open())
+			if err != nil {
+				return nil, fmt.Errorf("failed to read interpreter of %s: %w", path, err)
+			}
+			info.Interpreter = string(bytes.TrimRight(data, "\x00"))
+			info.Static = false
+		case elf.PT_DYNAMIC:
+			info.Static = false
+		}
+	}
+	if !info.Static {
+		// Static PIE binaries have a dynamic section but no needed libraries.
+		info.Needed, err = ef.ImportedLibraries()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read needed libraries of %s: %w", path, err)
+		}
+		if info.Interpreter == "" && len(info.Needed) == 0 {
+			info.Static = true
+		}
+		// DT_RPATH is ignored by the dynamic loader if DT_RUNPATH is present.
+		runPath, err := ef.DynString(elf.DT_RUNPATH)
+		if err == nil && len(runPath) == 0 {
+			runPath, err = ef.DynString(elf.DT_RPATH)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read run path of %s: %w", path, err)
+		}
+		for _, p := range runPath {
+			info.RunPath = append(info.RunPath, strings.Split(p, ":")...)
+		}
+	}
+	return info, nil
+}
+
+// arch returns the architecture of the binary as used for selecting the
+// nanos kernel: "arm" for arm64 and "amd64" for x86-64.
+func (e *elfInfo) arch() string {
+	if e.Machine == elf.EM_AARCH64 {
+		return "arm"
+	}
+	return "amd64"
+}
+
+// validate returns why nanos can't run the binary, or nil if it can.
+func (e *elfInfo) validate() error {
+	if e.Class != elf.ELFCLASS64 {
+		return fmt.Errorf("unsupported elf class %s, only 64-bit binaries are supported", e.Class)
+	}
+	if e.Machine != elf.EM_X86_64 && e.Machine != elf.EM_AARCH64 {
+		return fmt.Errorf("unsupported machine %s, only x86-64 and arm64 binaries are supported", e.Machine)
+	}
+	if !e.Static && e.Interpreter == "" {
+		return fmt.Errorf("dynamically linked binary needs %v but has no interpreter", e.Needed)
+	}
+	return nil
+}
+
+// checkElf returns why the binary at path can't be used as the program of an
+// image, or an empty string if it can.
+func checkElf(path string) string {
+	info, err := inspectElf(path)
+	if err != nil {
+		return err.Error()
+	}
+	if err := info.validate(); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// String summarizes the binary for logging.
+func (e *elfInfo) String() string {
+	if e.Static {
+		return fmt.Sprintf("%s %s, statically linked", e.Class, e.Machine)
+	}
+	return fmt.Sprintf("%s %s, dynamically linked, interpreter %s, needs %v", e.Class, e.Machine, e.Interpreter, e.Needed)
+}
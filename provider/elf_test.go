@@ -0,0 +1,258 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// dynamicElf describes the dynamic linking of an elf binary written by
+// writeDynamicElf.
+type dynamicElf struct {
+	interpreter string
+	needed      []string
+	runPath     string
+	rpath       string
+}
+
+// writeDynamicElf writes a dynamically linked 64-bit elf binary for machine,
+// only consisting of the headers, the interpreter and the dynamic section.
+func writeDynamicElf(t *testing.T, path string, machine elf.Machine, d dynamicElf) {
+	t.Helper()
+	var progs []elf.Prog64
+	if d.interpreter != "" {
+		progs = append(progs, elf.Prog64{Type: uint32(elf.PT_INTERP), Flags: uint32(elf.PF_R), Align: 1})
+	}
+	progs = append(progs, elf.Prog64{Type: uint32(elf.PT_DYNAMIC), Flags: uint32(elf.PF_R | elf.PF_W), Align: 8})
+
+	dynstr := []byte{0}
+	var dyns []elf.Dyn64
+	addString := func(tag elf.DynTag, s string) {
+		dyns = append(dyns, elf.Dyn64{Tag: int64(tag), Val: uint64(len(dynstr))})
+		dynstr = append(append(dynstr, s...), 0)
+	}
+	for _, lib := range d.needed {
+		addString(elf.DT_NEEDED, lib)
+	}
+	if d.runPath != "" {
+		addString(elf.DT_RUNPATH, d.runPath)
+	}
+	if d.rpath != "" {
+		addString(elf.DT_RPATH, d.rpath)
+	}
+	dyns = append(dyns, elf.Dyn64{Tag: int64(elf.DT_NULL)})
+
+	const headerSize, progSize, sectionSize, dynSize = 64, 56, 64, 16
+	interpOff := uint64(headerSize + progSize*len(progs))
+	interp := append([]byte(d.interpreter), 0)
+	dynstrOff := interpOff + uint64(len(interp))
+	dynamicOff := (dynstrOff + uint64(len(dynstr)) + 7) &^ 7
+	shoff := dynamicOff + uint64(dynSize*len(dyns))
+
+	i := 0
+	if d.interpreter != "" {
+		progs[0].Off, progs[0].Filesz, progs[0].Memsz = interpOff, uint64(len(interp)), uint64(len(interp))
+		i++
+	}
+	progs[i].Off, progs[i].Filesz, progs[i].Memsz = dynamicOff, uint64(dynSize*len(dyns)), uint64(dynSize*len(dyns))
+
+	header := elf.Header64{
+		Type:      uint16(elf.ET_DYN),
+		Machine:   uint16(machine),
+		Version:   uint32(elf.EV_CURRENT),
+		Phoff:     headerSize,
+		Shoff:     shoff,
+		Ehsize:    headerSize,
+		Phentsize: progSize,
+		Phnum:     uint16(len(progs)),
+		Shentsize: sectionSize,
+		Shnum:     3,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	sections := []elf.Section64{
+		{},
+		{Type: uint32(elf.SHT_STRTAB), Off: dynstrOff, Size: uint64(len(dynstr)), Addralign: 1},
+		{Type: uint32(elf.SHT_DYNAMIC), Off: dynamicOff, Size: uint64(dynSize * len(dyns)), Link: 1, Addralign: 8, Entsize: dynSize},
+	}
+
+	var buf bytes.Buffer
+	for _, data := range []any{header, progs, interp, dynstr, make([]byte, dynamicOff-dynstrOff-uint64(len(dynstr))), dyns, sections} {
+		if err := binary.Write(&buf, binary.LittleEndian, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeElf32 writes a 32-bit elf binary for machine, only consisting of the
+// elf header.
+func writeElf32(t *testing.T, path string, machine elf.Machine) {
+	t.Helper()
+	header := elf.Header32{
+		Type:    uint16(elf.ET_EXEC),
+		Machine: uint16(machine),
+		Version: uint32(elf.EV_CURRENT),
+		Ehsize:  52,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS32)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, header); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInspectElf(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, data string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	static := filepath.Join(dir, "static")
+	writeElf(t, static, elf.EM_X86_64)
+	arm := filepath.Join(dir, "arm")
+	writeElf(t, arm, elf.EM_AARCH64)
+	dynamic := filepath.Join(dir, "dynamic")
+	writeDynamicElf(t, dynamic, elf.EM_X86_64, dynamicElf{
+		interpreter: "/lib64/ld-linux-x86-64.so.2",
+		needed:      []string{"libssl.so.3", "libc.so.6"},
+		runPath:     "$ORIGIN/../lib:/opt/lib",
+		rpath:       "/ignored",
+	})
+	rpath := filepath.Join(dir, "rpath")
+	writeDynamicElf(t, rpath, elf.EM_AARCH64, dynamicElf{
+		interpreter: "/lib/ld-linux-aarch64.so.1",
+		needed:      []string{"libc.so.6"},
+		rpath:       "/opt/lib",
+	})
+	staticPie := filepath.Join(dir, "static-pie")
+	writeDynamicElf(t, staticPie, elf.EM_X86_64, dynamicElf{})
+
+	tests := []struct {
+		name    string
+		path    string
+		want    *elfInfo
+		wantErr string
+	}{
+		{name: "static", path: static, want: &elfInfo{Machine: elf.EM_X86_64, Class: elf.ELFCLASS64, Static: true}},
+		{name: "arm", path: arm, want: &elfInfo{Machine: elf.EM_AARCH64, Class: elf.ELFCLASS64, Static: true}},
+		{
+			name: "dynamic",
+			path: dynamic,
+			want: &elfInfo{
+				Machine:     elf.EM_X86_64,
+				Class:       elf.ELFCLASS64,
+				Interpreter: "/lib64/ld-linux-x86-64.so.2",
+				Needed:      []string{"libssl.so.3", "libc.so.6"},
+				RunPath:     []string{"$ORIGIN/../lib", "/opt/lib"},
+			},
+		},
+		{
+			name: "rpath",
+			path: rpath,
+			want: &elfInfo{
+				Machine:     elf.EM_AARCH64,
+				Class:       elf.ELFCLASS64,
+				Interpreter: "/lib/ld-linux-aarch64.so.1",
+				Needed:      []string{"libc.so.6"},
+				RunPath:     []string{"/opt/lib"},
+			},
+		},
+		{name: "static pie", path: staticPie, want: &elfInfo{Machine: elf.EM_X86_64, Class: elf.ELFCLASS64, Static: true}},
+		{name: "script", path: write("script", "#!/bin/sh\necho hello\n"), wantErr: "%s is a script, not an elf binary"},
+		{name: "text", path: write("text", "hello world\n"), wantErr: "%s is not an elf binary"},
+		{name: "empty", path: write("empty", ""), wantErr: "%s is not an elf binary"},
+		{name: "truncated", path: write("truncated", elf.ELFMAG), wantErr: "failed to parse elf binary %s: "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := inspectElf(tt.path)
+			if tt.wantErr != "" {
+				want := fmt.Sprintf(tt.wantErr, tt.path)
+				if err == nil || !strings.HasPrefix(err.Error(), want) {
+					t.Fatalf("error is %v, want %s", err, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(info, tt.want) {
+				t.Fatalf("elf info is %+v, want %+v", info, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckElf(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name  string
+		write func(path string)
+		want  string
+	}{
+		{name: "x86-64", write: func(path string) { writeElf(t, path, elf.EM_X86_64) }},
+		{name: "arm64", write: func(path string) { writeElf(t, path, elf.EM_AARCH64) }},
+		{
+			name: "dynamic",
+			write: func(path string) {
+				writeDynamicElf(t, path, elf.EM_X86_64, dynamicElf{interpreter: "/lib64/ld-linux-x86-64.so.2", needed: []string{"libc.so.6"}})
+			},
+		},
+		{
+			name:  "unsupported machine",
+			write: func(path string) { writeElf(t, path, elf.EM_RISCV) },
+			want:  "unsupported machine EM_RISCV, only x86-64 and arm64 binaries are supported",
+		},
+		{
+			name:  "32-bit",
+			write: func(path string) { writeElf32(t, path, elf.EM_386) },
+			want:  "unsupported elf class ELFCLASS32, only 64-bit binaries are supported",
+		},
+		{
+			name:  "no interpreter",
+			write: func(path string) { writeDynamicElf(t, path, elf.EM_X86_64, dynamicElf{needed: []string{"libc.so.6"}}) },
+			want:  "dynamically linked binary needs [libc.so.6] but has no interpreter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, tt.name)
+			tt.write(path)
+			if got := checkElf(path); got != tt.want {
+				t.Fatalf("checkElf is %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestElfArch(t *testing.T) {
+	for machine, want := range map[elf.Machine]string{elf.EM_X86_64: "amd64", elf.EM_AARCH64: "arm"} {
+		info := &elfInfo{Machine: machine, Class: elf.ELFCLASS64}
+		if got := info.arch(); got != want {
+			t.Errorf("architecture of %s is %v, want %v", machine, got, want)
+		}
+	}
+}
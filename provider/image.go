@@ -3,15 +3,17 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 
 	"github.com/nanovms/ops/lepton"
-	"github.com/nanovms/ops/provider"
 	"github.com/nanovms/ops/types"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -37,61 +39,107 @@ func (i *Image) Annotate(a infer.Annotator) {
 }
 
 type ImageArgs struct {
-	Name            string `pulumi:"name"`
-	Elf             string `pulumi:"elf"`
-	Config          string `pulumi:"config,optional"`
-	Provider        string `pulumi:"provider"`
-	Force           bool   `pulumi:"force,optional"`
-	UseLatestKernel bool   `pulumi:"useLatestKernel,optional"`
+	Name            string     `pulumi:"name"`
+	Elf             string     `pulumi:"elf"`
+	Args            []string   `pulumi:"args,optional"`
+	Config          string     `pulumi:"config,optional"`
+	OpsConfig       *OpsConfig `pulumi:"opsConfig,optional"`
+	Provider        string     `pulumi:"provider,optional"`
+	Force           bool       `pulumi:"force,optional"`
+	UseLatestKernel bool       `pulumi:"useLatestKernel,optional"`
+	KernelVersion   string     `pulumi:"kernelVersion,optional"`
+	OpsHome         string     `pulumi:"opsHome,optional"`
+	IncludeLdd      bool       `pulumi:"includeLdd,optional"`
+	Sysroot         string     `pulumi:"sysroot,optional"`
 }
 
 func (i *ImageArgs) Annotate(a infer.Annotator) {
 	a.Describe(&i.Name, "The name of the image")
 	a.Describe(&i.Elf, "The path to the executable file")
-	a.Describe(&i.Config, "The configuration as a JSON encoded string")
+	a.Describe(&i.Args, "The arguments the program is started with, appended to the args of the configuration")
+	a.Describe(&i.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Deprecate(&i.Config, "use opsConfig instead")
+	a.Describe(&i.OpsConfig, "The configuration of the image")
 	a.Describe(&i.Provider, "The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)")
 	a.Describe(&i.Force, "If an already existing image should be deleted if it exists")
 	a.Describe(&i.UseLatestKernel, "If the latest kernel should be used, download it if necessary")
+	a.Describe(&i.KernelVersion, "The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration")
+	a.Describe(&i.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
+	a.Describe(&i.IncludeLdd, "If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image")
+	a.Describe(&i.Sysroot, "The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /")
 }
 
 type ImageState struct {
-	ImagePath       string `pulumi:"imagePath"`
-	ImageName       string `pulumi:"imageName"`
-	Config          string `pulumi:"config"`
-	Provider        string `pulumi:"provider"`
-	UseLatestKernel bool   `pulumi:"useLatestKernel"`
+	ImagePath       string   `pulumi:"imagePath"`
+	ImageName       string   `pulumi:"imageName"`
+	Config          string   `pulumi:"config"`
+	Provider        string   `pulumi:"provider"`
+	UseLatestKernel bool     `pulumi:"useLatestKernel"`
+	KernelVersion   string   `pulumi:"kernelVersion,optional"`
+	ContentHash     string   `pulumi:"contentHash,optional"`
+	SharedLibraries []string `pulumi:"sharedLibraries,optional"`
+	Args            []string `pulumi:"args,optional"`
 }
 
 func (i *ImageState) Annotate(a infer.Annotator) {
-	fmt.Fprintf(os.Stderr, "inferrer: %v ; i: %v\n", a, i)
 	a.Describe(&i.ImagePath, "The path to the built image")
 	a.Describe(&i.ImageName, "The name of the built image")
 	a.Describe(&i.Config, "The configuration of the built image as a JSON encoded string")
 	a.Describe(&i.Provider, "The cloud provider of the built image")
 	a.Describe(&i.UseLatestKernel, "If the latest kernel should be used, download it if necessary")
+	a.Describe(&i.KernelVersion, "The nanos kernel version the image is built with")
+	a.Describe(&i.ContentHash, "The digest of the elf and all files and directories included into the image")
+	a.Describe(&i.SharedLibraries, "The paths of the dynamic loader and shared libraries added to the image because of includeLdd")
+	a.Describe(&i.Args, "The command line of the program in the image, the program name followed by its arguments")
 }
 
 func (*Image) Create(ctx context.Context, req infer.CreateRequest[ImageArgs]) (infer.CreateResponse[ImageState], error) {
 	var resp infer.CreateResponse[ImageState]
 
-	if _, err := os.Stat(req.Inputs.Elf); os.IsNotExist(err) {
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	// During preview the elf may not exist yet, if it is built earlier in the
+	// deployment.
+	if info, err := os.Stat(req.Inputs.Elf); os.IsNotExist(err) && !req.DryRun {
 		return resp, fmt.Errorf("elf file with path %s not found", req.Inputs.Elf)
+	} else if err == nil && info.IsDir() {
+		return resp, fmt.Errorf("elf file with path %s is a directory", req.Inputs.Elf)
 	}
 
 	builder, err := createBuilder(ctx, req.Inputs, true)
 	if err != nil {
 		return resp, err
 	}
+	defer builder.release()
+
+	// During preview the digest is unknown (empty) until the elf and the
+	// included files exist.
+	contentHash, err := contentDigest(builder.config)
+	if errors.Is(err, fs.ErrNotExist) && req.DryRun {
+		p.GetLogger(ctx).Infof("content digest unknown until the files exist: %v", err)
+		contentHash = ""
+	} else if err != nil {
+		return resp, err
+	}
+
+	if err := validateBuild(builder.config); err != nil {
+		return resp, err
+	}
 
 	if req.DryRun { // Don't do the actual creating if in preview
+		// The image path is only known after building, it is marked unknown
+		// through its dependencies in WireDependencies.
 		return infer.CreateResponse[ImageState]{
 			ID: req.Inputs.Name,
 			Output: ImageState{
-				ImagePath:       req.Inputs.Name,
-				ImageName:       req.Inputs.Elf,
+				ImageName:       req.Inputs.Name,
 				Config:          string(builder.configAsJson),
 				Provider:        req.Inputs.Provider,
 				UseLatestKernel: req.Inputs.UseLatestKernel,
+				KernelVersion:   builder.config.NanosVersion,
+				ContentHash:     contentHash,
+				SharedLibraries: builder.sharedLibraries,
+				Args:            builder.config.Args,
 			},
 		}, nil
 	}
@@ -131,6 +179,10 @@ func (*Image) Create(ctx context.Context, req infer.CreateRequest[ImageArgs]) (i
 			Config:          string(builder.configAsJson),
 			Provider:        req.Inputs.Provider,
 			UseLatestKernel: req.Inputs.UseLatestKernel,
+			KernelVersion:   builder.config.NanosVersion,
+			ContentHash:     contentHash,
+			SharedLibraries: builder.sharedLibraries,
+			Args:            builder.config.Args,
 		},
 	}, nil
 }
@@ -147,7 +199,9 @@ func (*Image) Delete(ctx context.Context, req infer.DeleteRequest[ImageState]) (
 		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to create provider: %w", err)
 	}
@@ -166,7 +220,11 @@ func (*Image) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckRes
 	if _, ok := req.NewInputs.GetOk("name"); !ok {
 		req.NewInputs = req.NewInputs.Set("name", property.New(req.Name))
 	}
-	args, fails, err := infer.DefaultCheck[ImageArgs](ctx, req.NewInputs)
+	req.NewInputs = setDefaultProvider(ctx, req.NewInputs)
+	var fails []p.CheckFailure
+	req.NewInputs, fails = pruneOpsConfig(req.NewInputs)
+	args, defaultFails, err := infer.DefaultCheck[ImageArgs](ctx, req.NewInputs)
+	fails = append(fails, defaultFails...)
 
 	provider, ok := req.NewInputs.GetOk("provider")
 	if !ok {
@@ -183,24 +241,52 @@ func (*Image) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckRes
 	// Note: Provider validation is now done at runtime when creating the cloud provider.
 	// This allows all providers supported by ops/lepton to be used.
 
-	config, ok := req.NewInputs.GetOk("config")
-	if ok {
-		if !config.IsString() {
+	if sysroot, ok := req.NewInputs.GetOk("sysroot"); ok && sysroot.IsString() && sysroot.AsString() != "" {
+		if includeLdd, ok := req.NewInputs.GetOk("includeLdd"); !ok || !includeLdd.IsBool() || !includeLdd.AsBool() {
 			fails = append(fails, p.CheckFailure{
-				Property: "config",
-				Reason:   "config must be a (JSON encoded) string",
+				Property: "sysroot",
+				Reason:   "sysroot is only used with includeLdd",
 			})
-		} else {
-			var c types.Config
-			err := json.Unmarshal([]byte(config.AsString()), &c)
-			if err != nil {
+		}
+		if elf, ok := req.NewInputs.GetOk("elf"); ok && elf.IsString() && filepath.IsAbs(elf.AsString()) && sysroot.AsString() != "/" {
+			fails = append(fails, p.CheckFailure{
+				Property: "elf",
+				Reason:   "elf must be a relative path when a sysroot is set, ops looks up absolute paths inside the sysroot",
+			})
+		}
+	}
+
+	if kernelVersion, ok := req.NewInputs.GetOk("kernelVersion"); ok && kernelVersion.IsString() && kernelVersion.AsString() != "" {
+		if useLatestKernel, ok := req.NewInputs.GetOk("useLatestKernel"); ok && useLatestKernel.IsBool() && useLatestKernel.AsBool() {
+			fails = append(fails, p.CheckFailure{
+				Property: "kernelVersion",
+				Reason:   "kernelVersion and useLatestKernel cannot both be set",
+			})
+		}
+	}
+
+	// The elf may not exist yet if it is built during the deployment, in which
+	// case it is checked on create.
+	if elf, ok := req.NewInputs.GetOk("elf"); ok && elf.IsString() {
+		if info, err := os.Stat(elf.AsString()); err == nil && !info.IsDir() {
+			if reason := checkElf(elf.AsString()); reason != "" {
 				fails = append(fails, p.CheckFailure{
-					Property: "config",
-					Reason:   fmt.Sprintf("invalid config: %v", err),
+					Property: "elf",
+					Reason:   reason,
 				})
 			}
 		}
-	} else {
+	}
+
+	// The klibs are looked up for the architecture of the elf if it exists.
+	arch := kernelArchitecture(ctx, "")
+	if elf, ok := req.NewInputs.GetOk("elf"); ok && elf.IsString() {
+		if info, err := inspectElf(elf.AsString()); err == nil {
+			arch = info.arch()
+		}
+	}
+	fails = append(fails, checkConfig(ctx, req.NewInputs, args.OpsConfig, checkKernel(ctx, req.NewInputs, arch))...)
+	if _, ok := req.NewInputs.GetOk("config"); !ok {
 		p.GetLogger(ctx).Info("empty config field, using defaults")
 	}
 
@@ -217,21 +303,49 @@ func (i *Image) Update(ctx context.Context, req infer.UpdateRequest[ImageArgs, I
 
 	createRequest := infer.CreateRequest[ImageArgs]{Inputs: req.Inputs, DryRun: req.DryRun}
 	res, err := i.Create(ctx, createRequest)
+	if req.DryRun {
+		// Rebuilding with the same name results in the same path.
+		res.Output.ImagePath = req.State.ImagePath
+	}
 
 	resp := infer.UpdateResponse[ImageState]{Output: res.Output}
 	return resp, err
 }
 
 func (*Image) Diff(ctx context.Context, req infer.DiffRequest[ImageArgs, ImageState]) (infer.DiffResponse, error) {
+	defer useOpsHome(req.Inputs.OpsHome)()
+
 	builder, err := createBuilder(ctx, req.Inputs, false)
 	if err != nil {
 		return infer.DiffResponse{}, err
 	}
+	defer builder.release()
 
 	diff := map[string]p.PropertyDiff{}
 	if req.Inputs.Name != req.State.ImageName {
 		diff["name"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if req.State.KernelVersion != "" && builder.config.NanosVersion != req.State.KernelVersion {
+		p.GetLogger(ctx).Infof("kernel version changes from %s to %s", req.State.KernelVersion, builder.config.NanosVersion)
+		diff["kernelVersion"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if req.State.Args != nil && !slices.Equal(builder.config.Args, req.State.Args) {
+		p.GetLogger(ctx).Infof("arguments change from %v to %v", req.State.Args, builder.config.Args)
+		diff["args"] = p.PropertyDiff{Kind: p.Update}
+	}
+	// Files that don't exist yet are built during the deployment, after which
+	// the image is rebuilt.
+	if contentHash, err := contentDigest(builder.config); errors.Is(err, fs.ErrNotExist) {
+		p.GetLogger(ctx).Infof("%v, the image is rebuilt once it exists", err)
+		diff["elf"] = p.PropertyDiff{Kind: p.Update}
+	} else if err != nil {
+		return infer.DiffResponse{}, err
+	} else if req.State.ContentHash == "" {
+		p.GetLogger(ctx).Debugf("no content hash in state, skipping content comparison")
+	} else if contentHash != req.State.ContentHash {
+		p.GetLogger(ctx).Infof("content of %s or included files changed", req.Inputs.Elf)
+		diff["elf"] = p.PropertyDiff{Kind: p.Update}
+	}
 	patch, err := jsondiff.CompareJSON([]byte(req.State.Config), []byte(builder.configAsJson))
 	if err != nil {
 		return infer.DiffResponse{}, err
@@ -256,6 +370,38 @@ func (*Image) Diff(ctx context.Context, req infer.DiffRequest[ImageArgs, ImageSt
 func (Image) Read(ctx context.Context, req infer.ReadRequest[ImageArgs, ImageState]) (infer.ReadResponse[ImageArgs, ImageState], error) {
 	resp := infer.ReadResponse[ImageArgs, ImageState](req)
 
+	if req.State.Config == "" {
+		// Only the ID is known when the image is imported.
+		imported, err := importImage(ctx, req.ID)
+		if err != nil {
+			return resp, err
+		}
+		if imported == nil {
+			p.GetLogger(ctx).Errorf("image with import ID %v not found", req.ID)
+			resp.ID = ""
+			return resp, nil
+		}
+		// The program is not recorded in the image, elf is required though.
+		p.GetLogger(ctx).Warningf("the program of image %s is unknown, replace the elf %q in the imported code with its path", imported.name, importedElf)
+		return infer.ReadResponse[ImageArgs, ImageState]{
+			ID: imported.name,
+			Inputs: ImageArgs{
+				Name:          imported.name,
+				Elf:           importedElf,
+				Provider:      imported.provider,
+				KernelVersion: imported.kernelVersion,
+				OpsConfig:     imported.opsConfig(),
+			},
+			State: ImageState{
+				ImagePath:     imported.name,
+				ImageName:     imported.name,
+				Config:        imported.config,
+				Provider:      imported.provider,
+				KernelVersion: imported.kernelVersion,
+			},
+		}, nil
+	}
+
 	var config types.Config
 
 	err := json.Unmarshal([]byte(req.State.Config), &config)
@@ -263,7 +409,9 @@ func (Image) Read(ctx context.Context, req infer.ReadRequest[ImageArgs, ImageSta
 		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to create provider: %w", err)
 	}
@@ -297,69 +445,128 @@ func (Image) Read(ctx context.Context, req infer.ReadRequest[ImageArgs, ImageSta
 }
 
 func (*Image) WireDependencies(f infer.FieldSelector, args *ImageArgs, state *ImageState) {
-	f.OutputField(&state.ImageName).DependsOn(f.InputField(&args.Elf))
-	f.OutputField(&state.ImagePath).DependsOn(f.InputField(&args.Name))
-	f.OutputField(&state.Config).DependsOn(f.InputField(&args.Config))
+	f.OutputField(&state.ImageName).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.ImagePath).DependsOn(f.InputField(&args.Name), f.InputField(&args.Elf), f.InputField(&args.Args), f.InputField(&args.Provider), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.OpsHome))
+	f.OutputField(&state.Config).DependsOn(f.InputField(&args.Name), f.InputField(&args.Elf), f.InputField(&args.Args), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.UseLatestKernel), f.InputField(&args.KernelVersion), f.InputField(&args.OpsHome))
 	f.OutputField(&state.Provider).DependsOn(f.InputField(&args.Provider))
 	f.OutputField(&state.UseLatestKernel).DependsOn(f.InputField(&args.UseLatestKernel))
+	f.OutputField(&state.KernelVersion).DependsOn(f.InputField(&args.UseLatestKernel), f.InputField(&args.KernelVersion), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.OpsHome))
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.Elf), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.IncludeLdd), f.InputField(&args.Sysroot))
+	f.OutputField(&state.SharedLibraries).DependsOn(f.InputField(&args.Elf), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.IncludeLdd), f.InputField(&args.Sysroot))
+	f.OutputField(&state.Args).DependsOn(f.InputField(&args.Elf), f.InputField(&args.Args), f.InputField(&args.Config), f.InputField(&args.OpsConfig))
 }
 
 type builder struct {
 	config       *types.Config
 	configAsJson string
 	provider     lepton.Provider
+	release      func()
+
+	sharedLibraries []string
 }
 
-func createBuilder(ctx context.Context, args ImageArgs, building bool) (*builder, error) {
+func createBuilder(ctx context.Context, args ImageArgs, building bool) (b *builder, err error) {
 	config := lepton.NewConfig()
 
-	if args.Config == "" {
-		p.GetLogger(ctx).Warning("no config provided, using defaults")
-	} else {
-		err := json.Unmarshal([]byte(args.Config), config)
-		if err != nil {
-			return nil, fmt.Errorf("cannot unmarshal config: %w", err)
-		}
+	if err := mergeConfig(ctx, config, args.OpsConfig, args.Config); err != nil {
+		return nil, err
 	}
 
 	// Note that the 'ops' tool sets various default values for the config when it
 	// does `NewMergeConfigContainer` with the command line arguments.
 	// Below sets the defaults similar to the 'ops' tool.
 
+	config.Home = args.OpsHome
 	config.Program = args.Elf
-	config.Args = []string{filepath.Base(args.Elf)}
+	// The program name comes first, followed by the args of the configuration
+	// (those of config replace those of opsConfig) and then the args input.
+	config.Args = slices.Concat([]string{filepath.Base(args.Elf)}, config.Args, args.Args)
 	config.RunConfig.ImageName = path.Join(lepton.GetOpsHome(), "images", args.Name)
 	config.CloudConfig.ImageName = args.Name
 
-	arch := archCheck(config.Program)
-	if arch != runtime.GOARCH && (arch+"64" != runtime.GOARCH) {
+	// The elf may not exist yet if it is built during the deployment, only
+	// the build itself requires it. Until then the default architecture is
+	// assumed and its shared libraries are unknown.
+	var elfInfo *elfInfo
+	elfMissing := false
+	if _, err := os.Stat(config.Program); os.IsNotExist(err) {
+		p.GetLogger(ctx).Infof("elf %s does not exist (yet), assuming architecture %s", config.Program, defaultElfArch(ctx))
+		elfMissing = true
+	} else {
+		elfInfo, err = inspectElf(config.Program)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inspect elf: %w", err)
+		}
+		if err := elfInfo.validate(); err != nil {
+			return nil, fmt.Errorf("unsupported elf %s: %w", config.Program, err)
+		}
+		if building {
+			p.GetLogger(ctx).Debugf("Elf %s: %s", config.Program, elfInfo)
+		}
+	}
+
+	var sharedLibraries []string
+	if args.IncludeLdd && !elfMissing {
+		sysroot := args.Sysroot
+		if sysroot == "" {
+			sysroot = config.TargetRoot
+		}
+		if sysroot == "" {
+			sysroot = "/"
+		}
+		if sysroot != "/" {
+			if config.TargetRoot != "" && config.TargetRoot != sysroot {
+				return nil, fmt.Errorf("sysroot %s conflicts with targetRoot %s of the configuration", sysroot, config.TargetRoot)
+			}
+			// ops looks up absolute paths of files in the target root
+			config.TargetRoot = sysroot
+		}
+		sharedLibraries, err = sharedLibs(sysroot, config.Program, elfInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve shared libraries of %s: %w", config.Program, err)
+		}
+		for _, lib := range sharedLibraries {
+			if !slices.Contains(config.Files, lib) {
+				config.Files = append(config.Files, lib)
+			}
+		}
 		if building {
-			p.GetLogger(ctx).Warningf("Warning: Detected %s architecture in Elf binary, but running on %s, building image for %s", arch, runtime.GOARCH, arch)
+			p.GetLogger(ctx).Infof("Adding shared libraries: %v", sharedLibraries)
 		}
-		lepton.AltGOARCH = arch
 	}
 
-	version, err := getCurrentVersion(ctx, args.UseLatestKernel, arch)
+	arch := defaultElfArch(ctx)
+	if !elfMissing {
+		arch = elfInfo.arch()
+	}
+	if building && altGOARCHFor(arch) != "" {
+		p.GetLogger(ctx).Warningf("Warning: Detected %s architecture in Elf binary, but running on %s, building image for %s", arch, runtime.GOARCH, arch)
+	}
+	// Keep the architecture until the image is built, the caller releases it.
+	release := altGOARCH.use(arch)
+	defer func() {
+		if err != nil {
+			release()
+		}
+	}()
+
+	version, err := resolveNanosVersion(ctx, args.UseLatestKernel, args.KernelVersion, arch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get kernel version: %w", err)
 	}
 
+	releaseDir := releaseDirName(version, arch)
 	if config.Kernel == "" {
 		config.NanosVersion = version
-
-		if strings.Contains(arch, "arm") {
-			version += "-arm"
-		}
-
-		config.Kernel = getKernelVersion(version)
+		config.Kernel = getKernelVersion(releaseDir)
 		if building {
 			p.GetLogger(ctx).Infof("Using kernel version %s", config.Kernel)
 		}
 		config.RunConfig.Kernel = config.Kernel
 	}
-	config.UefiBoot = lepton.GetUefiBoot(version)
+	config.UefiBoot = lepton.GetUefiBoot(releaseDir)
 	if config.Boot == "" {
-		bootPath := path.Join(lepton.GetOpsHome(), version, "boot.img")
+		bootPath := path.Join(lepton.GetOpsHome(), releaseDir, "boot.img")
 		if _, err := os.Stat(bootPath); err == nil {
 			config.Boot = bootPath
 		}
@@ -370,7 +577,7 @@ func createBuilder(ctx context.Context, args ImageArgs, building bool) (*builder
 		config.NanosVersion = lepton.LocalReleaseVersion
 	}
 
-	provider, err := provider.CloudProvider(args.Provider, &config.CloudConfig)
+	provider, err := cloudProvider(args.Provider, &config.CloudConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cloud provider: %w", err)
 	}
@@ -383,5 +590,35 @@ func createBuilder(ctx context.Context, args ImageArgs, building bool) (*builder
 		config:       config,
 		configAsJson: string(resultingConfig),
 		provider:     provider,
+		release:      release,
+
+		sharedLibraries: sharedLibraries,
 	}, nil
 }
+
+// defaultElfArch returns the architecture, as returned by elfInfo.arch, of an
+// elf that doesn't exist yet: that of the provider configuration or the
+// current system.
+func defaultElfArch(ctx context.Context) string {
+	if strings.Contains(kernelArchitecture(ctx, ""), "arm") {
+		return "arm"
+	}
+	return "amd64"
+}
+
+// validateBuild checks that the kernel and boot image referenced by the config
+// can be found, so problems show up during preview instead of halfway
+// through building the image.
+func validateBuild(config *types.Config) error {
+	if _, err := os.Stat(config.Kernel); err != nil {
+		return fmt.Errorf("kernel %s not found: %w", config.Kernel, err)
+	}
+	if config.Boot != "" {
+		if _, err := os.Stat(config.Boot); err != nil {
+			return fmt.Errorf("boot image %s not found: %w", config.Boot, err)
+		}
+	} else if config.UefiBoot == "" {
+		return fmt.Errorf("no boot image found for nanos version %s", config.NanosVersion)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/nanovms/ops/fs"
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// importID splits the ID given to 'pulumi import', <provider>/<name>, into the
+// cloud provider and the name of the resource. Without a provider the
+// defaultProvider of the provider configuration is used.
+func importID(ctx context.Context, id string) (providerName string, name string, err error) {
+	providerName, name, found := strings.Cut(id, "/")
+	if !found {
+		providerName, name = "", id
+	}
+	if name == "" {
+		return "", "", fmt.Errorf("invalid import ID %q, expected <provider>/<name>", id)
+	}
+	providerName, err = resolveProviderName(ctx, providerName)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid import ID %q: %w", id, err)
+	}
+	return providerName, name, nil
+}
+
+// importedElf is the elf of imported images, which has to be replaced with the
+// path of the program the image was built from.
+const importedElf = "<path of the program>"
+
+// opsEnv are the environment variables ops adds to the manifest of every image,
+// they are not part of the configuration of an imported image.
+var opsEnv = []string{"OPS_VERSION", "NANOS_VERSION", "NANOS_ARCH", "IMAGE_NAME", "RADAR_IMAGE_NAME", "OPS_PORT"}
+
+// importedImage is what can be recovered of an image that was not created by
+// this provider, e.g. by 'ops image create'.
+type importedImage struct {
+	provider      string
+	name          string
+	config        string
+	kernelVersion string
+	architecture  string
+	env           map[string]string
+}
+
+func (i *importedImage) opsConfig() *OpsConfig {
+	if len(i.env) == 0 {
+		return nil
+	}
+	return &OpsConfig{Env: i.env}
+}
+
+// importImage looks up the image with the given import ID with GetImages. If
+// the image file is available locally, as it is for onprem images, the kernel
+// version, architecture and environment are read from its manifest. It returns
+// nil if the image does not exist.
+func importImage(ctx context.Context, id string) (*importedImage, error) {
+	providerName, name, err := importID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, providerName, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	config := opsContext.Config()
+	config.RunConfig.ImageName = path.Join(lepton.GetOpsHome(), "images", name)
+	config.CloudConfig.ImageName = name
+
+	images, err := provider.GetImages(opsContext, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %w", err)
+	}
+	var image *lepton.CloudImage
+	for i := range images {
+		if images[i].Name == name {
+			image = &images[i]
+			break
+		}
+	}
+	if image == nil {
+		return nil, nil
+	}
+	p.GetLogger(ctx).Debugf("importing image: %v ; %v ; %v ; %v", image.ID, image.Name, image.Path, image)
+
+	configAsJson, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal config: %w", err)
+	}
+	imported := &importedImage{
+		provider: providerName,
+		name:     name,
+		config:   string(configAsJson),
+	}
+
+	localPath := config.RunConfig.ImageName
+	if providerName == "onprem" && image.Path != "" {
+		localPath = image.Path
+	}
+	if _, err := os.Stat(localPath); err != nil {
+		p.GetLogger(ctx).Debugf("no local image file for %s, not reading its manifest", name)
+		return imported, nil
+	}
+	reader, err := fs.NewReader(localPath)
+	if err != nil {
+		p.GetLogger(ctx).Warningf("failed to read manifest of image %s: %v", localPath, err)
+		return imported, nil
+	}
+	defer reader.Close()
+
+	env := reader.ListEnv()
+	imported.kernelVersion = env["NANOS_VERSION"]
+	imported.architecture = env["NANOS_ARCH"]
+	for _, key := range opsEnv {
+		delete(env, key)
+	}
+	if env["USER"] == "root" {
+		delete(env, "USER")
+	}
+	if env["PWD"] == "/" {
+		delete(env, "PWD")
+	}
+	imported.env = env
+	return imported, nil
+}
+
+// importInstance looks up the instance with the given import ID, by name. The
+// instance name and the ports are recovered into the configuration, so an
+// unchanged program keeps the instance instead of replacing it. It returns
+// false if the instance does not exist.
+func importInstance(ctx context.Context, id string) (InstanceArgs, InstanceState, bool, error) {
+	providerName, name, err := importID(ctx, id)
+	if err != nil {
+		return InstanceArgs{}, InstanceState{}, false, err
+	}
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, providerName, nil, "")
+	if err != nil {
+		return InstanceArgs{}, InstanceState{}, false, err
+	}
+	instance, err := provider.GetInstanceByName(opsContext, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "instance not found") {
+			return InstanceArgs{}, InstanceState{}, false, nil
+		}
+		return InstanceArgs{}, InstanceState{}, false, fmt.Errorf("failed to get instance information: %w", err)
+	}
+	p.GetLogger(ctx).Debugf("importing instance: %v ; %v ; %v ; %v", instance.ID, instance.Name, instance.Image, instance)
+
+	runConfig := &OpsRunConfig{InstanceName: instance.Name}
+	for _, port := range instance.Ports {
+		port = strings.TrimSpace(port)
+		if port == "" {
+			continue
+		}
+		if reason := checkPortSpec(port); reason != "" {
+			p.GetLogger(ctx).Debugf("not importing port of instance %s: %s", name, reason)
+			continue
+		}
+		runConfig.Ports = append(runConfig.Ports, port)
+	}
+	args := InstanceArgs{
+		Provider:  providerName,
+		OpsConfig: &OpsConfig{RunConfig: runConfig},
+	}
+	if instance.Image != "" {
+		// onprem reports the path of the image.
+		args.ImageName = path.Base(instance.Image)
+	}
+
+	var config types.Config
+	configAsJson, err := resolveInstanceConfig(ctx, args, &config)
+	if err != nil {
+		return InstanceArgs{}, InstanceState{}, false, err
+	}
+	state := InstanceState{
+		InstanceID: instance.Name,
+		ImageName:  args.ImageName,
+		Config:     configAsJson,
+		Provider:   providerName,
+		PID:        instance.ID,
+		Status:     instance.Status,
+		PublicIPs:  instance.PublicIps,
+		PrivateIPs: instance.PrivateIps,
+		Name:       instance.Name,
+	}
+	if state.PublicIPs == nil {
+		state.PublicIPs = []string{}
+	}
+	if state.PrivateIPs == nil {
+		state.PrivateIPs = []string{}
+	}
+	return args, state, true, nil
+}
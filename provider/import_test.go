@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestImportImage(t *testing.T) {
+	cloud := useFakeCloud(t)
+	cloud.builds["web"] = types.Config{}
+	server := newTestServer(t, p.ConfigureRequest{Args: property.NewMap(map[string]property.Value{
+		"defaultProvider": property.New("onprem"),
+	})})
+
+	for _, token := range []string{"Image", "PackageImage"} {
+		for _, id := range []string{"onprem/web", "web"} {
+			t.Run(token+" "+id, func(t *testing.T) {
+				urn := resource.CreateURN("web", "nanovms:index:"+token, "", "project", "stack")
+				read, err := server.Read(p.ReadRequest{ID: id, Urn: urn})
+				if err != nil {
+					t.Fatal(err)
+				}
+				// The ID is the image name, like the ID of created images.
+				if read.ID != "web" {
+					t.Errorf("ID is %v, want web", read.ID)
+				}
+				if name := read.Inputs.Get("name").AsString(); name != "web" {
+					t.Errorf("name is %v, want web", name)
+				}
+				if provider := read.Inputs.Get("provider").AsString(); provider != "onprem" {
+					t.Errorf("provider is %v, want onprem", provider)
+				}
+				elf, hasElf := read.Inputs.GetOk("elf")
+				if token == "Image" && (!hasElf || elf.AsString() != importedElf) {
+					t.Errorf("elf is %v, want %v", elf, importedElf)
+				}
+				if token == "PackageImage" && hasElf {
+					t.Errorf("package image has elf %v", elf)
+				}
+			})
+		}
+	}
+
+	urn := resource.CreateURN("api", "nanovms:index:Image", "", "project", "stack")
+	read, err := server.Read(p.ReadRequest{ID: "onprem/api", Urn: urn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ID != "" {
+		t.Errorf("missing image is read as %v, want not found", read.ID)
+	}
+}
+
+func TestImportInstance(t *testing.T) {
+	cloud := useFakeCloud(t)
+	cloud.instances["web"] = lepton.CloudInstance{ID: "1234", Name: "web", Status: "running", Image: "/root/.ops/images/web-image"}
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+
+	read, err := server.Read(p.ReadRequest{ID: "onprem/web", Urn: urn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The ID is the instance name, like the ID of created instances.
+	if read.ID != "web" {
+		t.Errorf("ID is %v, want web", read.ID)
+	}
+	if image := read.Inputs.Get("image").AsString(); image != "web-image" {
+		t.Errorf("image is %v, want web-image", image)
+	}
+}
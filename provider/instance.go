@@ -4,9 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path"
 	"path/filepath"
-	"runtime"
-	"strconv"
 	"strings"
 	"time"
 
@@ -15,7 +14,6 @@ import (
 	"github.com/wI2L/jsondiff"
 
 	"github.com/nanovms/ops/lepton"
-	"github.com/nanovms/ops/provider"
 	"github.com/nanovms/ops/types"
 )
 
@@ -23,23 +21,38 @@ type Instance struct{}
 
 var _ = (infer.CustomCreate[InstanceArgs, InstanceState])((*Instance)(nil))
 var _ = (infer.CustomDelete[InstanceState])((*Instance)(nil))
+var _ = (infer.CustomUpdate[InstanceArgs, InstanceState])((*Instance)(nil))
 var _ = (infer.CustomDiff[InstanceArgs, InstanceState])((*Instance)(nil))
 var _ = (infer.CustomRead[InstanceArgs, InstanceState])((*Instance)(nil))
+var _ = (infer.CustomCheck[InstanceArgs])((*Instance)(nil))
 
 func (i *Instance) Annotate(a infer.Annotator) {
 	a.Describe(&i, "A NanoVMs resource for deploying unikernel images")
 }
 
 type InstanceArgs struct {
-	ImageName string `pulumi:"image,optional"`
-	Config    string `pulumi:"config"`
-	Provider  string `pulumi:"provider"`
+	Name                 string             `pulumi:"name,optional"`
+	NamePrefix           string             `pulumi:"namePrefix,optional"`
+	ImageName            string             `pulumi:"image,optional"`
+	Config               string             `pulumi:"config,optional"`
+	OpsConfig            *OpsConfig         `pulumi:"opsConfig,optional"`
+	Provider             string             `pulumi:"provider,optional"`
+	Readiness            *InstanceReadiness `pulumi:"readiness,optional"`
+	CaptureLogsOnFailure int                `pulumi:"captureLogsOnFailure,optional"`
+	OpsHome              string             `pulumi:"opsHome,optional"`
 }
 
 func (i *InstanceArgs) Annotate(a infer.Annotator) {
+	a.Describe(&i.Name, "The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance")
+	a.Describe(&i.NamePrefix, "The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name")
 	a.Describe(&i.ImageName, "The name of the image to deploy")
-	a.Describe(&i.Config, "The configuration for the instance")
+	a.Describe(&i.Config, "The configuration for the instance as a JSON encoded string, merged on top of opsConfig")
+	a.Deprecate(&i.Config, "use opsConfig instead")
+	a.Describe(&i.OpsConfig, "The configuration for the instance")
 	a.Describe(&i.Provider, "The provider for the instance")
+	a.Describe(&i.Readiness, "Wait for the instance to become ready before completing, so its status and IP addresses are known")
+	a.Describe(&i.CaptureLogsOnFailure, "The number of console log lines to include in the error when the instance does not become ready")
+	a.Describe(&i.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
 }
 
 type InstanceState struct {
@@ -51,6 +64,8 @@ type InstanceState struct {
 	Status     string   `pulumi:"status"`
 	PublicIPs  []string `pulumi:"public_ips"`
 	PrivateIPs []string `pulumi:"private_ips"`
+	Name       string   `pulumi:"name,optional"`
+	NamePrefix string   `pulumi:"namePrefix,optional"`
 }
 
 func (i *InstanceState) Annotate(a infer.Annotator) {
@@ -62,33 +77,34 @@ func (i *InstanceState) Annotate(a infer.Annotator) {
 	a.Describe(&i.PublicIPs, "The public IP addresses of the instance")
 	a.Describe(&i.PrivateIPs, "The private IP addresses of the instance")
 	a.Describe(&i.Provider, "The provider (type) for the instance")
+	a.Describe(&i.Name, "The name the instance was given, through the name input or the configuration")
+	a.Describe(&i.NamePrefix, "The prefix the name of the instance was generated from")
 }
 
 func (*Instance) Create(ctx context.Context, req infer.CreateRequest[InstanceArgs]) (infer.CreateResponse[InstanceState], error) {
 	var resp infer.CreateResponse[InstanceState]
 
-	var config types.Config
+	releaseOpsHome := useOpsHome(req.Inputs.OpsHome)
+	defer releaseOpsHome()
 
 	// In preview mode the Config may be empty, e.g. if it uses the result of an
-	// image Create, in preview mode Pulumi does not wait for dependencies.
-	if !req.DryRun {
-		if err := json.Unmarshal([]byte(req.Inputs.Config), &config); err != nil {
-			if req.Inputs.Config == "" {
-				p.GetLogger(ctx).Warning("no config provided, using default")
-			} else {
-				return resp, fmt.Errorf("failed to unmarshal config: %w", err)
-			}
-		}
+	// image Create, in preview mode Pulumi does not wait for dependencies. It
+	// is resolved as far as it is known, e.g. for the instanceName.
+	var config types.Config
+	configAsJson, err := resolveInstanceConfig(ctx, req.Inputs, &config)
+	if req.DryRun {
+		configAsJson = ""
+	} else if err != nil {
+		return resp, err
 	}
 	if req.Inputs.ImageName != "" {
 		config.RunConfig.ImageName = req.Inputs.ImageName
 	}
-	if config.RunConfig.InstanceName == "" {
-		config.RunConfig.InstanceName = fmt.Sprintf("%v-%v",
-			strings.Split(filepath.Base(config.CloudConfig.ImageName), ".")[0],
-			strconv.FormatInt(time.Now().Unix(), 10),
-		)
+	name, namePrefix, err := instanceName(req.Name, req.Inputs, config.RunConfig.InstanceName)
+	if err != nil {
+		return resp, err
 	}
+	config.RunConfig.InstanceName = name
 	// Pulumi makes the plugin binary (=this code) a process group and later
 	// uses signals to kill it, prevent the instance from being killed.
 	config.RunConfig.BackgroundDetach = true
@@ -97,8 +113,12 @@ func (*Instance) Create(ctx context.Context, req infer.CreateRequest[InstanceArg
 	resp.Output = InstanceState{
 		InstanceID: config.RunConfig.InstanceName,
 		ImageName:  config.CloudConfig.ImageName,
-		Config:     req.Inputs.Config,
+		Config:     configAsJson,
 		Provider:   req.Inputs.Provider,
+		NamePrefix: namePrefix,
+	}
+	if namePrefix == "" {
+		resp.Output.Name = name
 	}
 
 	// If previewing and not running on-prem, return early, only for onprem a
@@ -108,7 +128,7 @@ func (*Instance) Create(ctx context.Context, req infer.CreateRequest[InstanceArg
 		return resp, nil
 	}
 
-	provider, err := provider.CloudProvider(req.Inputs.Provider, &config.CloudConfig)
+	provider, err := cloudProvider(req.Inputs.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to create provider: %w", err)
 	}
@@ -134,18 +154,19 @@ func (*Instance) Create(ctx context.Context, req infer.CreateRequest[InstanceArg
 		}
 	}
 	if !req.DryRun {
-		if strings.Contains(config.Kernel, "arm") && strings.Contains(runtime.GOARCH, "amd") {
-			// running on amd64 but starting an arm64 instance, set AltGOARCH
-			lepton.AltGOARCH = "arm64"
-			p.GetLogger(ctx).Infof("creating instance on %s for %s with architecture: %v", req.Inputs.Provider, config.CloudConfig.ImageName, lepton.AltGOARCH)
-		} else if !strings.Contains(config.Kernel, "arm") && strings.Contains(runtime.GOARCH, "arm") {
-			// running on arm64 but starting an amd64 instance, set AltGOARCH
-			lepton.AltGOARCH = "amd64"
-			p.GetLogger(ctx).Infof("creating instance on %s for %s with architecture: %v", req.Inputs.Provider, config.CloudConfig.ImageName, lepton.AltGOARCH)
+		// The kernel of an arm64 image is in the -arm release directory.
+		arch := "amd64"
+		if strings.Contains(config.Kernel, "arm") {
+			arch = "arm64"
+		}
+		if altArch := altGOARCHFor(arch); altArch != "" {
+			p.GetLogger(ctx).Infof("creating instance on %s for %s with architecture: %v", req.Inputs.Provider, config.CloudConfig.ImageName, altArch)
 		} else {
 			p.GetLogger(ctx).Infof("creating instance on %s for %s", req.Inputs.Provider, config.CloudConfig.ImageName)
 		}
+		releaseArch := altGOARCH.use(arch)
 		err = provider.CreateInstance(opsContext)
+		releaseArch()
 		if err != nil {
 			return resp, fmt.Errorf("failed to create instance: %w", err)
 		}
@@ -160,10 +181,114 @@ func (*Instance) Create(ctx context.Context, req infer.CreateRequest[InstanceArg
 		resp.Output.Status = "starting"
 		resp.Output.PublicIPs = []string{}
 		resp.Output.PrivateIPs = []string{}
+		if req.Inputs.Readiness != nil {
+			// Other operations wait for the ops home, waitForInstance only
+			// uses it while it polls.
+			releaseOpsHome()
+			if err := waitForInstance(ctx, provider, opsContext, req.Inputs, &resp.Output); err != nil {
+				return resp, err
+			}
+		}
 	}
 	return resp, nil
 }
 
+// waitForInstance waits for the instance to become ready and updates the state
+// with the retrieved instance information. The instance exists at this point,
+// so failing to become ready is reported as a partially initialized resource.
+func waitForInstance(ctx context.Context, provider lepton.Provider, opsContext *lepton.Context, args InstanceArgs, state *InstanceState) error {
+	instance, err := waitForReady(ctx, provider, opsContext, state.InstanceID, args.Readiness)
+	if instance != nil {
+		state.PID = instance.ID
+		state.Status = instance.Status
+		state.PublicIPs = instance.PublicIps
+		state.PrivateIPs = instance.PrivateIps
+	}
+	if err != nil {
+		reasons := []string{err.Error()}
+		if args.CaptureLogsOnFailure > 0 {
+			release := useOpsHome(opsContext.Config().Home)
+			logs, logErr := instanceLogs(opsContext, provider, args.Provider, state.InstanceID)
+			release()
+			if logErr != nil {
+				p.GetLogger(ctx).Warningf("cannot capture logs of instance %v: %v", state.InstanceID, logErr)
+			} else {
+				reasons = append(reasons, fmt.Sprintf("last %d lines of the console output:\n%s", args.CaptureLogsOnFailure, lastLines(logs, args.CaptureLogsOnFailure)))
+			}
+		}
+		return infer.ResourceInitFailedError{Reasons: reasons}
+	}
+	return nil
+}
+
+func (*Instance) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[InstanceArgs], error) {
+	req.NewInputs = setDefaultProvider(ctx, req.NewInputs)
+	args, fails, err := infer.DefaultCheck[InstanceArgs](ctx, req.NewInputs)
+
+	var config types.Config
+	if _, err := resolveInstanceConfig(ctx, args, &config); err == nil && config.RunConfig.InstanceName != "" && args.Name != "" && args.Name != config.RunConfig.InstanceName {
+		fails = append(fails, p.CheckFailure{
+			Property: "name",
+			Reason:   fmt.Sprintf("name %q differs from the instanceName %q of the configuration", args.Name, config.RunConfig.InstanceName),
+		})
+	}
+	if args.Name != "" {
+		if reason := checkInstanceName(args.Name); reason != "" {
+			fails = append(fails, p.CheckFailure{
+				Property: "name",
+				Reason:   reason,
+			})
+		}
+		if args.NamePrefix != "" {
+			fails = append(fails, p.CheckFailure{
+				Property: "namePrefix",
+				Reason:   "namePrefix cannot be used with name",
+			})
+		}
+	} else if args.NamePrefix != "" {
+		if reason := checkNamePrefix(args.NamePrefix); reason != "" {
+			fails = append(fails, p.CheckFailure{
+				Property: "namePrefix",
+				Reason:   reason,
+			})
+		}
+	}
+	// Like Pulumi autonaming, the generated name and its prefix are kept in
+	// the inputs, so the name stays the same until the prefix changes.
+	if args.Name == "" && config.RunConfig.InstanceName == "" {
+		if args.NamePrefix == "" {
+			args.NamePrefix = defaultNamePrefix(req.Name)
+		}
+		name, nameErr := autoname(req.OldInputs, args.NamePrefix)
+		if nameErr != nil {
+			return infer.CheckResponse[InstanceArgs]{}, nameErr
+		}
+		args.Name = name
+	}
+
+	if _, ok := req.NewInputs.GetOk("provider"); !ok {
+		fails = append(fails, p.CheckFailure{
+			Property: "provider",
+			Reason:   "provider not specified",
+		})
+	}
+
+	if args.Readiness != nil {
+		fails = append(fails, args.Readiness.validate()...)
+	}
+	if args.CaptureLogsOnFailure < 0 {
+		fails = append(fails, p.CheckFailure{
+			Property: "captureLogsOnFailure",
+			Reason:   "captureLogsOnFailure must not be negative",
+		})
+	}
+
+	return infer.CheckResponse[InstanceArgs]{
+		Inputs:   args,
+		Failures: fails,
+	}, err
+}
+
 func (*Instance) Delete(ctx context.Context, req infer.DeleteRequest[InstanceState]) (infer.DeleteResponse, error) {
 	resp := infer.DeleteResponse{}
 
@@ -177,7 +302,9 @@ func (*Instance) Delete(ctx context.Context, req infer.DeleteRequest[InstanceSta
 		}
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to get provider: %w", err)
 	}
@@ -215,42 +342,160 @@ func (i *Instance) Diff(ctx context.Context, req infer.DiffRequest[InstanceArgs,
 		}
 	}
 
+	if req.Inputs.Config == "" && req.Inputs.OpsConfig == nil {
+		p.GetLogger(ctx).Info("no input config provided, cannot diff instance")
+		diffs["config"] = p.PropertyDiff{Kind: p.DeleteReplace}
+		resp.HasChanges = true
+		resp.DeleteBeforeReplace = true
+		resp.DetailedDiff = diffs
+		return resp, nil
+	}
 	var argconfig types.Config
-	if err := json.Unmarshal([]byte(req.Inputs.Config), &argconfig); err != nil {
-		if req.Inputs.Config == "" {
-			p.GetLogger(ctx).Info("no input config provided, cannot diff instance")
-			diffs["config"] = p.PropertyDiff{Kind: p.DeleteReplace}
-			resp.HasChanges = true
-			resp.DeleteBeforeReplace = true
-			resp.DetailedDiff = diffs
-			return resp, nil
-		} else {
-			return resp, fmt.Errorf("failed to unmarshal config: %w", err)
-		}
+	argconfigAsJson, err := resolveInstanceConfig(ctx, req.Inputs, &argconfig)
+	if err != nil {
+		return resp, err
 	}
 
-	patches, err := jsondiff.CompareJSON([]byte(req.State.Config), []byte(req.Inputs.Config))
+	patches, err := jsondiff.CompareJSON([]byte(req.State.Config), []byte(argconfigAsJson))
 	if err != nil {
 		return resp, err
 	}
+	replace := false
 	for _, patch := range patches {
 		p.GetLogger(ctx).Infof("config patch: %s %v -> %v", patch.Path, patch.OldValue, patch.Value)
-		diffs[patch.Path] = p.PropertyDiff{Kind: p.UpdateReplace}
+		if requiresReplace(patch.Path) {
+			diffs[patch.Path] = p.PropertyDiff{Kind: p.UpdateReplace}
+			replace = true
+		} else {
+			diffs[patch.Path] = p.PropertyDiff{Kind: p.Update}
+		}
 		resp.HasChanges = true
 	}
 
 	if req.State.ImageName != req.Inputs.ImageName {
 		p.GetLogger(ctx).Infof("image name changed from %s to %s", req.State.ImageName, req.Inputs.ImageName)
 		diffs["image_name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		replace = true
 		resp.HasChanges = true
 	}
 
+	// A name given through the name input or the configuration must match, a
+	// generated name only changes with the namePrefix. Instances created
+	// before names could be generated have neither a name nor a prefix in
+	// their state and keep their name.
+	name := req.Inputs.Name
+	if name == "" {
+		name = argconfig.RunConfig.InstanceName
+	}
+	if name != "" && name != req.State.InstanceID {
+		p.GetLogger(ctx).Infof("instance name changed from %s to %s", req.State.InstanceID, name)
+		diffs["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		replace = true
+	} else if name == "" && req.State.Name != "" {
+		p.GetLogger(ctx).Infof("instance name %s removed, a name is generated", req.State.Name)
+		diffs["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		replace = true
+	} else if name == "" && req.State.NamePrefix != "" && req.Inputs.NamePrefix != req.State.NamePrefix {
+		p.GetLogger(ctx).Infof("name prefix changed from %s to %s", req.State.NamePrefix, req.Inputs.NamePrefix)
+		diffs["namePrefix"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		replace = true
+	}
+
+	if req.State.Provider != req.Inputs.Provider {
+		p.GetLogger(ctx).Infof("provider changed from %s to %s", req.State.Provider, req.Inputs.Provider)
+		diffs["provider"] = p.PropertyDiff{Kind: p.UpdateReplace}
+		replace = true
+		resp.HasChanges = true
+	}
+
+	// A stopped instance is started again instead of replaced, unless it is
+	// replaced anyway.
+	if !replace && instanceStopped(req.State.Status) {
+		p.GetLogger(ctx).Infof("instance %s is %s, it is started", req.State.InstanceID, req.State.Status)
+		diffs["status"] = p.PropertyDiff{Kind: p.Update}
+	}
+
 	resp.HasChanges = resp.HasChanges || (len(diffs) > 0)
-	resp.DeleteBeforeReplace = resp.HasChanges
+	resp.DeleteBeforeReplace = replace
 	resp.DetailedDiff = diffs
 	return resp, nil
 }
 
+// replaceConfigPaths are the config paths that cannot be changed on an existing
+// instance, changing any of them (or a value below them) replaces the instance.
+var replaceConfigPaths = []string{
+	"/CloudConfig/ImageName",
+	"/CloudConfig/Flavor",
+	"/CloudConfig/Zone",
+	"/RunConfig/ImageName",
+	"/RunConfig/InstanceName",
+}
+
+func requiresReplace(patchPath string) bool {
+	for _, replacePath := range replaceConfigPaths {
+		if patchPath == replacePath || strings.HasPrefix(patchPath, replacePath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// Update applies config changes that do not require a replace by stopping the
+// instance and starting it again with the new configuration. An instance that
+// is already stopped is only started.
+func (*Instance) Update(ctx context.Context, req infer.UpdateRequest[InstanceArgs, InstanceState]) (infer.UpdateResponse[InstanceState], error) {
+	resp := infer.UpdateResponse[InstanceState]{Output: req.State}
+
+	releaseOpsHome := useOpsHome(req.Inputs.OpsHome)
+	defer releaseOpsHome()
+
+	var config types.Config
+	configAsJson, err := resolveInstanceConfig(ctx, req.Inputs, &config)
+	if err != nil {
+		return resp, err
+	}
+	resp.Output.Config = configAsJson
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	provider, err := cloudProvider(req.Inputs.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+	opsContext := lepton.NewContext(&config)
+
+	if instanceStopped(req.State.Status) {
+		p.GetLogger(ctx).Infof("instance %v is %v, not stopping it", req.State.InstanceID, req.State.Status)
+	} else if configAsJson == req.State.Config {
+		p.GetLogger(ctx).Infof("instance %v is %v, nothing to update", req.State.InstanceID, req.State.Status)
+		return resp, nil
+	} else {
+		p.GetLogger(ctx).Infof("stopping instance %v to apply configuration changes", req.State.InstanceID)
+		if err := provider.StopInstance(opsContext, req.State.InstanceID); err != nil {
+			return resp, fmt.Errorf("failed to stop instance: %w", err)
+		}
+	}
+
+	p.GetLogger(ctx).Infof("starting instance %v", req.State.InstanceID)
+	if err := provider.StartInstance(opsContext, req.State.InstanceID); err != nil {
+		// The instance still exists but is stopped, keep it in the state.
+		resp.Output.Status = "stopped"
+		return resp, infer.ResourceInitFailedError{Reasons: []string{fmt.Sprintf("failed to start instance: %v", err)}}
+	}
+
+	resp.Output.Status = "starting"
+	if req.Inputs.Readiness != nil {
+		// As in Create, the ops home is only used while polling.
+		releaseOpsHome()
+		if err := waitForInstance(ctx, provider, opsContext, req.Inputs, &resp.Output); err != nil {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
+
 func (Instance) Read(ctx context.Context, req infer.ReadRequest[InstanceArgs, InstanceState]) (infer.ReadResponse[InstanceArgs, InstanceState], error) {
 	p.GetLogger(ctx).Debugf("reading instance %v information on provider %v", req.State.InstanceID, req.State.Provider)
 
@@ -259,14 +504,30 @@ func (Instance) Read(ctx context.Context, req infer.ReadRequest[InstanceArgs, In
 	var config types.Config
 	if err := json.Unmarshal([]byte(req.State.Config), &config); err != nil {
 		if req.State.Config == "" {
-			p.GetLogger(ctx).Info("no config provided, cannot get instance status")
-			return resp, nil
+			// Only the ID, <provider>/<instance name>, is known when the
+			// instance is imported.
+			args, state, found, err := importInstance(ctx, req.ID)
+			if err != nil {
+				return resp, err
+			}
+			if !found {
+				p.GetLogger(ctx).Errorf("instance with import ID %v not found", req.ID)
+				resp.ID = ""
+				return resp, nil
+			}
+			return infer.ReadResponse[InstanceArgs, InstanceState]{
+				ID:     state.InstanceID,
+				Inputs: args,
+				State:  state,
+			}, nil
 		} else {
 			return resp, fmt.Errorf("failed to unmarshal config: %w", err)
 		}
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to get provider: %w", err)
 	}
@@ -277,7 +538,7 @@ func (Instance) Read(ctx context.Context, req infer.ReadRequest[InstanceArgs, In
 
 	instance, err := provider.GetInstanceByName(opsContext, req.State.InstanceID)
 	if err != nil {
-		if strings.Contains(err.Error(), "instance not found") {
+		if instanceNotFound(err) {
 			p.GetLogger(ctx).Infof("instance %v not found - no longer running?", req.State.InstanceID)
 			resp.ID = ""
 			resp.State.ImageName = ""
@@ -288,10 +549,63 @@ func (Instance) Read(ctx context.Context, req infer.ReadRequest[InstanceArgs, In
 	}
 
 	p.GetLogger(ctx).Infof("instance %v status: %v", instance.ID, instance.Status)
+	if instanceGone(req.State.Provider, instance.Status) {
+		p.GetLogger(ctx).Infof("instance %v is %v - no longer running", req.State.InstanceID, instance.Status)
+		resp.ID = ""
+		resp.State.ImageName = ""
+		return resp, nil
+	}
+	if instanceStopped(instance.Status) {
+		p.GetLogger(ctx).Warningf("instance %v is %v, it is started again on the next update", req.State.InstanceID, instance.Status)
+	}
+
+	// Record what the provider reports instead of what was deployed, so the
+	// next diff replaces an instance that was changed outside of Pulumi.
+	details := lookupInstanceDetails(ctx, provider, req.State.Provider, &config, instance)
+	if details.image != "" && req.State.ImageName != "" && details.image != path.Base(req.State.ImageName) {
+		p.GetLogger(ctx).Warningf("instance %v runs image %v instead of %v", req.State.InstanceID, details.image, req.State.ImageName)
+		resp.State.ImageName = details.image
+	}
+	// Without a flavor in the configuration the provider picked its default.
+	if details.flavor != "" && config.CloudConfig.Flavor != "" && details.flavor != config.CloudConfig.Flavor {
+		p.GetLogger(ctx).Warningf("instance %v has flavor %v instead of %v", req.State.InstanceID, details.flavor, config.CloudConfig.Flavor)
+		config.CloudConfig.Flavor = details.flavor
+		configAsJson, err := json.Marshal(config)
+		if err != nil {
+			return resp, fmt.Errorf("failed to marshal config: %w", err)
+		}
+		resp.State.Config = string(configAsJson)
+	}
+	if undetectable := undetectableDrift(req.State.Provider, &config); len(undetectable) > 0 {
+		p.GetLogger(ctx).Warningf("changes of the %s of instance %v outside Pulumi cannot be detected on %v", strings.Join(undetectable, ", "), req.State.InstanceID, req.State.Provider)
+	}
+
 	resp.State.PID = instance.ID
 	resp.State.Status = instance.Status
 	resp.State.PublicIPs = instance.PublicIps
 	resp.State.PrivateIPs = instance.PrivateIps
+	if resp.State.PublicIPs == nil {
+		resp.State.PublicIPs = []string{}
+	}
+	if resp.State.PrivateIPs == nil {
+		resp.State.PrivateIPs = []string{}
+	}
 
 	return resp, nil
 }
+
+// resolveInstanceConfig merges the instance configuration inputs into config
+// and returns the resulting configuration as a JSON encoded string.
+func resolveInstanceConfig(ctx context.Context, args InstanceArgs, config *types.Config) (string, error) {
+	if err := mergeConfig(ctx, config, args.OpsConfig, args.Config); err != nil {
+		return "", err
+	}
+	if args.OpsHome != "" {
+		config.Home = args.OpsHome
+	}
+	resultingConfig, err := json.Marshal(config)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return string(resultingConfig), nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func instanceInputs(env string) property.Map {
+	return property.NewMap(map[string]property.Value{
+		"name":     property.New("web"),
+		"image":    property.New("web-image"),
+		"provider": property.New("gcp"),
+		// The config output of an image.
+		"config": property.New(`{"CloudConfig":{"ImageName":"web-image"}}`),
+		"opsConfig": property.New(property.NewMap(map[string]property.Value{
+			"env": property.New(property.NewMap(map[string]property.Value{
+				"GREETING": property.New(env),
+			})),
+		})),
+	})
+}
+
+func TestInstanceEnvChangeUpdatesInPlace(t *testing.T) {
+	cloud := useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: instanceInputs("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env := cloud.configs["web"].Env["GREETING"]; env != "hello" {
+		t.Fatalf("instance created with GREETING=%q, want hello", env)
+	}
+
+	diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: created.Properties, Inputs: instanceInputs("world")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasChanges || diff.DeleteBeforeReplace {
+		t.Fatalf("env change does not update the instance in place: %+v", diff)
+	}
+	if kind := diff.DetailedDiff["/Env/GREETING"].Kind; kind != p.Update {
+		t.Fatalf("/Env/GREETING diff is %v, want %v", kind, p.Update)
+	}
+
+	updated, err := server.Update(p.UpdateRequest{ID: created.ID, Urn: urn, State: created.Properties, Inputs: instanceInputs("world")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ops []string
+	for _, o := range cloud.observed {
+		ops = append(ops, o.op+" "+o.name)
+	}
+	if want := []string{"createInstance web", "stopInstance web", "startInstance web"}; !reflect.DeepEqual(ops, want) {
+		t.Fatalf("operations are %v, want %v", ops, want)
+	}
+	if env := cloud.configs["web"].Env["GREETING"]; env != "world" {
+		t.Fatalf("instance started with GREETING=%q, want world", env)
+	}
+	if id := updated.Properties.Get("instanceID").AsString(); id != "web" {
+		t.Fatalf("instance is %v after the update, want web", id)
+	}
+	var config types.Config
+	if err := json.Unmarshal([]byte(updated.Properties.Get("config").AsString()), &config); err != nil {
+		t.Fatal(err)
+	}
+	if env := config.Env["GREETING"]; env != "world" {
+		t.Fatalf("state has GREETING=%q after the update, want world", env)
+	}
+}
+
+func TestInstanceDiffReplaces(t *testing.T) {
+	useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: instanceInputs("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, config := range map[string]string{
+		"/CloudConfig/Flavor": `{"CloudConfig":{"ImageName":"web-image","Flavor":"e2-medium"}}`,
+		"/CloudConfig/Zone":   `{"CloudConfig":{"ImageName":"web-image","Zone":"us-east1-b"}}`,
+	} {
+		inputs := instanceInputs("hello").Set("config", property.New(config))
+		diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: created.Properties, Inputs: inputs})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !diff.HasChanges || !diff.DeleteBeforeReplace || diff.DetailedDiff[path].Kind != p.UpdateReplace {
+			t.Errorf("%s change does not replace the instance: %+v", path, diff)
+		}
+	}
+
+	inputs := instanceInputs("hello").Set("image", property.New("other-image"))
+	diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: created.Properties, Inputs: inputs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasChanges || !diff.DeleteBeforeReplace {
+		t.Errorf("image change does not replace the instance: %+v", diff)
+	}
+}
+
+func TestInstanceUpdateStartsStoppedInstance(t *testing.T) {
+	cloud := useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: instanceInputs("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cloud.StopInstance(nil, "web"); err != nil {
+		t.Fatal(err)
+	}
+	state := created.Properties.Set("status", property.New("stopped"))
+
+	diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: state, Inputs: instanceInputs("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasChanges || diff.DeleteBeforeReplace || diff.DetailedDiff["status"].Kind != p.Update {
+		t.Fatalf("stopped instance is not updated in place: %+v", diff)
+	}
+
+	updated, err := server.Update(p.UpdateRequest{ID: created.ID, Urn: urn, State: state, Inputs: instanceInputs("hello")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status := cloud.instances["web"].Status; status != "running" {
+		t.Fatalf("instance is %v after the update, want running", status)
+	}
+	if status := updated.Properties.Get("status").AsString(); status != "starting" {
+		t.Fatalf("status is %v after the update, want starting", status)
+	}
+}
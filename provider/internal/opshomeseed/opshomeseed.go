@@ -0,0 +1,99 @@
+// Package opshomeseed prepares the ops home before ops initializes.
+//
+// On startup ops looks up the latest nanos release and exits if that fails
+// and the ops home has no latest.txt yet, long before the provider is
+// configured. Importing this package copies latest.txt from the mirror
+// directory in NANOVMS_MIRROR_DIR into the ops home first, or, with
+// NANOVMS_OFFLINE set, exits with an explanation instead of the bare "No local
+// build found." of ops.
+//
+// It only imports the standard library: packages are initialized in the order
+// of their import paths once their imports are, so it is initialized before
+// ops, which imports net/http.
+package opshomeseed
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// MirrorDirEnv is the environment variable the mirrorDir of the provider
+	// configuration defaults to.
+	MirrorDirEnv = "NANOVMS_MIRROR_DIR"
+	// OfflineEnv is the environment variable the offline setting of the
+	// provider configuration defaults to.
+	OfflineEnv = "NANOVMS_OFFLINE"
+)
+
+func init() {
+	offline, _ := strconv.ParseBool(os.Getenv(OfflineEnv))
+	if err := seed(opsHome(), os.Getenv(MirrorDirEnv), offline); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+// opsHome returns the ops home ops uses on startup, like lepton.GetOpsHome.
+func opsHome() string {
+	if home := os.Getenv("OPS_HOME"); home != "" {
+		return filepath.Join(home, ".ops")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		home, _ = os.Getwd()
+	}
+	return filepath.Join(home, ".ops")
+}
+
+// seed copies latest.txt from mirrorDir into opsHome if it is missing there.
+// The release it names is copied when it is used, like other missing assets.
+// In offline mode it fails if neither has one.
+func seed(opsHome string, mirrorDir string, offline bool) error {
+	latest := filepath.Join(opsHome, "latest.txt")
+	if _, err := os.Stat(latest); err == nil {
+		return nil
+	}
+	if mirrorDir != "" {
+		err := copyFile(filepath.Join(mirrorDir, "latest.txt"), latest)
+		if err == nil {
+			return nil
+		}
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to copy latest.txt from mirror %s: %w", mirrorDir, err)
+		}
+	}
+	if !offline {
+		return nil
+	}
+	where := opsHome
+	if mirrorDir != "" {
+		where += " or the mirror " + mirrorDir
+	}
+	return fmt.Errorf("offline and there is no latest.txt in %s, ops cannot start without it: copy latest.txt and the nanos release it names (e.g. 0.1.54/) from an ops home with internet access", where)
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	return out.Close()
+}
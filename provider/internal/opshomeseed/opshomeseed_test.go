@@ -0,0 +1,86 @@
+package opshomeseed
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSeed(t *testing.T) {
+	tests := []struct {
+		name       string
+		home       string
+		mirror     string
+		noMirror   bool
+		offline    bool
+		wantLatest string
+		wantErr    string
+	}{
+		{name: "ops home has a release", home: "0.1.53", mirror: "0.1.54", offline: true, wantLatest: "0.1.53"},
+		{name: "copied from mirror", mirror: "0.1.54", offline: true, wantLatest: "0.1.54"},
+		{name: "online without release", noMirror: true},
+		{name: "online mirror without release"},
+		{
+			name:     "offline without release",
+			noMirror: true,
+			offline:  true,
+			wantErr:  "offline and there is no latest.txt in %[1]s, ops cannot start without it",
+		},
+		{
+			name:    "offline mirror without release",
+			offline: true,
+			wantErr: "offline and there is no latest.txt in %[1]s or the mirror %[2]s, ops cannot start without it",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := filepath.Join(t.TempDir(), ".ops")
+			if tt.home != "" {
+				writeLatest(t, home, tt.home)
+			}
+			mirror := ""
+			if !tt.noMirror {
+				mirror = t.TempDir()
+				if tt.mirror != "" {
+					writeLatest(t, mirror, tt.mirror)
+				}
+			}
+
+			err := seed(home, mirror, tt.offline)
+			if tt.wantErr != "" {
+				want := strings.ReplaceAll(strings.ReplaceAll(tt.wantErr, "%[1]s", home), "%[2]s", mirror)
+				if err == nil || !strings.HasPrefix(err.Error(), want) {
+					t.Fatalf("error is %v, want %s", err, want)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := os.ReadFile(filepath.Join(home, "latest.txt"))
+			if tt.wantLatest == "" {
+				if !os.IsNotExist(err) {
+					t.Fatalf("latest.txt is written with %q, want none", data)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.TrimSpace(string(data)); got != tt.wantLatest {
+				t.Fatalf("latest.txt is %v, want %v", got, tt.wantLatest)
+			}
+		})
+	}
+}
+
+func writeLatest(t *testing.T, dir string, version string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "latest.txt"), []byte(version+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
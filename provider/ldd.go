@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"debug/elf"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// defaultLibraryDirs are searched for needed libraries after the run path of
+// the binary and the directories configured in the ld.so.conf of the sysroot.
+var defaultLibraryDirs = map[elf.Machine][]string{
+	elf.EM_X86_64:  {"/lib/x86_64-linux-gnu", "/usr/lib/x86_64-linux-gnu", "/lib64", "/usr/lib64", "/lib", "/usr/lib", "/usr/local/lib"},
+	elf.EM_AARCH64: {"/lib/aarch64-linux-gnu", "/usr/lib/aarch64-linux-gnu", "/lib64", "/usr/lib64", "/lib", "/usr/lib", "/usr/local/lib"},
+}
+
+// sharedLibs resolves the interpreter and the needed libraries of a
+// dynamically linked binary, and the libraries they need in turn, against
+// sysroot. It returns the paths of the libraries inside the sysroot, which are
+// also their paths in the image.
+func sharedLibs(sysroot string, program string, info *elfInfo) ([]string, error) {
+	if info.Static {
+		return nil, nil
+	}
+
+	confDirs, err := ldSoConfDirs(sysroot, "/etc/ld.so.conf")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ld.so.conf: %w", err)
+	}
+
+	libs := map[string]bool{}
+	var missing []string
+	if info.Interpreter != "" {
+		if _, err := os.Stat(sysrootPath(sysroot, info.Interpreter)); err != nil {
+			missing = append(missing, info.Interpreter)
+		} else {
+			libs[info.Interpreter] = true
+		}
+	}
+
+	type object struct {
+		dir  string
+		info *elfInfo
+	}
+	seen := map[string]bool{}
+	queue := []object{{dir: path.Dir(filepath.ToSlash(program)), info: info}}
+	for len(queue) > 0 {
+		obj := queue[0]
+		queue = queue[1:]
+
+		var dirs []string
+		for _, dir := range obj.info.RunPath {
+			dirs = append(dirs, strings.ReplaceAll(strings.ReplaceAll(dir, "${ORIGIN}", obj.dir), "$ORIGIN", obj.dir))
+		}
+		dirs = append(dirs, confDirs...)
+		dirs = append(dirs, defaultLibraryDirs[info.Machine]...)
+
+		for _, name := range obj.info.Needed {
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			lib, libInfo := findLibrary(sysroot, dirs, name, info)
+			if lib == "" {
+				missing = append(missing, name)
+				continue
+			}
+			libs[lib] = true
+			queue = append(queue, object{dir: path.Dir(lib), info: libInfo})
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("cannot find shared libraries %s in sysroot %s", strings.Join(missing, ", "), sysroot)
+	}
+
+	result := make([]string, 0, len(libs))
+	for lib := range libs {
+		result = append(result, lib)
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// findLibrary returns the path inside the sysroot of the first library called
+// name in dirs matching the class and machine of the program.
+func findLibrary(sysroot string, dirs []string, name string, program *elfInfo) (string, *elfInfo) {
+	if strings.Contains(name, "/") {
+		dirs = []string{""}
+	}
+	for _, dir := range dirs {
+		lib := path.Join("/", dir, name)
+		info, err := inspectElf(sysrootPath(sysroot, lib))
+		if err != nil || info.Class != program.Class || info.Machine != program.Machine {
+			continue
+		}
+		return lib, info
+	}
+	return "", nil
+}
+
+// ldSoConfDirs returns the library directories configured in the ld.so.conf
+// file conf inside the sysroot, following include directives.
+func ldSoConfDirs(sysroot string, conf string) ([]string, error) {
+	f, err := os.Open(sysrootPath(sysroot, conf))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var dirs []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if pattern, ok := strings.CutPrefix(line, "include "); ok {
+			pattern = strings.TrimSpace(pattern)
+			if !path.IsAbs(pattern) {
+				pattern = path.Join(path.Dir(conf), pattern)
+			}
+			matches, err := filepath.Glob(filepath.Join(sysroot, pattern))
+			if err != nil {
+				return nil, err
+			}
+			sort.Strings(matches)
+			for _, match := range matches {
+				rel, err := filepath.Rel(sysroot, match)
+				if err != nil {
+					return nil, err
+				}
+				included, err := ldSoConfDirs(sysroot, path.Join("/", filepath.ToSlash(rel)))
+				if err != nil {
+					return nil, err
+				}
+				dirs = append(dirs, included...)
+			}
+		} else if path.IsAbs(line) {
+			dirs = append(dirs, line)
+		}
+	}
+	return dirs, scanner.Err()
+}
+
+// sysrootPath returns the host path of p inside the sysroot, resolving
+// symbolic links relative to the sysroot instead of the host.
+func sysrootPath(sysroot string, p string) string {
+	if sysroot == "" || sysroot == "/" {
+		return p
+	}
+	resolved := "/"
+	parts := strings.Split(path.Clean("/"+p), "/")
+	for hops := 0; len(parts) > 0 && hops < 40; {
+		part := parts[0]
+		parts = parts[1:]
+		if part == "" {
+			continue
+		}
+		next := path.Join(resolved, part)
+		target, err := os.Readlink(filepath.Join(sysroot, next))
+		if err != nil {
+			resolved = next
+			continue
+		}
+		hops++
+		if path.IsAbs(target) {
+			resolved = "/"
+		}
+		parts = append(strings.Split(target, "/"), parts...)
+	}
+	return filepath.Join(sysroot, resolved, path.Join(parts...))
+}
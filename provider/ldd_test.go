@@ -0,0 +1,159 @@
+package main
+
+import (
+	"debug/elf"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newSysroot returns a sysroot with a merged /usr like current distributions,
+// /lib links to usr/lib and /lib64 to /usr/lib64.
+func newSysroot(t *testing.T) string {
+	t.Helper()
+	sysroot := filepath.Join(t.TempDir(), "sysroot")
+	for _, dir := range []string{"usr/lib/x86_64-linux-gnu", "usr/lib64", "etc/ld.so.conf.d"} {
+		if err := os.MkdirAll(filepath.Join(sysroot, dir), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink("usr/lib", filepath.Join(sysroot, "lib")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("/usr/lib64", filepath.Join(sysroot, "lib64")); err != nil {
+		t.Fatal(err)
+	}
+	return sysroot
+}
+
+func writeFile(t *testing.T, path string, data string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedLibs(t *testing.T) {
+	sysroot := newSysroot(t)
+	const interpreter = "/lib64/ld-linux-x86-64.so.2"
+	writeFile(t, filepath.Join(sysroot, "usr/lib64/ld-linux-x86-64.so.2"), "")
+	writeFile(t, filepath.Join(sysroot, "etc/ld.so.conf"), "# libraries of packages\ninclude ld.so.conf.d/*.conf\n")
+	writeFile(t, filepath.Join(sysroot, "etc/ld.so.conf.d/ssl.conf"), "/opt/ssl/lib # openssl\n")
+
+	writeDynamicElf(t, filepath.Join(sysroot, "app/bin/web"), elf.EM_X86_64, dynamicElf{
+		interpreter: interpreter,
+		needed:      []string{"libapp.so", "libc.so.6"},
+		runPath:     "$ORIGIN/../lib",
+	})
+	writeDynamicElf(t, filepath.Join(sysroot, "app/lib/libapp.so"), elf.EM_X86_64, dynamicElf{
+		needed:  []string{"libssl.so.3", "libutil.so"},
+		runPath: "${ORIGIN}/util",
+	})
+	writeDynamicElf(t, filepath.Join(sysroot, "app/lib/util/libutil.so"), elf.EM_X86_64, dynamicElf{needed: []string{"libc.so.6"}})
+	writeDynamicElf(t, filepath.Join(sysroot, "opt/ssl/lib/libssl.so.3"), elf.EM_X86_64, dynamicElf{needed: []string{"libc.so.6"}})
+	// A libc for another machine in the run path is skipped.
+	writeDynamicElf(t, filepath.Join(sysroot, "app/lib/libc.so.6"), elf.EM_AARCH64, dynamicElf{})
+	writeElf(t, filepath.Join(sysroot, "usr/lib/x86_64-linux-gnu/libc.so.6"), elf.EM_X86_64)
+
+	info, err := inspectElf(filepath.Join(sysroot, "app/bin/web"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	libs, err := sharedLibs(sysroot, "/app/bin/web", info)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"/app/lib/libapp.so",
+		"/app/lib/util/libutil.so",
+		"/lib/x86_64-linux-gnu/libc.so.6",
+		interpreter,
+		"/opt/ssl/lib/libssl.so.3",
+	}
+	if !reflect.DeepEqual(libs, want) {
+		t.Fatalf("shared libraries are %v, want %v", libs, want)
+	}
+
+	libs, err = sharedLibs(sysroot, "/app/bin/static", &elfInfo{Machine: elf.EM_X86_64, Class: elf.ELFCLASS64, Static: true})
+	if err != nil || libs != nil {
+		t.Fatalf("shared libraries of a static binary are %v, %v, want none", libs, err)
+	}
+}
+
+func TestSharedLibsMissing(t *testing.T) {
+	sysroot := newSysroot(t)
+	host := filepath.Join(filepath.Dir(sysroot), "host")
+	// The libraries exist on the host, but not in the sysroot.
+	if err := os.MkdirAll(host, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeElf(t, filepath.Join(host, "libescape.so"), elf.EM_X86_64)
+	writeElf(t, filepath.Join(host, "libabsolute.so"), elf.EM_X86_64)
+	if err := os.Symlink("../../../host/libescape.so", filepath.Join(sysroot, "usr/lib/libescape.so")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(filepath.Join(host, "libabsolute.so"), filepath.Join(sysroot, "usr/lib/libabsolute.so")); err != nil {
+		t.Fatal(err)
+	}
+	writeDynamicElf(t, filepath.Join(sysroot, "app/web"), elf.EM_X86_64, dynamicElf{
+		interpreter: "/lib64/ld-linux-x86-64.so.2",
+		needed:      []string{"libescape.so", "libabsolute.so", "libmissing.so"},
+	})
+
+	info, err := inspectElf(filepath.Join(sysroot, "app/web"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = sharedLibs(sysroot, "/app/web", info)
+	want := "cannot find shared libraries /lib64/ld-linux-x86-64.so.2, libescape.so, libabsolute.so, libmissing.so in sysroot " + sysroot
+	if err == nil || err.Error() != want {
+		t.Fatalf("error is %v, want %s", err, want)
+	}
+}
+
+func TestSysrootPath(t *testing.T) {
+	sysroot := newSysroot(t)
+	host := t.TempDir()
+	for link, target := range map[string]string{
+		"usr/lib/escape":   "../../../..",
+		"usr/lib/absolute": host,
+		"usr/lib/loop":     "loop",
+		"usr/lib/current":  "x86_64-linux-gnu/",
+	} {
+		if err := os.Symlink(target, filepath.Join(sysroot, link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "/usr/lib/x86_64-linux-gnu/libc.so.6", want: "/usr/lib/x86_64-linux-gnu/libc.so.6"},
+		{path: "lib/x86_64-linux-gnu/libc.so.6", want: "/usr/lib/x86_64-linux-gnu/libc.so.6"},
+		{path: "/lib64/ld-linux-x86-64.so.2", want: "/usr/lib64/ld-linux-x86-64.so.2"},
+		{path: "/lib/current/libc.so.6", want: "/usr/lib/x86_64-linux-gnu/libc.so.6"},
+		{path: "/../../etc/passwd", want: "/etc/passwd"},
+		{path: "/lib/escape/etc/passwd", want: "/etc/passwd"},
+		{path: "/lib/absolute/libc.so.6", want: filepath.Join(host, "libc.so.6")},
+	}
+	for _, tt := range tests {
+		if got := sysrootPath(sysroot, tt.path); got != filepath.Join(sysroot, tt.want) {
+			t.Errorf("sysrootPath(%q) is %v, want %v", tt.path, got, filepath.Join(sysroot, tt.want))
+		}
+	}
+
+	if got := sysrootPath(sysroot, "/lib/loop/libc.so.6"); !strings.HasPrefix(got, sysroot+"/") {
+		t.Errorf("symbolic link loop resolves to %v outside the sysroot", got)
+	}
+	for _, root := range []string{"", "/"} {
+		if got := sysrootPath(root, "/lib/libc.so.6"); got != "/lib/libc.so.6" {
+			t.Errorf("sysrootPath(%q) is %v, want the host path", root, got)
+		}
+	}
+}
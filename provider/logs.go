@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type GetInstanceLogs struct{}
+
+var _ = (infer.Fn[GetInstanceLogsArgs, GetInstanceLogsResult])((*GetInstanceLogs)(nil))
+var _ = (infer.Annotated)((*GetInstanceLogs)(nil))
+var _ = (infer.Annotated)((*GetInstanceLogsArgs)(nil))
+var _ = (infer.Annotated)((*GetInstanceLogsResult)(nil))
+
+func (g *GetInstanceLogs) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Retrieves the console output of a NanoVMs instance")
+}
+
+type GetInstanceLogsArgs struct {
+	InstanceID string     `pulumi:"instanceID"`
+	Provider   string     `pulumi:"provider,optional"`
+	Config     string     `pulumi:"config,optional"`
+	OpsConfig  *OpsConfig `pulumi:"opsConfig,optional"`
+	Lines      int        `pulumi:"lines,optional"`
+}
+
+func (g *GetInstanceLogsArgs) Annotate(a infer.Annotator) {
+	a.Describe(&g.InstanceID, "The ID (name) of the instance")
+	a.Describe(&g.Provider, "The provider (type) of the instance")
+	a.Describe(&g.Config, "The configuration as a JSON encoded string, e.g. the config output of the instance")
+	a.Describe(&g.OpsConfig, "The configuration, used for the cloud provider settings such as zone")
+	a.Describe(&g.Lines, "Only return the last number of lines, all output is returned if not set")
+}
+
+type GetInstanceLogsResult struct {
+	Logs string `pulumi:"logs"`
+}
+
+func (g *GetInstanceLogsResult) Annotate(a infer.Annotator) {
+	a.Describe(&g.Logs, "The console output of the instance")
+}
+
+func (*GetInstanceLogs) Invoke(ctx context.Context, req infer.FunctionRequest[GetInstanceLogsArgs]) (infer.FunctionResponse[GetInstanceLogsResult], error) {
+	var resp infer.FunctionResponse[GetInstanceLogsResult]
+
+	providerName, err := resolveProviderName(ctx, req.Input.Provider)
+	if err != nil {
+		return resp, err
+	}
+
+	defer useOpsHome("")()
+
+	provider, opsContext, err := lookupProvider(ctx, providerName, req.Input.OpsConfig, req.Input.Config)
+	if err != nil {
+		return resp, err
+	}
+
+	logs, err := instanceLogs(opsContext, provider, providerName, req.Input.InstanceID)
+	if err != nil {
+		return resp, err
+	}
+	resp.Output.Logs = lastLines(logs, req.Input.Lines)
+	return resp, nil
+}
+
+// instanceLogs returns the console output of an instance.
+func instanceLogs(opsContext *lepton.Context, provider lepton.Provider, providerName, name string) (string, error) {
+	if providerName == "onprem" {
+		// The onprem provider exits the process if the log file does not exist.
+		if _, err := os.Stat("/tmp/" + name + ".log"); err != nil {
+			return "", fmt.Errorf("failed to get instance logs: %w", err)
+		}
+	}
+	logs, err := provider.GetInstanceLogs(opsContext, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get instance logs: %w", err)
+	}
+	return logs, nil
+}
+
+// lastLines returns the last n lines of s, or all of s if n is not positive.
+func lastLines(s string, n int) string {
+	if n <= 0 {
+		return s
+	}
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -8,6 +8,9 @@ import (
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+
+	// Prepares the ops home before ops initializes, see the package.
+	_ "github.com/tpjg/pulumi-nanovms/internal/opshomeseed"
 )
 
 // Version can be set via ldflags during build:
@@ -36,6 +39,19 @@ func newProvider() (p.Provider, error) {
 			infer.Resource(&Image{}),
 			infer.Resource(&PackageImage{}),
 			infer.Resource(&Instance{}),
+			infer.Resource(&Volume{}),
+			infer.Resource(&VolumeAttachment{}),
+			infer.Resource(&NanosKernel{}),
+			infer.Resource(&Package{}),
+		).
+		WithConfig(infer.Config(&Config{})).
+		WithFunctions(
+			infer.Function(&GetInstanceLogs{}),
+			infer.Function(&GetImages{}),
+			infer.Function(&GetImage{}),
+			infer.Function(&GetInstances{}),
+			infer.Function(&GetInstance{}),
+			infer.Function(&GetVolumes{}),
 		).
 		WithNamespace("tpjg").
 		WithDisplayName("pulumi-nanovms").
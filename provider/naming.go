@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+// Instance names are limited to what all cloud providers accept, e.g. GCP
+// requires lowercase RFC 1035 labels.
+const (
+	maxInstanceNameLength = 63
+	autonameSuffixLength  = 7
+	maxNamePrefixLength   = maxInstanceNameLength - autonameSuffixLength - 1
+)
+
+var (
+	instanceNameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	namePrefixRegex   = regexp.MustCompile(`^[a-z][-a-z0-9]*$`)
+	invalidNameChars  = regexp.MustCompile(`[^-a-z0-9]+`)
+)
+
+// checkInstanceName returns why name can't be used as instance name, or an
+// empty string if it can.
+func checkInstanceName(name string) string {
+	if len(name) > maxInstanceNameLength {
+		return fmt.Sprintf("name %q is longer than %d characters", name, maxInstanceNameLength)
+	}
+	if !instanceNameRegex.MatchString(name) {
+		return fmt.Sprintf("name %q must start with a lowercase letter, followed by lowercase letters, digits or hyphens, and not end with a hyphen", name)
+	}
+	return ""
+}
+
+// checkNamePrefix returns why prefix can't be used to generate instance names,
+// or an empty string if it can.
+func checkNamePrefix(prefix string) string {
+	if len(prefix) > maxNamePrefixLength {
+		return fmt.Sprintf("namePrefix %q is longer than %d characters", prefix, maxNamePrefixLength)
+	}
+	if !namePrefixRegex.MatchString(prefix) {
+		return fmt.Sprintf("namePrefix %q must start with a lowercase letter, followed by lowercase letters, digits or hyphens", prefix)
+	}
+	return ""
+}
+
+// defaultNamePrefix turns the name of a resource into a valid name prefix.
+func defaultNamePrefix(resourceName string) string {
+	prefix := invalidNameChars.ReplaceAllString(strings.ToLower(resourceName), "-")
+	prefix = strings.TrimLeft(prefix, "-0123456789")
+	if len(prefix) > maxNamePrefixLength {
+		prefix = prefix[:maxNamePrefixLength]
+	}
+	prefix = strings.TrimRight(prefix, "-")
+	if prefix == "" {
+		return "instance"
+	}
+	return prefix
+}
+
+// instanceName returns the name of a new instance: the name input, the
+// instanceName of the configuration or, like Pulumi autonaming, the namePrefix
+// (defaulting to the resource name) followed by a random suffix. The prefix is
+// only returned for a generated name, which Check puts into the name input
+// together with its prefix.
+func instanceName(resourceName string, args InstanceArgs, configName string) (name string, prefix string, err error) {
+	if args.Name != "" {
+		return args.Name, args.NamePrefix, nil
+	}
+	if configName != "" {
+		return configName, "", nil
+	}
+	prefix = args.NamePrefix
+	if prefix == "" {
+		prefix = defaultNamePrefix(resourceName)
+	}
+	name, err = generateInstanceName(prefix)
+	if err != nil {
+		return "", "", err
+	}
+	return name, prefix, nil
+}
+
+// autoname returns the name Check puts into the inputs of an instance without
+// a name input or instanceName: the name generated for prefix before, taken
+// from the old inputs, or a new one. Instances that got a generated name in
+// Create, before names were generated in Check, only have their name in the
+// state and get an empty name, which Diff and Create handle.
+func autoname(oldInputs property.Map, prefix string) (string, error) {
+	oldName, oldPrefix := stringInput(oldInputs, "name"), stringInput(oldInputs, "namePrefix")
+	if oldName != "" && oldPrefix == prefix {
+		return oldName, nil
+	}
+	if oldInputs.Len() > 0 && oldName == "" && (oldPrefix == "" || oldPrefix == prefix) {
+		return "", nil
+	}
+	return generateInstanceName(prefix)
+}
+
+// generateInstanceName returns prefix followed by a random suffix.
+func generateInstanceName(prefix string) (string, error) {
+	name, err := resource.NewUniqueHex(prefix+"-", autonameSuffixLength, maxInstanceNameLength)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate instance name: %w", err)
+	}
+	return name, nil
+}
+
+// stringInput returns the string value of key in inputs, or an empty string.
+func stringInput(inputs property.Map, key string) string {
+	if v, ok := inputs.GetOk(key); ok && v.IsString() {
+		return v.AsString()
+	}
+	return ""
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestInstanceAutonaming(t *testing.T) {
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web-server", "nanovms:index:Instance", "", "project", "stack")
+	inputs := property.NewMap(map[string]property.Value{
+		"provider": property.New("gcp"),
+	})
+	check := func(t *testing.T, olds, news property.Map) (name string, prefix string) {
+		t.Helper()
+		resp, err := server.Check(p.CheckRequest{Urn: urn, State: olds, Inputs: news})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Failures) > 0 {
+			t.Fatalf("check failed: %v", resp.Failures)
+		}
+		return stringInput(resp.Inputs, "name"), stringInput(resp.Inputs, "namePrefix")
+	}
+
+	name, prefix := check(t, property.Map{}, inputs)
+	if prefix != "web-server" || !strings.HasPrefix(name, "web-server-") {
+		t.Fatalf("generated name %q with prefix %q, want one starting with web-server-", name, prefix)
+	}
+	olds := inputs.Set("name", property.New(name)).Set("namePrefix", property.New(prefix))
+
+	t.Run("kept", func(t *testing.T) {
+		if got, _ := check(t, olds, inputs); got != name {
+			t.Errorf("name changed from %q to %q", name, got)
+		}
+	})
+	t.Run("prefix changed", func(t *testing.T) {
+		got, gotPrefix := check(t, olds, inputs.Set("namePrefix", property.New("api")))
+		if gotPrefix != "api" || !strings.HasPrefix(got, "api-") {
+			t.Errorf("generated name %q with prefix %q, want one starting with api-", got, gotPrefix)
+		}
+	})
+	t.Run("name", func(t *testing.T) {
+		got, gotPrefix := check(t, olds, inputs.Set("name", property.New("api")))
+		if got != "api" || gotPrefix != "" {
+			t.Errorf("name is %q with prefix %q, want api without prefix", got, gotPrefix)
+		}
+	})
+	t.Run("instanceName", func(t *testing.T) {
+		withInstanceName := inputs.Set("opsConfig", property.New(property.NewMap(map[string]property.Value{
+			"runConfig": property.New(property.NewMap(map[string]property.Value{
+				"instanceName": property.New("fixed"),
+			})),
+		})))
+		if got, gotPrefix := check(t, olds, withInstanceName); got != "" || gotPrefix != "" {
+			t.Errorf("generated name %q with prefix %q for an instance with instanceName", got, gotPrefix)
+		}
+	})
+	t.Run("generated by Create", func(t *testing.T) {
+		// Earlier versions generated the name in Create, it is only in the
+		// state.
+		legacy := inputs.Set("namePrefix", property.New("web-server"))
+		if got, _ := check(t, legacy, inputs); got != "" {
+			t.Errorf("generated name %q for an instance named in Create", got)
+		}
+	})
+}
+
+func TestInstancePreviewUsesInstanceName(t *testing.T) {
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("web", "nanovms:index:Instance", "", "project", "stack")
+
+	resp, err := server.Create(p.CreateRequest{Urn: urn, DryRun: true, Properties: property.NewMap(map[string]property.Value{
+		"provider":   property.New("gcp"),
+		"namePrefix": property.New("web"),
+		"opsConfig": property.New(property.NewMap(map[string]property.Value{
+			"runConfig": property.New(property.NewMap(map[string]property.Value{
+				"instanceName": property.New("fixed"),
+			})),
+		})),
+	})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID != "fixed" {
+		t.Errorf("previewed instance %q, want the instanceName fixed", resp.ID)
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"runtime"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type NanosKernel struct{}
+
+var _ = (infer.CustomCreate[NanosKernelArgs, NanosKernelState])((*NanosKernel)(nil))
+var _ = (infer.CustomDelete[NanosKernelState])((*NanosKernel)(nil))
+var _ = (infer.CustomCheck[NanosKernelArgs])((*NanosKernel)(nil))
+var _ = (infer.CustomUpdate[NanosKernelArgs, NanosKernelState])((*NanosKernel)(nil))
+var _ = (infer.CustomDiff[NanosKernelArgs, NanosKernelState])((*NanosKernel)(nil))
+var _ = (infer.CustomRead[NanosKernelArgs, NanosKernelState])((*NanosKernel)(nil))
+var _ = (infer.Annotated)((*NanosKernel)(nil))
+var _ = (infer.Annotated)((*NanosKernelArgs)(nil))
+var _ = (infer.Annotated)((*NanosKernelState)(nil))
+
+func (k *NanosKernel) Annotate(a infer.Annotator) {
+	a.Describe(&k, "A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with")
+}
+
+type NanosKernelArgs struct {
+	Version              string `pulumi:"version"`
+	Architecture         string `pulumi:"architecture,optional"`
+	ExpectedKernelSha256 string `pulumi:"expectedKernelSha256,optional"`
+	OpsHome              string `pulumi:"opsHome,optional"`
+}
+
+func (k *NanosKernelArgs) Annotate(a infer.Annotator) {
+	a.Describe(&k.Version, "The nanos release version (e.g. '0.1.54')")
+	a.Describe(&k.Architecture, "The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture")
+	a.Describe(&k.ExpectedKernelSha256, "The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against")
+	a.Describe(&k.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
+}
+
+type NanosKernelState struct {
+	Version      string `pulumi:"version"`
+	Architecture string `pulumi:"architecture"`
+	OpsHome      string `pulumi:"opsHome,optional"`
+	KernelPath   string `pulumi:"kernelPath"`
+	BootPath     string `pulumi:"bootPath,optional"`
+	KlibsDir     string `pulumi:"klibsDir"`
+	KernelSha256 string `pulumi:"kernelSha256"`
+	BootSha256   string `pulumi:"bootSha256,optional"`
+}
+
+func (k *NanosKernelState) Annotate(a infer.Annotator) {
+	a.Describe(&k.Version, "The nanos release version")
+	a.Describe(&k.Architecture, "The architecture of the release")
+	a.Describe(&k.OpsHome, "The directory containing the ops home (.ops) the release is downloaded into")
+	a.Describe(&k.KernelPath, "The path of kernel.img")
+	a.Describe(&k.BootPath, "The path of boot.img, not part of arm64 releases")
+	a.Describe(&k.KlibsDir, "The directory containing the klibs of the release")
+	a.Describe(&k.KernelSha256, "The SHA-256 digest of kernel.img")
+	a.Describe(&k.BootSha256, "The SHA-256 digest of boot.img")
+}
+
+func (*NanosKernel) Create(ctx context.Context, req infer.CreateRequest[NanosKernelArgs]) (infer.CreateResponse[NanosKernelState], error) {
+	var resp infer.CreateResponse[NanosKernelState]
+
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	arch := kernelArchitecture(ctx, req.Inputs.Architecture)
+	resp.ID = req.Inputs.Version + "-" + arch
+	resp.Output = nanosKernelState(req.Inputs.Version, arch, req.Inputs.OpsHome)
+
+	if req.DryRun { // Don't download if in preview
+		return resp, nil
+	}
+
+	if err := prepareAssets(ctx, releaseAsset(req.Inputs.Version, arch)); err != nil {
+		return resp, err
+	}
+	if err := inspectNanosKernel(&resp.Output); err != nil {
+		p.GetLogger(ctx).Infof("Downloading nanos %s for %s: %v", req.Inputs.Version, arch, err)
+		if err := downloadNanosKernel(req.Inputs.Version, arch); err != nil {
+			return resp, fmt.Errorf("failed to download nanos %s for %s: %w", req.Inputs.Version, arch, err)
+		}
+		if err := inspectNanosKernel(&resp.Output); err != nil {
+			return resp, fmt.Errorf("invalid nanos release %s for %s: %w", req.Inputs.Version, arch, err)
+		}
+	}
+	if req.Inputs.ExpectedKernelSha256 != "" && req.Inputs.ExpectedKernelSha256 != resp.Output.KernelSha256 {
+		return resp, fmt.Errorf("kernel.img of nanos %s for %s has digest %s, expected %s", req.Inputs.Version, arch, resp.Output.KernelSha256, req.Inputs.ExpectedKernelSha256)
+	}
+
+	return resp, nil
+}
+
+func (*NanosKernel) Delete(ctx context.Context, req infer.DeleteRequest[NanosKernelState]) (infer.DeleteResponse, error) {
+	// The release directory is shared with ops and images built outside of
+	// this stack, so it is left in place.
+	p.GetLogger(ctx).Infof("keeping nanos %s for %s in the ops home", req.State.Version, req.State.Architecture)
+	return infer.DeleteResponse{}, nil
+}
+
+func (*NanosKernel) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[NanosKernelArgs], error) {
+	args, fails, err := infer.DefaultCheck[NanosKernelArgs](ctx, req.NewInputs)
+
+	version, ok := req.NewInputs.GetOk("version")
+	if ok && version.IsString() && version.AsString() == "" {
+		fails = append(fails, p.CheckFailure{
+			Property: "version",
+			Reason:   "version must be a non-empty string",
+		})
+	}
+
+	architecture, ok := req.NewInputs.GetOk("architecture")
+	if ok && architecture.IsString() {
+		arch := architecture.AsString()
+		if arch != "" && arch != "amd64" && arch != "arm64" {
+			fails = append(fails, p.CheckFailure{
+				Property: "architecture",
+				Reason:   "architecture must be either 'amd64' or 'arm64'",
+			})
+		}
+	}
+
+	return infer.CheckResponse[NanosKernelArgs]{
+		Inputs:   args,
+		Failures: fails,
+	}, err
+}
+
+func (k *NanosKernel) Update(ctx context.Context, req infer.UpdateRequest[NanosKernelArgs, NanosKernelState]) (infer.UpdateResponse[NanosKernelState], error) {
+	// Only the expected digest can change without a replacement (see Diff),
+	// which is compared with the release again.
+	res, err := k.Create(ctx, infer.CreateRequest[NanosKernelArgs]{Inputs: req.Inputs, DryRun: req.DryRun})
+	return infer.UpdateResponse[NanosKernelState]{Output: res.Output}, err
+}
+
+func (*NanosKernel) Diff(ctx context.Context, req infer.DiffRequest[NanosKernelArgs, NanosKernelState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.Inputs.Version != req.State.Version {
+		diff["version"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if kernelArchitecture(ctx, req.Inputs.Architecture) != req.State.Architecture {
+		diff["architecture"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.OpsHome != req.State.OpsHome {
+		diff["opsHome"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.ExpectedKernelSha256 != "" && req.Inputs.ExpectedKernelSha256 != req.State.KernelSha256 {
+		diff["expectedKernelSha256"] = p.PropertyDiff{Kind: p.Update}
+	}
+	return infer.DiffResponse{
+		HasChanges:   len(diff) > 0,
+		DetailedDiff: diff,
+	}, nil
+}
+
+func (*NanosKernel) Read(ctx context.Context, req infer.ReadRequest[NanosKernelArgs, NanosKernelState]) (infer.ReadResponse[NanosKernelArgs, NanosKernelState], error) {
+	defer useOpsHome(req.State.OpsHome)()
+
+	state := nanosKernelState(req.State.Version, req.State.Architecture, req.State.OpsHome)
+	if err := inspectNanosKernel(&state); err != nil {
+		// The release was removed from the ops home, it is downloaded again on
+		// the next update.
+		p.GetLogger(ctx).Warningf("nanos %s for %s not found: %v", req.State.Version, req.State.Architecture, err)
+		return infer.ReadResponse[NanosKernelArgs, NanosKernelState]{}, nil
+	}
+
+	return infer.ReadResponse[NanosKernelArgs, NanosKernelState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State:  state,
+	}, nil
+}
+
+// kernelArchitecture returns arch, or the default architecture if it is empty.
+func kernelArchitecture(ctx context.Context, arch string) string {
+	if arch != "" {
+		return arch
+	}
+	if defaultArch := infer.GetConfig[Config](ctx).Architecture; defaultArch != "" {
+		return defaultArch
+	}
+	return runtime.GOARCH
+}
+
+// nanosKernelState returns the state of the nanos release version for arch,
+// without the digests of the files.
+func nanosKernelState(version string, arch string, opsHome string) NanosKernelState {
+	dir := path.Join(lepton.GetOpsHome(), releaseDirName(version, arch))
+	state := NanosKernelState{
+		Version:      version,
+		Architecture: arch,
+		OpsHome:      opsHome,
+		KernelPath:   path.Join(dir, "kernel.img"),
+		KlibsDir:     path.Join(dir, "klibs"),
+	}
+	if arch == "amd64" {
+		state.BootPath = path.Join(dir, "boot.img")
+	}
+	return state
+}
+
+// inspectNanosKernel checks that the files of the release exist and are not
+// empty and sets their digests in state. It can't tell if the files are
+// intact, nanos releases have no published checksums.
+func inspectNanosKernel(state *NanosKernelState) error {
+	var err error
+	if state.KernelSha256, err = fileSha256(state.KernelPath); err != nil {
+		return err
+	}
+	if state.BootPath != "" {
+		if state.BootSha256, err = fileSha256(state.BootPath); err != nil {
+			return err
+		}
+	}
+	klibs, err := os.ReadDir(state.KlibsDir)
+	if err != nil {
+		return err
+	}
+	if len(klibs) == 0 {
+		return fmt.Errorf("no klibs in %s", state.KlibsDir)
+	}
+	return nil
+}
+
+// downloadNanosKernel downloads the nanos release version for arch into the ops
+// home.
+func downloadNanosKernel(version string, arch string) error {
+	// lepton decides between the arm and amd64 release on AltGOARCH as well.
+	defer altGOARCH.use(arch)()
+
+	if goarch(arch) == "arm64" {
+		return lepton.DownloadReleaseImages(version, "arm")
+	}
+	return lepton.DownloadReleaseImages(version, "amd64")
+}
+
+func fileSha256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+	if info.Size() == 0 {
+		return "", fmt.Errorf("%s is empty", name)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// asset is something ops downloads into the ops home when it is missing, such
+// as a nanos release or a package.
+type asset struct {
+	// name describes the asset in errors.
+	name string
+	// path is the path of the asset relative to the ops home.
+	path string
+	// files must exist in path for the asset to be complete, path itself must
+	// exist if there are none.
+	files []string
+}
+
+// commonAsset contains the files ops adds to every image.
+var commonAsset = asset{
+	name: "common files",
+	path: "common.tar.gz",
+}
+
+// releaseAsset returns the asset of nanos version for arch.
+func releaseAsset(version string, arch string) asset {
+	return asset{
+		name:  fmt.Sprintf("nanos %s for %s", version, arch),
+		path:  releaseDirName(version, arch),
+		files: []string{"kernel.img", "klibs"},
+	}
+}
+
+// packageAsset returns the asset of the package in packagePath.
+func packageAsset(name string, packagePath string) (asset, error) {
+	rel, err := filepath.Rel(lepton.GetOpsHome(), packagePath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return asset{}, fmt.Errorf("package %s is not in the ops home %s", packagePath, lepton.GetOpsHome())
+	}
+	return asset{
+		name:  "package " + name,
+		path:  rel,
+		files: []string{"package.manifest"},
+	}, nil
+}
+
+// prepareAssets copies the assets missing in the ops home from the mirror
+// directory of the provider configuration. In offline mode it fails with all
+// assets that are still missing, otherwise ops downloads them when needed.
+func prepareAssets(ctx context.Context, assets ...asset) error {
+	config := infer.GetConfig[Config](ctx)
+	opsHome := lepton.GetOpsHome()
+
+	var missing []string
+	for _, a := range assets {
+		if a.complete(opsHome) {
+			continue
+		}
+		if config.MirrorDir != "" && a.complete(config.MirrorDir) {
+			p.GetLogger(ctx).Infof("Copying %s from %s", a.name, config.MirrorDir)
+			if err := copyTree(filepath.Join(config.MirrorDir, a.path), filepath.Join(opsHome, a.path)); err != nil {
+				return fmt.Errorf("failed to copy %s from mirror: %w", a.name, err)
+			}
+			continue
+		}
+		missing = append(missing, fmt.Sprintf("%s (%s)", a.name, a.path))
+	}
+
+	if len(missing) > 0 && config.Offline {
+		where := opsHome
+		if config.MirrorDir != "" {
+			where += " or " + config.MirrorDir
+		}
+		return fmt.Errorf("offline mode, not found in %s: %s", where, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func (a asset) complete(root string) bool {
+	if len(a.files) == 0 {
+		_, err := os.Stat(filepath.Join(root, a.path))
+		return err == nil
+	}
+	for _, f := range a.files {
+		if _, err := os.Stat(filepath.Join(root, a.path, f)); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// copyTree copies the file or directory src to dst, keeping files that already
+// exist in dst.
+func copyTree(src string, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		if _, err := os.Lstat(target); err == nil {
+			return nil
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, target)
+		}
+		return copyFile(path, target)
+	})
+}
+
+func copyFile(src string, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	// Copy to a temporary file first, so an interrupted copy doesn't leave an
+	// incomplete asset behind.
+	tmp, err := os.CreateTemp(filepath.Dir(dst), "."+filepath.Base(dst)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(info.Mode().Perm()); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"os"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/nanovms/ops/lepton"
+)
+
+// The ops home is process global in lepton: it is read from the OPS_HOME
+// environment variable and cached in LocalVolumeDir and LocalReleaseVersion.
+// Like archGuard, opsHomeGuard lets operations using the same ops home, the
+// default one of the provider configuration or the same per-resource
+// override, run concurrently. An operation for another ops home waits until
+// all of them are done.
+type opsHomeGuard struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	home    string
+	holders int
+
+	// The default ops home, restored when the last holder of an override is
+	// done.
+	savedEnv            string
+	savedHasEnv         bool
+	savedVolumeDir      string
+	savedReleaseVersion string
+}
+
+var opsHomeEnv = newOpsHomeGuard()
+
+func newOpsHomeGuard() *opsHomeGuard {
+	g := &opsHomeGuard{}
+	g.cond = sync.NewCond(&g.mu)
+	return g
+}
+
+// use makes lepton use home as the ops home until the returned function is
+// called. An empty home uses the ops home of the provider configuration.
+func (g *opsHomeGuard) use(home string) (release func()) {
+	g.mu.Lock()
+	for g.holders > 0 && g.home != home {
+		g.cond.Wait()
+	}
+	if g.holders == 0 {
+		g.home = home
+		if home != "" {
+			g.savedEnv, g.savedHasEnv = os.LookupEnv("OPS_HOME")
+			g.savedVolumeDir, g.savedReleaseVersion = lepton.LocalVolumeDir, lepton.LocalReleaseVersion
+			setOpsHome(home)
+		}
+	}
+	g.holders++
+	g.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			g.mu.Lock()
+			g.holders--
+			if g.holders == 0 {
+				if g.home != "" {
+					if g.savedHasEnv {
+						os.Setenv("OPS_HOME", g.savedEnv)
+					} else {
+						os.Unsetenv("OPS_HOME")
+					}
+					lepton.LocalVolumeDir, lepton.LocalReleaseVersion = g.savedVolumeDir, g.savedReleaseVersion
+				}
+				g.cond.Broadcast()
+			}
+			g.mu.Unlock()
+		})
+	}
+}
+
+// setDefault makes home the ops home of the provider configuration once no
+// operation uses an ops home.
+func (g *opsHomeGuard) setDefault(home string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for g.holders > 0 {
+		g.cond.Wait()
+	}
+	setOpsHome(home)
+}
+
+// useOpsHome makes lepton use home as the ops home until the returned function
+// is called, see opsHomeGuard.use. Operations for the same ops home may nest
+// their calls, an operation must not use another ops home while holding one.
+func useOpsHome(home string) (release func()) {
+	return opsHomeEnv.use(home)
+}
+
+// localVolumeDir returns the directory onprem volumes are stored in for the ops
+// home in home, or for the ops home of the provider configuration.
+func localVolumeDir(home string) string {
+	if home != "" {
+		return path.Join(home, ".ops", "volumes")
+	}
+	defer useOpsHome("")()
+	return lepton.LocalVolumeDir
+}
+
+// setOpsHome points lepton at the ops home in home, which, like OPS_HOME, is
+// the directory containing the .ops directory.
+func setOpsHome(home string) {
+	os.Setenv("OPS_HOME", home)
+	lepton.LocalVolumeDir = path.Join(lepton.GetOpsHome(), "volumes")
+	lepton.LocalReleaseVersion = localReleaseVersion()
+}
+
+// localReleaseVersion returns the latest nanos release downloaded into the
+// current ops home, or "0.0" if there is none, like lepton does on startup.
+func localReleaseVersion() string {
+	data, err := os.ReadFile(path.Join(lepton.GetOpsHome(), "latest.txt"))
+	if err != nil {
+		return "0.0"
+	}
+	return strings.TrimSuffix(string(data), "\n")
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestOpsHomeGuard(t *testing.T) {
+	defaultHome := lepton.GetOpsHome()
+	home, otherHome := t.TempDir(), t.TempDir()
+	g := newOpsHomeGuard()
+
+	release := g.use(home)
+	// Operations for the same ops home share it.
+	releaseNested := g.use(home)
+	if got := lepton.GetOpsHome(); got != path.Join(home, ".ops") {
+		t.Fatalf("ops home is %v while using %v", got, home)
+	}
+
+	acquired := make(chan string)
+	go func() {
+		defer g.use(otherHome)()
+		acquired <- lepton.GetOpsHome()
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("another ops home is used while the ops home is in use")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+	releaseNested()
+	select {
+	case got := <-acquired:
+		if got != path.Join(otherHome, ".ops") {
+			t.Fatalf("ops home is %v while using %v", got, otherHome)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the other ops home is not used after the ops home is released")
+	}
+
+	defer g.use("")()
+	if got := lepton.GetOpsHome(); got != defaultHome {
+		t.Fatalf("ops home is %v after the overrides, want the default %v", got, defaultHome)
+	}
+}
+
+// TestOpsHomeConcurrentOverrides creates instances with ops home overrides
+// while listing instances through a function, which uses the default ops home.
+func TestOpsHomeConcurrentOverrides(t *testing.T) {
+	cloud := useFakeCloud(t)
+	cloud.delay = 2 * time.Millisecond
+	server := newTestServer(t, p.ConfigureRequest{})
+	defaultHome := lepton.GetOpsHome()
+	homes := []string{t.TempDir(), t.TempDir()}
+
+	var wg sync.WaitGroup
+	for i, home := range homes {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 10 {
+				name := fmt.Sprintf("web-%d-%d", i, j)
+				urn := resource.CreateURN(name, "nanovms:index:Instance", "", "project", "stack")
+				_, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(map[string]property.Value{
+					"name":     property.New(name),
+					"provider": property.New("gcp"),
+					"opsHome":  property.New(home),
+				})})
+				if err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 10 {
+				_, err := server.Invoke(p.InvokeRequest{Token: "nanovms:index:getInstances", Args: property.NewMap(map[string]property.Value{
+					"provider": property.New("gcp"),
+				})})
+				if err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(cloud.observed) != 40 {
+		t.Errorf("observed %d operations, want 40", len(cloud.observed))
+	}
+	for _, o := range cloud.observed {
+		want := defaultHome
+		if o.op == "createInstance" {
+			var i, j int
+			if _, err := fmt.Sscanf(o.name, "web-%d-%d", &i, &j); err != nil {
+				t.Fatal(err)
+			}
+			want = path.Join(homes[i], ".ops")
+		}
+		if o.opsHome != want {
+			t.Errorf("%s %s used ops home %v, want %v", o.op, o.name, o.opsHome, want)
+		}
+	}
+}
+
+func TestOpsHomeReleasedWhileWaitingForReadiness(t *testing.T) {
+	cloud := useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+
+	// The slow instance answers on its health check once ready is set.
+	var ready atomic.Bool
+	health := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !ready.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	t.Cleanup(health.Close)
+	healthURL, err := url.Parse(health.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	port, err := strconv.Atoi(healthURL.Port())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := func(name string, home string, readiness property.Value) error {
+		inputs := map[string]property.Value{
+			"name":     property.New(name),
+			"provider": property.New("onprem"),
+			"opsHome":  property.New(home),
+		}
+		if !readiness.IsNull() {
+			inputs["readiness"] = readiness
+		}
+		urn := resource.CreateURN(name, "nanovms:index:Instance", "", "project", "stack")
+		_, err := server.Create(p.CreateRequest{Urn: urn, Properties: property.NewMap(inputs)})
+		return err
+	}
+
+	slowDone := make(chan error, 1)
+	go func() {
+		slowDone <- create("slow", t.TempDir(), property.New(property.NewMap(map[string]property.Value{
+			"port":         property.New(float64(port)),
+			"httpPath":     property.New("/health"),
+			"timeout":      property.New("30s"),
+			"pollInterval": property.New("10ms"),
+		})))
+	}()
+
+	// An instance on another ops home is created while the slow one waits.
+	for {
+		cloud.mu.Lock()
+		_, created := cloud.instances["slow"]
+		cloud.mu.Unlock()
+		if created {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	fastDone := make(chan error, 1)
+	go func() {
+		fastDone <- create("fast", t.TempDir(), property.Value{})
+	}()
+	select {
+	case err := <-fastDone:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case err := <-slowDone:
+		t.Fatalf("slow instance is done before it is ready: %v", err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("instance on another ops home waits for the readiness of the slow instance")
+	}
+
+	ready.Store(true)
+	if err := <-slowDone; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+type Package struct{}
+
+var _ = (infer.CustomCreate[PackageArgs, PackageState])((*Package)(nil))
+var _ = (infer.CustomDelete[PackageState])((*Package)(nil))
+var _ = (infer.CustomCheck[PackageArgs])((*Package)(nil))
+var _ = (infer.CustomUpdate[PackageArgs, PackageState])((*Package)(nil))
+var _ = (infer.CustomDiff[PackageArgs, PackageState])((*Package)(nil))
+var _ = (infer.CustomRead[PackageArgs, PackageState])((*Package)(nil))
+var _ = (infer.ExplicitDependencies[PackageArgs, PackageState])((*Package)(nil))
+var _ = (infer.Annotated)((*Package)(nil))
+var _ = (infer.Annotated)((*PackageArgs)(nil))
+var _ = (infer.Annotated)((*PackageState)(nil))
+
+func (pkg *Package) Annotate(a infer.Annotator) {
+	a.Describe(&pkg, "A local ops package built from a sysroot directory, to be used by a PackageImage")
+}
+
+type PackageArgs struct {
+	Name         string            `pulumi:"name"`
+	Version      string            `pulumi:"version"`
+	Program      string            `pulumi:"program"`
+	Sysroot      string            `pulumi:"sysroot"`
+	Args         []string          `pulumi:"args,optional"`
+	Env          map[string]string `pulumi:"env,optional"`
+	Language     string            `pulumi:"language,optional"`
+	Description  string            `pulumi:"description,optional"`
+	Architecture string            `pulumi:"architecture,optional"`
+	OpsHome      string            `pulumi:"opsHome,optional"`
+}
+
+func (pkg *PackageArgs) Annotate(a infer.Annotator) {
+	a.Describe(&pkg.Name, "The name of the package, without version")
+	a.Describe(&pkg.Version, "The version of the package")
+	a.Describe(&pkg.Program, "The path of the executable inside the sysroot (e.g. '/usr/bin/app')")
+	a.Describe(&pkg.Sysroot, "The directory with the files of the package, copied as its sysroot")
+	a.Describe(&pkg.Args, "The arguments to start the program with, after the program name")
+	a.Describe(&pkg.Env, "The environment variables of the program")
+	a.Describe(&pkg.Language, "The language of the program")
+	a.Describe(&pkg.Description, "A description of the package")
+	a.Describe(&pkg.Architecture, "The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture")
+	a.Describe(&pkg.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
+}
+
+type PackageState struct {
+	PackageArgs
+	PackageName string `pulumi:"packageName"`
+	PackagePath string `pulumi:"packagePath"`
+	ArchivePath string `pulumi:"archivePath"`
+	ContentHash string `pulumi:"contentHash"`
+}
+
+func (pkg *PackageState) Annotate(a infer.Annotator) {
+	a.Describe(&pkg.PackageName, "The qualified name of the package (name_version), to use as packageName of a PackageImage with localPackage set")
+	a.Describe(&pkg.PackagePath, "The directory of the package in the local packages of the ops home")
+	a.Describe(&pkg.ArchivePath, "The path of the .tar.gz archive of the package")
+	a.Describe(&pkg.ContentHash, "The digest of the manifest and all files in the sysroot of the package")
+}
+
+// packageManifest is the package.manifest of an ops package, its fields are
+// merged into the image configuration when the package is used.
+type packageManifest struct {
+	Program     string            `json:",omitempty"`
+	Args        []string          `json:",omitempty"`
+	Env         map[string]string `json:",omitempty"`
+	Version     string            `json:",omitempty"`
+	Language    string            `json:",omitempty"`
+	Description string            `json:",omitempty"`
+}
+
+var packageNameRegex = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+
+func (*Package) Create(ctx context.Context, req infer.CreateRequest[PackageArgs]) (infer.CreateResponse[PackageState], error) {
+	var resp infer.CreateResponse[PackageState]
+
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	state, manifest, err := packageLayout(ctx, req.Inputs)
+	if err != nil {
+		return resp, err
+	}
+	resp.ID = state.PackageName
+	resp.Output = state
+
+	if req.DryRun { // Don't do the actual creating if in preview
+		return resp, nil
+	}
+
+	p.GetLogger(ctx).Infof("creating package %s in %s", state.PackageName, state.PackagePath)
+	if err := writePackage(state, manifest); err != nil {
+		return resp, fmt.Errorf("failed to create package: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (*Package) Delete(ctx context.Context, req infer.DeleteRequest[PackageState]) (infer.DeleteResponse, error) {
+	var resp infer.DeleteResponse
+
+	defer useOpsHome(req.State.OpsHome)()
+
+	p.GetLogger(ctx).Infof("deleting package %s", req.State.PackageName)
+	if err := os.RemoveAll(req.State.PackagePath); err != nil {
+		return resp, fmt.Errorf("failed to delete package: %w", err)
+	}
+	if err := os.Remove(req.State.ArchivePath); err != nil && !os.IsNotExist(err) {
+		return resp, fmt.Errorf("failed to delete package archive: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (*Package) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[PackageArgs], error) {
+	if _, ok := req.NewInputs.GetOk("name"); !ok {
+		req.NewInputs = req.NewInputs.Set("name", property.New(req.Name))
+	}
+	args, fails, err := infer.DefaultCheck[PackageArgs](ctx, req.NewInputs)
+
+	name, ok := req.NewInputs.GetOk("name")
+	if ok && name.IsString() && !packageNameRegex.MatchString(name.AsString()) {
+		fails = append(fails, p.CheckFailure{
+			Property: "name",
+			Reason:   "name may only contain letters, digits and '-'",
+		})
+	}
+
+	version, ok := req.NewInputs.GetOk("version")
+	if ok && version.IsString() && (version.AsString() == "" || strings.ContainsAny(version.AsString(), "/ \t")) {
+		fails = append(fails, p.CheckFailure{
+			Property: "version",
+			Reason:   "version must be non-empty and may not contain '/' or whitespace",
+		})
+	}
+
+	program, ok := req.NewInputs.GetOk("program")
+	if ok && program.IsString() && !path.IsAbs(program.AsString()) {
+		fails = append(fails, p.CheckFailure{
+			Property: "program",
+			Reason:   "program must be an absolute path inside the sysroot",
+		})
+	}
+
+	architecture, ok := req.NewInputs.GetOk("architecture")
+	if ok && architecture.IsString() {
+		arch := architecture.AsString()
+		if arch != "" && arch != "amd64" && arch != "arm64" {
+			fails = append(fails, p.CheckFailure{
+				Property: "architecture",
+				Reason:   "architecture must be either 'amd64' or 'arm64'",
+			})
+		}
+	}
+
+	return infer.CheckResponse[PackageArgs]{
+		Inputs:   args,
+		Failures: fails,
+	}, err
+}
+
+func (*Package) Update(ctx context.Context, req infer.UpdateRequest[PackageArgs, PackageState]) (infer.UpdateResponse[PackageState], error) {
+	var resp infer.UpdateResponse[PackageState]
+
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	// The location of the package doesn't change (see Diff), it is rewritten
+	// with the new content.
+	state, manifest, err := packageLayout(ctx, req.Inputs)
+	if err != nil {
+		return resp, err
+	}
+	resp.Output = state
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	p.GetLogger(ctx).Infof("updating package %s in %s", state.PackageName, state.PackagePath)
+	if err := writePackage(state, manifest); err != nil {
+		return resp, fmt.Errorf("failed to update package: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (*Package) Diff(ctx context.Context, req infer.DiffRequest[PackageArgs, PackageState]) (infer.DiffResponse, error) {
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	diff := map[string]p.PropertyDiff{}
+	if req.Inputs.Name != req.State.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Version != req.State.Version {
+		diff["version"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if kernelArchitecture(ctx, req.Inputs.Architecture) != req.State.Architecture {
+		diff["architecture"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.OpsHome != req.State.OpsHome {
+		diff["opsHome"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Sysroot != req.State.Sysroot {
+		diff["sysroot"] = p.PropertyDiff{Kind: p.Update}
+	}
+
+	state, _, err := packageLayout(ctx, req.Inputs)
+	if err != nil {
+		return infer.DiffResponse{}, err
+	}
+	if state.ContentHash != req.State.ContentHash {
+		p.GetLogger(ctx).Infof("content of package %s changed", state.PackageName)
+		diff["contentHash"] = p.PropertyDiff{Kind: p.Update}
+	}
+
+	return infer.DiffResponse{
+		DeleteBeforeReplace: false,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+func (*Package) Read(ctx context.Context, req infer.ReadRequest[PackageArgs, PackageState]) (infer.ReadResponse[PackageArgs, PackageState], error) {
+	defer useOpsHome(req.State.OpsHome)()
+
+	if _, err := os.Stat(path.Join(req.State.PackagePath, "package.manifest")); os.IsNotExist(err) {
+		p.GetLogger(ctx).Warningf("package %s not found in %s", req.State.PackageName, req.State.PackagePath)
+		return infer.ReadResponse[PackageArgs, PackageState]{}, nil
+	}
+
+	return infer.ReadResponse[PackageArgs, PackageState]{
+		ID:     req.ID,
+		Inputs: req.Inputs,
+		State:  req.State,
+	}, nil
+}
+
+func (*Package) WireDependencies(f infer.FieldSelector, args *PackageArgs, state *PackageState) {
+	f.OutputField(&state.PackageName).DependsOn(f.InputField(&args.Name), f.InputField(&args.Version))
+	f.OutputField(&state.PackagePath).DependsOn(f.InputField(&args.Name), f.InputField(&args.Version), f.InputField(&args.Architecture), f.InputField(&args.OpsHome))
+	f.OutputField(&state.ArchivePath).DependsOn(f.InputField(&args.Name), f.InputField(&args.Version), f.InputField(&args.Architecture), f.InputField(&args.OpsHome))
+	f.OutputField(&state.ContentHash).DependsOn(f.InputField(&args.Version), f.InputField(&args.Program), f.InputField(&args.Sysroot), f.InputField(&args.Args), f.InputField(&args.Env), f.InputField(&args.Language), f.InputField(&args.Description))
+}
+
+// manifest returns the package.manifest for args, the arguments of the program
+// start with the program itself like in the configuration of ops.
+func (args PackageArgs) manifest() packageManifest {
+	return packageManifest{
+		Program:     args.Program,
+		Args:        append([]string{args.Program}, args.Args...),
+		Env:         args.Env,
+		Version:     args.Version,
+		Language:    args.Language,
+		Description: args.Description,
+	}
+}
+
+// packageLayout returns the state of the package for args, which is stored in
+// the local packages of the current ops home, and its manifest.
+func packageLayout(ctx context.Context, args PackageArgs) (PackageState, []byte, error) {
+	info, err := os.Stat(args.Sysroot)
+	if err != nil {
+		return PackageState{}, nil, fmt.Errorf("sysroot %s not found: %w", args.Sysroot, err)
+	} else if !info.IsDir() {
+		return PackageState{}, nil, fmt.Errorf("sysroot %s is not a directory", args.Sysroot)
+	}
+	if _, err := os.Stat(sysrootPath(args.Sysroot, args.Program)); err != nil {
+		return PackageState{}, nil, fmt.Errorf("program %s not found in sysroot %s: %w", args.Program, args.Sysroot, err)
+	}
+
+	manifest, err := json.MarshalIndent(args.manifest(), "", "  ")
+	if err != nil {
+		return PackageState{}, nil, fmt.Errorf("failed to marshal package manifest: %w", err)
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "manifest\x00%s\x00", manifest)
+	if err := digestDir(h, "sysroot", args.Sysroot); err != nil {
+		return PackageState{}, nil, err
+	}
+
+	args.Architecture = kernelArchitecture(ctx, args.Architecture)
+	name := args.Name + "_" + args.Version
+	packagePath := path.Join(lepton.GetOpsHome(), "local_packages", args.Architecture, name)
+	return PackageState{
+		PackageArgs: args,
+		PackageName: name,
+		PackagePath: packagePath,
+		ArchivePath: packagePath + ".tar.gz",
+		ContentHash: "sha256:" + hex.EncodeToString(h.Sum(nil)),
+	}, manifest, nil
+}
+
+// writePackage writes the package directory with the manifest and a copy of
+// the sysroot, and the archive of the package next to it like 'ops pkg push'.
+func writePackage(state PackageState, manifest []byte) error {
+	parent := filepath.Dir(state.PackagePath)
+	if err := os.MkdirAll(parent, 0755); err != nil {
+		return err
+	}
+	// Build the package next to its final location and swap it in when it is
+	// complete, so a failure doesn't leave a partial package behind.
+	tmp, err := os.MkdirTemp(parent, "."+filepath.Base(state.PackagePath)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(tmp, "package.manifest"), manifest, 0644); err != nil {
+		return err
+	}
+	if err := copyTree(state.Sysroot, filepath.Join(tmp, "sysroot")); err != nil {
+		return fmt.Errorf("failed to copy sysroot: %w", err)
+	}
+
+	if err := os.RemoveAll(state.PackagePath); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, state.PackagePath); err != nil {
+		return err
+	}
+	return lepton.CreateTarGz(state.PackagePath, state.ArchivePath)
+}
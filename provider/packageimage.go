@@ -11,7 +11,6 @@ import (
 
 	"github.com/nanovms/ops/cmd"
 	"github.com/nanovms/ops/lepton"
-	"github.com/nanovms/ops/provider"
 	"github.com/nanovms/ops/types"
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
@@ -37,65 +36,120 @@ func (i *PackageImage) Annotate(a infer.Annotator) {
 }
 
 type PackageImageArgs struct {
-	Name            string `pulumi:"name"`
-	PackageName     string `pulumi:"packageName"`
-	Config          string `pulumi:"config,optional"`
-	Provider        string `pulumi:"provider"`
-	Architecture    string `pulumi:"architecture,optional"`
-	Force           bool   `pulumi:"force,optional"`
-	UseLatestKernel bool   `pulumi:"useLatestKernel,optional"`
+	Name            string     `pulumi:"name"`
+	PackageName     string     `pulumi:"packageName,optional"`
+	LocalPackage    bool       `pulumi:"localPackage,optional"`
+	PackagePath     string     `pulumi:"packagePath,optional"`
+	ContainerImage  string     `pulumi:"containerImage,optional"`
+	Config          string     `pulumi:"config,optional"`
+	OpsConfig       *OpsConfig `pulumi:"opsConfig,optional"`
+	Provider        string     `pulumi:"provider,optional"`
+	Architecture    string     `pulumi:"architecture,optional"`
+	Force           bool       `pulumi:"force,optional"`
+	UseLatestKernel bool       `pulumi:"useLatestKernel,optional"`
+	KernelVersion   string     `pulumi:"kernelVersion,optional"`
+	OpsHome         string     `pulumi:"opsHome,optional"`
 }
 
 func (i *PackageImageArgs) Annotate(a infer.Annotator) {
 	a.Describe(&i.Name, "The name of the image")
-	a.Describe(&i.PackageName, "The name of the package to use (e.g., 'node_v18.7.0')")
-	a.Describe(&i.Config, "The configuration as a JSON encoded string")
+	a.Describe(&i.PackageName, "The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set")
+	a.Describe(&i.LocalPackage, "If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'")
+	a.Describe(&i.PackagePath, "The path to a package directory containing a package.manifest and a sysroot, used instead of packageName")
+	a.Describe(&i.ContainerImage, "The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image")
+	a.Describe(&i.Config, "The configuration as a JSON encoded string, merged on top of opsConfig")
+	a.Deprecate(&i.Config, "use opsConfig instead")
+	a.Describe(&i.OpsConfig, "The configuration of the image")
 	a.Describe(&i.Provider, "The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)")
 	a.Describe(&i.Architecture, "The target architecture (amd64 or arm64). If not specified, uses the current system architecture")
 	a.Describe(&i.Force, "If an already existing image should be deleted if it exists")
 	a.Describe(&i.UseLatestKernel, "If the latest kernel should be used, download it if necessary")
+	a.Describe(&i.KernelVersion, "The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration")
+	a.Describe(&i.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
 }
 
 type PackageImageState struct {
 	ImagePath       string `pulumi:"imagePath"`
 	ImageName       string `pulumi:"imageName"`
 	PackageName     string `pulumi:"packageName"`
+	LocalPackage    bool   `pulumi:"localPackage,optional"`
+	PackagePath     string `pulumi:"packagePath,optional"`
+	ContainerImage  string `pulumi:"containerImage,optional"`
+	ContainerDigest string `pulumi:"containerDigest,optional"`
+	ManifestDigest  string `pulumi:"manifestDigest,optional"`
 	Config          string `pulumi:"config"`
 	Provider        string `pulumi:"provider"`
 	Architecture    string `pulumi:"architecture"`
 	UseLatestKernel bool   `pulumi:"useLatestKernel"`
+	KernelVersion   string `pulumi:"kernelVersion,optional"`
 }
 
 func (i *PackageImageState) Annotate(a infer.Annotator) {
-	fmt.Fprintf(os.Stderr, "inferrer: %v ; i: %v\n", a, i)
 	a.Describe(&i.ImagePath, "The path to the built image")
 	a.Describe(&i.ImageName, "The name of the built image")
 	a.Describe(&i.PackageName, "The name of the package used")
+	a.Describe(&i.LocalPackage, "If the package is a local package")
+	a.Describe(&i.PackagePath, "The path to the package directory used")
+	a.Describe(&i.ContainerImage, "The path to the container image used")
+	a.Describe(&i.ContainerDigest, "The digest of the configuration of the container image used")
+	a.Describe(&i.ManifestDigest, "The digest of the package.manifest of the package")
 	a.Describe(&i.Config, "The configuration of the built image as a JSON encoded string")
 	a.Describe(&i.Provider, "The cloud provider of the built image")
 	a.Describe(&i.Architecture, "The target architecture of the built image")
 	a.Describe(&i.UseLatestKernel, "If the latest kernel should be used, download it if necessary")
+	a.Describe(&i.KernelVersion, "The nanos kernel version the image is built with")
 }
 
 func (*PackageImage) Create(ctx context.Context, req infer.CreateRequest[PackageImageArgs]) (infer.CreateResponse[PackageImageState], error) {
 	var resp infer.CreateResponse[PackageImageState]
 
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	if req.DryRun && req.Inputs.PackageName == "" && req.Inputs.PackagePath == "" && req.Inputs.ContainerImage == "" {
+		// The package is the output of a resource that isn't created yet, the
+		// outputs depending on it are unknown (see WireDependencies).
+		resp.ID = req.Inputs.Name
+		resp.Output = PackageImageState{
+			ImageName:       req.Inputs.Name,
+			LocalPackage:    req.Inputs.LocalPackage,
+			Provider:        req.Inputs.Provider,
+			Architecture:    req.Inputs.Architecture,
+			UseLatestKernel: req.Inputs.UseLatestKernel,
+		}
+		return resp, nil
+	}
+
 	builder, err := createPackageBuilder(ctx, req.Inputs, true)
 	if err != nil {
 		return resp, err
 	}
+	defer builder.release()
+
+	if _, err := os.Stat(builder.packagePath); err != nil {
+		return resp, fmt.Errorf("package %s not found at %s: %w", req.Inputs.PackageName, builder.packagePath, err)
+	}
+	if err := validateBuild(builder.config); err != nil {
+		return resp, err
+	}
 
 	if req.DryRun { // Don't do the actual creating if in preview
+		// The image path is only known after building, it is marked unknown
+		// through its dependencies in WireDependencies.
 		return infer.CreateResponse[PackageImageState]{
 			ID: req.Inputs.Name,
 			Output: PackageImageState{
-				ImagePath:       req.Inputs.Name,
 				ImageName:       req.Inputs.Name,
 				PackageName:     req.Inputs.PackageName,
+				LocalPackage:    req.Inputs.LocalPackage,
+				PackagePath:     req.Inputs.PackagePath,
+				ContainerImage:  req.Inputs.ContainerImage,
+				ContainerDigest: builder.containerDigest,
+				ManifestDigest:  builder.manifestDigest,
 				Config:          string(builder.configAsJson),
 				Provider:        req.Inputs.Provider,
 				Architecture:    builder.architecture,
 				UseLatestKernel: req.Inputs.UseLatestKernel,
+				KernelVersion:   builder.config.NanosVersion,
 			},
 		}, nil
 	}
@@ -132,10 +186,16 @@ func (*PackageImage) Create(ctx context.Context, req infer.CreateRequest[Package
 			ImagePath:       path.Base(imagePath),
 			ImageName:       req.Inputs.Name,
 			PackageName:     req.Inputs.PackageName,
+			LocalPackage:    req.Inputs.LocalPackage,
+			PackagePath:     req.Inputs.PackagePath,
+			ContainerImage:  req.Inputs.ContainerImage,
+			ContainerDigest: builder.containerDigest,
+			ManifestDigest:  builder.manifestDigest,
 			Config:          string(builder.configAsJson),
 			Provider:        req.Inputs.Provider,
 			Architecture:    builder.architecture,
 			UseLatestKernel: req.Inputs.UseLatestKernel,
+			KernelVersion:   builder.config.NanosVersion,
 		},
 	}, nil
 }
@@ -152,7 +212,9 @@ func (*PackageImage) Delete(ctx context.Context, req infer.DeleteRequest[Package
 		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to create provider: %w", err)
 	}
@@ -171,7 +233,11 @@ func (*PackageImage) Check(ctx context.Context, req infer.CheckRequest) (infer.C
 	if _, ok := req.NewInputs.GetOk("name"); !ok {
 		req.NewInputs = req.NewInputs.Set("name", property.New(req.Name))
 	}
-	args, fails, err := infer.DefaultCheck[PackageImageArgs](ctx, req.NewInputs)
+	req.NewInputs = setDefaultProvider(ctx, req.NewInputs)
+	var fails []p.CheckFailure
+	req.NewInputs, fails = pruneOpsConfig(req.NewInputs)
+	args, defaultFails, err := infer.DefaultCheck[PackageImageArgs](ctx, req.NewInputs)
+	fails = append(fails, defaultFails...)
 
 	provider, ok := req.NewInputs.GetOk("provider")
 	if !ok {
@@ -189,18 +255,67 @@ func (*PackageImage) Check(ctx context.Context, req infer.CheckRequest) (infer.C
 	// This allows all providers supported by ops/lepton to be used.
 
 	packageName, ok := req.NewInputs.GetOk("packageName")
-	if !ok {
+	if packagePath, hasPath := req.NewInputs.GetOk("packagePath"); hasPath {
+		if !packagePath.IsComputed() && (!packagePath.IsString() || packagePath.AsString() == "") {
+			fails = append(fails, p.CheckFailure{
+				Property: "packagePath",
+				Reason:   "packagePath must be a non-empty string",
+			})
+		}
+		if localPackage, ok := req.NewInputs.GetOk("localPackage"); ok && localPackage.IsBool() && localPackage.AsBool() {
+			fails = append(fails, p.CheckFailure{
+				Property: "localPackage",
+				Reason:   "localPackage cannot be used with packagePath",
+			})
+		}
+		if _, hasImage := req.NewInputs.GetOk("containerImage"); hasImage {
+			fails = append(fails, p.CheckFailure{
+				Property: "containerImage",
+				Reason:   "containerImage cannot be used with packagePath",
+			})
+		}
+	} else if containerImage, hasImage := req.NewInputs.GetOk("containerImage"); hasImage {
+		if !containerImage.IsComputed() && (!containerImage.IsString() || containerImage.AsString() == "") {
+			fails = append(fails, p.CheckFailure{
+				Property: "containerImage",
+				Reason:   "containerImage must be a non-empty string",
+			})
+		}
+		if ok {
+			fails = append(fails, p.CheckFailure{
+				Property: "containerImage",
+				Reason:   "containerImage cannot be used with packageName",
+			})
+		}
+		if localPackage, ok := req.NewInputs.GetOk("localPackage"); ok && localPackage.IsBool() && localPackage.AsBool() {
+			fails = append(fails, p.CheckFailure{
+				Property: "localPackage",
+				Reason:   "localPackage cannot be used with containerImage",
+			})
+		}
+	} else if !ok {
 		fails = append(fails, p.CheckFailure{
 			Property: "packageName",
-			Reason:   "packageName not specified",
+			Reason:   "packageName, packagePath or containerImage not specified",
 		})
-	} else if !packageName.IsString() || packageName.AsString() == "" {
+	} else if !packageName.IsComputed() && (!packageName.IsString() || packageName.AsString() == "") {
+		// packageName is unknown during preview when it is the output of a
+		// Package resource.
 		fails = append(fails, p.CheckFailure{
 			Property: "packageName",
 			Reason:   "packageName must be a non-empty string",
 		})
 	}
 
+	if kernelVersion, ok := req.NewInputs.GetOk("kernelVersion"); ok && kernelVersion.IsString() && kernelVersion.AsString() != "" {
+		if useLatestKernel, ok := req.NewInputs.GetOk("useLatestKernel"); ok && useLatestKernel.IsBool() && useLatestKernel.AsBool() {
+			fails = append(fails, p.CheckFailure{
+				Property: "kernelVersion",
+				Reason:   "kernelVersion and useLatestKernel cannot both be set",
+			})
+		}
+	}
+
 	architecture, ok := req.NewInputs.GetOk("architecture")
 	if ok && architecture.IsString() {
 		arch := architecture.AsString()
@@ -212,24 +327,8 @@ func (*PackageImage) Check(ctx context.Context, req infer.CheckRequest) (infer.C
 		}
 	}
 
-	config, ok := req.NewInputs.GetOk("config")
-	if ok {
-		if !config.IsString() {
-			fails = append(fails, p.CheckFailure{
-				Property: "config",
-				Reason:   "config must be a (JSON encoded) string",
-			})
-		} else {
-			var c types.Config
-			err := json.Unmarshal([]byte(config.AsString()), &c)
-			if err != nil {
-				fails = append(fails, p.CheckFailure{
-					Property: "config",
-					Reason:   fmt.Sprintf("invalid config: %v", err),
-				})
-			}
-		}
-	} else {
+	fails = append(fails, checkConfig(ctx, req.NewInputs, args.OpsConfig, checkKernel(ctx, req.NewInputs, kernelArchitecture(ctx, args.Architecture)))...)
+	if _, ok := req.NewInputs.GetOk("config"); !ok {
 		p.GetLogger(ctx).Info("empty config field, using defaults")
 	}
 
@@ -246,16 +345,31 @@ func (i *PackageImage) Update(ctx context.Context, req infer.UpdateRequest[Packa
 
 	createRequest := infer.CreateRequest[PackageImageArgs]{Inputs: req.Inputs, DryRun: req.DryRun}
 	res, err := i.Create(ctx, createRequest)
+	if req.DryRun {
+		// Rebuilding with the same name results in the same path.
+		res.Output.ImagePath = req.State.ImagePath
+	}
 
 	resp := infer.UpdateResponse[PackageImageState]{Output: res.Output}
 	return resp, err
 }
 
 func (*PackageImage) Diff(ctx context.Context, req infer.DiffRequest[PackageImageArgs, PackageImageState]) (infer.DiffResponse, error) {
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	if req.Inputs.PackageName == "" && req.Inputs.PackagePath == "" && req.Inputs.ContainerImage == "" {
+		// The package is unknown during preview, so it is assumed to change.
+		return infer.DiffResponse{
+			HasChanges:   true,
+			DetailedDiff: map[string]p.PropertyDiff{"packageName": {Kind: p.Update}},
+		}, nil
+	}
+
 	builder, err := createPackageBuilder(ctx, req.Inputs, false)
 	if err != nil {
 		return infer.DiffResponse{}, err
 	}
+	defer builder.release()
 
 	diff := map[string]p.PropertyDiff{}
 	if req.Inputs.Name != req.State.ImageName {
@@ -264,6 +378,34 @@ func (*PackageImage) Diff(ctx context.Context, req infer.DiffRequest[PackageImag
 	if req.Inputs.PackageName != req.State.PackageName {
 		diff["packageName"] = p.PropertyDiff{Kind: p.Update}
 	}
+	if req.Inputs.LocalPackage != req.State.LocalPackage {
+		diff["localPackage"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if req.Inputs.PackagePath != req.State.PackagePath {
+		diff["packagePath"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if req.Inputs.ContainerImage != req.State.ContainerImage {
+		diff["containerImage"] = p.PropertyDiff{Kind: p.Update}
+	} else if builder.containerDigest != req.State.ContainerDigest {
+		p.GetLogger(ctx).Infof("container image %s changed", req.Inputs.ContainerImage)
+		diff["containerImage"] = p.PropertyDiff{Kind: p.Update}
+	}
+	if req.State.ManifestDigest == "" {
+		p.GetLogger(ctx).Debugf("no manifest digest in state, skipping manifest comparison")
+	} else if builder.manifestDigest != req.State.ManifestDigest {
+		p.GetLogger(ctx).Infof("package manifest of %s changed", builder.packagePath)
+		if req.Inputs.PackagePath != "" {
+			diff["packagePath"] = p.PropertyDiff{Kind: p.Update}
+		} else if req.Inputs.ContainerImage != "" {
+			diff["containerImage"] = p.PropertyDiff{Kind: p.Update}
+		} else {
+			diff["packageName"] = p.PropertyDiff{Kind: p.Update}
+		}
+	}
+	if req.State.KernelVersion != "" && builder.config.NanosVersion != req.State.KernelVersion {
+		p.GetLogger(ctx).Infof("kernel version changes from %s to %s", req.State.KernelVersion, builder.config.NanosVersion)
+		diff["kernelVersion"] = p.PropertyDiff{Kind: p.Update}
+	}
 	patch, err := jsondiff.CompareJSON([]byte(req.State.Config), []byte(builder.configAsJson))
 	if err != nil {
 		return infer.DiffResponse{}, err
@@ -288,6 +430,39 @@ func (*PackageImage) Diff(ctx context.Context, req infer.DiffRequest[PackageImag
 func (PackageImage) Read(ctx context.Context, req infer.ReadRequest[PackageImageArgs, PackageImageState]) (infer.ReadResponse[PackageImageArgs, PackageImageState], error) {
 	resp := infer.ReadResponse[PackageImageArgs, PackageImageState](req)
 
+	if req.State.Config == "" {
+		// Only the ID is known when the image is imported, the package it was
+		// built from is not recorded in the image.
+		imported, err := importImage(ctx, req.ID)
+		if err != nil {
+			return resp, err
+		}
+		if imported == nil {
+			p.GetLogger(ctx).Errorf("image with import ID %v not found", req.ID)
+			resp.ID = ""
+			return resp, nil
+		}
+		p.GetLogger(ctx).Warningf("the package of image %s is unknown, set packageName, packagePath or containerImage in the imported code", imported.name)
+		return infer.ReadResponse[PackageImageArgs, PackageImageState]{
+			ID: imported.name,
+			Inputs: PackageImageArgs{
+				Name:          imported.name,
+				Provider:      imported.provider,
+				Architecture:  imported.architecture,
+				KernelVersion: imported.kernelVersion,
+				OpsConfig:     imported.opsConfig(),
+			},
+			State: PackageImageState{
+				ImagePath:     imported.name,
+				ImageName:     imported.name,
+				Config:        imported.config,
+				Provider:      imported.provider,
+				Architecture:  imported.architecture,
+				KernelVersion: imported.kernelVersion,
+			},
+		}, nil
+	}
+
 	var config types.Config
 
 	err := json.Unmarshal([]byte(req.State.Config), &config)
@@ -295,7 +470,9 @@ func (PackageImage) Read(ctx context.Context, req infer.ReadRequest[PackageImage
 		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
-	provider, err := provider.CloudProvider(req.State.Provider, &config.CloudConfig)
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
 	if err != nil {
 		return resp, fmt.Errorf("failed to create provider: %w", err)
 	}
@@ -329,13 +506,19 @@ func (PackageImage) Read(ctx context.Context, req infer.ReadRequest[PackageImage
 }
 
 func (*PackageImage) WireDependencies(f infer.FieldSelector, args *PackageImageArgs, state *PackageImageState) {
-	f.OutputField(&state.ImageName).DependsOn(f.InputField(&args.PackageName))
-	f.OutputField(&state.ImagePath).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.ImageName).DependsOn(f.InputField(&args.Name))
+	f.OutputField(&state.ImagePath).DependsOn(f.InputField(&args.Name), f.InputField(&args.PackageName), f.InputField(&args.LocalPackage), f.InputField(&args.PackagePath), f.InputField(&args.ContainerImage), f.InputField(&args.Provider), f.InputField(&args.Architecture), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.OpsHome))
 	f.OutputField(&state.PackageName).DependsOn(f.InputField(&args.PackageName))
-	f.OutputField(&state.Config).DependsOn(f.InputField(&args.Config))
+	f.OutputField(&state.LocalPackage).DependsOn(f.InputField(&args.LocalPackage))
+	f.OutputField(&state.PackagePath).DependsOn(f.InputField(&args.PackagePath))
+	f.OutputField(&state.ContainerImage).DependsOn(f.InputField(&args.ContainerImage))
+	f.OutputField(&state.ContainerDigest).DependsOn(f.InputField(&args.ContainerImage), f.InputField(&args.Architecture), f.InputField(&args.OpsHome))
+	f.OutputField(&state.ManifestDigest).DependsOn(f.InputField(&args.PackageName), f.InputField(&args.LocalPackage), f.InputField(&args.PackagePath), f.InputField(&args.ContainerImage), f.InputField(&args.Architecture), f.InputField(&args.OpsHome))
+	f.OutputField(&state.Config).DependsOn(f.InputField(&args.Name), f.InputField(&args.PackageName), f.InputField(&args.LocalPackage), f.InputField(&args.PackagePath), f.InputField(&args.ContainerImage), f.InputField(&args.Architecture), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.UseLatestKernel), f.InputField(&args.KernelVersion), f.InputField(&args.OpsHome))
 	f.OutputField(&state.Provider).DependsOn(f.InputField(&args.Provider))
 	f.OutputField(&state.Architecture).DependsOn(f.InputField(&args.Architecture))
 	f.OutputField(&state.UseLatestKernel).DependsOn(f.InputField(&args.UseLatestKernel))
+	f.OutputField(&state.KernelVersion).DependsOn(f.InputField(&args.UseLatestKernel), f.InputField(&args.KernelVersion), f.InputField(&args.Config), f.InputField(&args.OpsConfig), f.InputField(&args.OpsHome))
 }
 
 type packageBuilder struct {
@@ -344,60 +527,105 @@ type packageBuilder struct {
 	provider     lepton.Provider
 	packagePath  string
 	architecture string
+	release      func()
+
+	manifestDigest  string
+	containerDigest string
 }
 
-func createPackageBuilder(ctx context.Context, args PackageImageArgs, building bool) (*packageBuilder, error) {
+func createPackageBuilder(ctx context.Context, args PackageImageArgs, building bool) (b *packageBuilder, err error) {
 	config := lepton.NewConfig()
 
-	if args.Config == "" {
-		p.GetLogger(ctx).Warning("no config provided, using defaults")
-	} else {
-		err := json.Unmarshal([]byte(args.Config), config)
-		if err != nil {
-			return nil, fmt.Errorf("cannot unmarshal config: %w", err)
-		}
+	if err := mergeConfig(ctx, config, args.OpsConfig, args.Config); err != nil {
+		return nil, err
 	}
+	config.Home = args.OpsHome
 
 	// Set the architecture for package resolution
 	// This affects which package variant (amd64/arm64) gets downloaded
 	var targetArch string
 	if args.Architecture != "" {
 		targetArch = args.Architecture
-		lepton.AltGOARCH = args.Architecture
 		if building {
 			p.GetLogger(ctx).Infof("Using specified architecture: %s", args.Architecture)
 		}
+	} else if defaultArch := infer.GetConfig[Config](ctx).Architecture; defaultArch != "" {
+		targetArch = defaultArch
+		if building {
+			p.GetLogger(ctx).Infof("Using provider default architecture: %s", defaultArch)
+		}
 	} else {
 		targetArch = runtime.GOARCH
-		lepton.AltGOARCH = runtime.GOARCH
 		if building {
 			p.GetLogger(ctx).Infof("Using runtime architecture: %s", runtime.GOARCH)
 		}
 	}
+	// Keep the architecture until the image is built, the caller releases it.
+	release := altGOARCH.use(targetArch)
+	defer func() {
+		if err != nil {
+			release()
+		}
+	}()
 
 	// Set up package flags and use MergeToConfig to handle package setup
 	pkgFlags := &cmd.PkgCommandFlags{
 		Package:      args.PackageName,
-		LocalPackage: false, // Assume remote packages by default
+		LocalPackage: args.LocalPackage,
 	}
 
-	// MergeToConfig will:
-	// 1. Resolve the package path (local or downloaded)
-	// 2. Download the package if it doesn't exist
-	// 3. Read the package manifest and merge config (Program, Args, Files, Dirs, Env, etc.)
-	if building {
-		p.GetLogger(ctx).Infof("Setting up package: %s", args.PackageName)
-	}
-	err := pkgFlags.MergeToConfig(config)
-	if err != nil {
-		return nil, fmt.Errorf("failed to merge package config: %w", err)
-	}
+	var packagePath, containerDigest string
+	if args.PackagePath != "" {
+		if building {
+			p.GetLogger(ctx).Infof("Setting up package from: %s", args.PackagePath)
+		}
+		packagePath = args.PackagePath
+		err = mergePackageManifest(config, packagePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge package config: %w", err)
+		}
+	} else if args.ContainerImage != "" {
+		if building {
+			p.GetLogger(ctx).Infof("Setting up package from container image: %s", args.ContainerImage)
+		}
+		packagePath, containerDigest, err = containerPackage(ctx, args.ContainerImage, targetArch)
+		if err != nil {
+			return nil, err
+		}
+		err = mergePackageManifest(config, packagePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge package config: %w", err)
+		}
+	} else {
+		// MergeToConfig will:
+		// 1. Resolve the package path (local or downloaded)
+		// 2. Download the package if it doesn't exist
+		// 3. Read the package manifest and merge config (Program, Args, Files, Dirs, Env, etc.)
+		if building {
+			p.GetLogger(ctx).Infof("Setting up package: %s", args.PackageName)
+		}
+		pkgAsset, err := packageAsset(args.PackageName, pkgFlags.PackagePath())
+		if err != nil {
+			return nil, err
+		}
+		if err := prepareAssets(ctx, pkgAsset); err != nil {
+			return nil, err
+		}
+		err = pkgFlags.MergeToConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge package config: %w", err)
+		}
 
-	// Get the package path for BuildImageWithPackage
-	packagePath := pkgFlags.PackagePath()
+		// Get the package path for BuildImageWithPackage
+		packagePath = pkgFlags.PackagePath()
+	}
 	if building {
 		p.GetLogger(ctx).Infof("Package path: %s", packagePath)
 	}
+	manifestDigest, err := fileSha256(path.Join(packagePath, "package.manifest"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read package manifest: %w", err)
+	}
 
 	// Override image names if specified by user
 	if args.Name != "" {
@@ -405,22 +633,23 @@ func createPackageBuilder(ctx context.Context, args PackageImageArgs, building b
 		config.CloudConfig.ImageName = args.Name
 	}
 
-	version, err := getCurrentVersion(ctx, args.UseLatestKernel, pkgFlags.Parch())
+	version, err := resolveNanosVersion(ctx, args.UseLatestKernel, args.KernelVersion, targetArch)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get kernel version: %w", err)
 	}
 
+	releaseDir := releaseDirName(version, targetArch)
 	if config.Kernel == "" {
 		config.NanosVersion = version
-		config.Kernel = getKernelVersion(version)
+		config.Kernel = getKernelVersion(releaseDir)
 		if building {
 			p.GetLogger(ctx).Infof("Using kernel version %s", config.Kernel)
 		}
 		config.RunConfig.Kernel = config.Kernel
 	}
-	config.UefiBoot = lepton.GetUefiBoot(version)
+	config.UefiBoot = lepton.GetUefiBoot(releaseDir)
 	if config.Boot == "" {
-		bootPath := path.Join(lepton.GetOpsHome(), version, "boot.img")
+		bootPath := path.Join(lepton.GetOpsHome(), releaseDir, "boot.img")
 		if _, err := os.Stat(bootPath); err == nil {
 			config.Boot = bootPath
 		}
@@ -431,7 +660,7 @@ func createPackageBuilder(ctx context.Context, args PackageImageArgs, building b
 		config.NanosVersion = lepton.LocalReleaseVersion
 	}
 
-	provider, err := provider.CloudProvider(args.Provider, &config.CloudConfig)
+	provider, err := cloudProvider(args.Provider, &config.CloudConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cloud provider: %w", err)
 	}
@@ -446,5 +675,56 @@ func createPackageBuilder(ctx context.Context, args PackageImageArgs, building b
 		provider:     provider,
 		packagePath:  packagePath,
 		architecture: targetArch,
+		release:      release,
+
+		manifestDigest:  "sha256:" + manifestDigest,
+		containerDigest: containerDigest,
 	}, nil
 }
+
+// mergePackageManifest merges the package.manifest of the package in
+// packagePath into config, like MergeToConfig does for packages in the ops
+// home.
+func mergePackageManifest(config *types.Config, packagePath string) error {
+	data, err := os.ReadFile(path.Join(packagePath, "package.manifest"))
+	if err != nil {
+		return fmt.Errorf("failed finding package manifest: %w", err)
+	}
+	pkgConfig := &types.Config{}
+	if err := cmd.ConvertJSONToConfig(data, pkgConfig); err != nil {
+		return err
+	}
+
+	config.Program = pkgConfig.Program
+	config.Version = pkgConfig.Version
+	config.Language = pkgConfig.Language
+	config.Description = pkgConfig.Description
+
+	config.Args = append(pkgConfig.Args, config.Args...)
+	config.Dirs = append(pkgConfig.Dirs, config.Dirs...)
+	config.Files = append(pkgConfig.Files, config.Files...)
+
+	if config.MapDirs == nil {
+		config.MapDirs = make(map[string]string)
+	}
+	for k, v := range pkgConfig.MapDirs {
+		config.MapDirs[k] = v
+	}
+	if config.Env == nil {
+		config.Env = make(map[string]string)
+	}
+	for k, v := range pkgConfig.Env {
+		config.Env[k] = v
+	}
+
+	if config.BaseVolumeSz == "" {
+		config.BaseVolumeSz = pkgConfig.BaseVolumeSz
+	}
+	if len(config.NameServers) == 0 {
+		config.NameServers = pkgConfig.NameServers
+	}
+	if config.TargetRoot == "" {
+		config.TargetRoot = pkgConfig.TargetRoot
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+)
+
+// newTestServer returns a configured provider server.
+func newTestServer(t *testing.T, config p.ConfigureRequest) integration.Server {
+	t.Helper()
+	prov, err := newProvider()
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := integration.NewServer(t.Context(), "nanovms", semver.MustParse("0.1.0"), integration.WithProvider(prov))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := server.Configure(config); err != nil {
+		t.Fatal(err)
+	}
+	return server
+}
+
+// fakeCloud is a cloud provider keeping its instances in memory. Methods that
+// are not implemented panic.
+type fakeCloud struct {
+	lepton.Provider
+
+	mu        sync.Mutex
+	instances map[string]lepton.CloudInstance
+	// configs are the configurations the instances were created or last
+	// started with.
+	configs map[string]types.Config
+	// builds are the configurations the images were built with.
+	builds map[string]types.Config
+	// observed records the process global lepton state operations ran with.
+	observed []observation
+	// delay is how long building images and creating and listing instances
+	// takes.
+	delay time.Duration
+}
+
+// observation is the lepton state an operation of a fakeCloud ran with.
+type observation struct {
+	op, name  string
+	opsHome   string
+	altGOARCH string
+}
+
+// observe records the lepton state of operation op on name, the caller holds
+// c.mu.
+func (c *fakeCloud) observe(op string, name string) {
+	c.observed = append(c.observed, observation{
+		op:        op,
+		name:      name,
+		opsHome:   lepton.GetOpsHome(),
+		altGOARCH: lepton.AltGOARCH,
+	})
+}
+
+// useFakeCloud makes the resources use a fakeCloud for all providers until the
+// test ends.
+func useFakeCloud(t *testing.T) *fakeCloud {
+	cloud := &fakeCloud{
+		instances: map[string]lepton.CloudInstance{},
+		configs:   map[string]types.Config{},
+		builds:    map[string]types.Config{},
+	}
+	previous := cloudProvider
+	cloudProvider = func(string, *types.ProviderConfig) (lepton.Provider, error) {
+		return cloud, nil
+	}
+	t.Cleanup(func() { cloudProvider = previous })
+	return cloud
+}
+
+func (c *fakeCloud) BuildImage(ctx *lepton.Context) (string, error) {
+	time.Sleep(c.delay)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	config := ctx.Config()
+	c.builds[config.CloudConfig.ImageName] = *config
+	c.observe("buildImage", config.CloudConfig.ImageName)
+	return config.RunConfig.ImageName, nil
+}
+
+func (c *fakeCloud) BuildImageWithPackage(ctx *lepton.Context, _ string) (string, error) {
+	time.Sleep(c.delay)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	config := ctx.Config()
+	c.builds[config.CloudConfig.ImageName] = *config
+	c.observe("buildPackageImage", config.CloudConfig.ImageName)
+	return config.RunConfig.ImageName, nil
+}
+
+func (c *fakeCloud) CreateImage(*lepton.Context, string) error {
+	return nil
+}
+
+func (c *fakeCloud) CreateInstance(ctx *lepton.Context) error {
+	time.Sleep(c.delay)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	config := ctx.Config()
+	name := config.RunConfig.InstanceName
+	c.instances[name] = lepton.CloudInstance{
+		ID:     name,
+		Name:   name,
+		Status: "running",
+		Image:  config.CloudConfig.ImageName,
+	}
+	c.configs[name] = *config
+	c.observe("createInstance", name)
+	return nil
+}
+
+func (c *fakeCloud) GetImages(*lepton.Context, string) ([]lepton.CloudImage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var images []lepton.CloudImage
+	for _, name := range sortedKeys(c.builds) {
+		images = append(images, lepton.CloudImage{ID: name, Name: name})
+	}
+	return images, nil
+}
+
+func (c *fakeCloud) GetInstances(*lepton.Context) ([]lepton.CloudInstance, error) {
+	time.Sleep(c.delay)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.observe("getInstances", "")
+	var instances []lepton.CloudInstance
+	for _, instance := range c.instances {
+		instances = append(instances, instance)
+	}
+	return instances, nil
+}
+
+func (c *fakeCloud) GetInstanceByName(_ *lepton.Context, name string) (*lepton.CloudInstance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	instance, ok := c.instances[name]
+	if !ok {
+		return nil, lepton.ErrInstanceNotFound(name)
+	}
+	return &instance, nil
+}
+
+func (c *fakeCloud) DeleteInstance(_ *lepton.Context, name string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.instances[name]; !ok {
+		return lepton.ErrInstanceNotFound(name)
+	}
+	delete(c.instances, name)
+	delete(c.configs, name)
+	return nil
+}
+
+func (c *fakeCloud) StartInstance(ctx *lepton.Context, name string) error {
+	return c.setStatus(ctx, "startInstance", name, "running")
+}
+
+func (c *fakeCloud) StopInstance(ctx *lepton.Context, name string) error {
+	return c.setStatus(ctx, "stopInstance", name, "stopped")
+}
+
+func (c *fakeCloud) setStatus(ctx *lepton.Context, op string, name string, status string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	instance, ok := c.instances[name]
+	if !ok {
+		return lepton.ErrInstanceNotFound(name)
+	}
+	instance.Status = status
+	c.instances[name] = instance
+	if status == "running" {
+		c.configs[name] = *ctx.Config()
+	}
+	c.observe(op, name)
+	return nil
+}
+
+// newOpsHome returns a directory containing an ops home with the kernel and
+// boot images of nanos 0.1.54 for amd64 and arm64.
+func newOpsHome(t *testing.T) string {
+	t.Helper()
+	home := t.TempDir()
+	for _, release := range []string{"0.1.54", "0.1.54-arm"} {
+		dir := filepath.Join(home, ".ops", release)
+		if err := os.MkdirAll(filepath.Join(dir, "klibs"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range []string{"kernel.img", "boot.img"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte(release), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := os.WriteFile(filepath.Join(home, ".ops", "latest.txt"), []byte("0.1.54"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return home
+}
+
+// writeElf writes a statically linked 64-bit elf binary for machine, only
+// consisting of the elf header.
+func writeElf(t *testing.T, path string, machine elf.Machine) {
+	t.Helper()
+	header := elf.Header64{
+		Type:    uint16(elf.ET_EXEC),
+		Machine: uint16(machine),
+		Version: uint32(elf.EV_CURRENT),
+		Ehsize:  64,
+	}
+	copy(header.Ident[:], elf.ELFMAG)
+	header.Ident[elf.EI_CLASS] = byte(elf.ELFCLASS64)
+	header.Ident[elf.EI_DATA] = byte(elf.ELFDATA2LSB)
+	header.Ident[elf.EI_VERSION] = byte(elf.EV_CURRENT)
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, header); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0755); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/nanovms/ops/provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+	"github.com/tpjg/pulumi-nanovms/internal/opshomeseed"
+)
+
+// Config is the provider configuration, its values are defaults for all
+// resources and functions of the provider. Each resource's own settings take
+// precedence over these defaults.
+type Config struct {
+	DefaultProvider string          `pulumi:"defaultProvider,optional"`
+	CloudConfig     *OpsCloudConfig `pulumi:"cloudConfig,optional"`
+	KernelVersion   string          `pulumi:"kernelVersion,optional"`
+	Architecture    string          `pulumi:"architecture,optional"`
+	OpsHome         string          `pulumi:"opsHome,optional"`
+	Offline         bool            `pulumi:"offline,optional"`
+	MirrorDir       string          `pulumi:"mirrorDir,optional"`
+}
+
+var _ = (infer.CustomConfigure)((*Config)(nil))
+var _ = (infer.Annotated)((*Config)(nil))
+
+func (c *Config) Annotate(a infer.Annotator) {
+	a.Describe(&c.DefaultProvider, "The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)")
+	a.Describe(&c.CloudConfig, "The default cloud provider settings, merged under the configuration of each resource")
+	a.Describe(&c.KernelVersion, "The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary")
+	a.Describe(&c.Architecture, "The default target architecture of package images (amd64 or arm64)")
+	a.Describe(&c.OpsHome, "The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME")
+	a.Describe(&c.Offline, "If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory")
+	a.SetDefault(&c.Offline, false, opshomeseed.OfflineEnv)
+	a.Describe(&c.MirrorDir, "A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them")
+	a.SetDefault(&c.MirrorDir, "", opshomeseed.MirrorDirEnv)
+}
+
+func (c *Config) Configure(ctx context.Context) error {
+	if c.Architecture != "" && c.Architecture != "amd64" && c.Architecture != "arm64" {
+		return fmt.Errorf("architecture must be either 'amd64' or 'arm64'")
+	}
+	if c.MirrorDir != "" {
+		if info, err := os.Stat(c.MirrorDir); err != nil || !info.IsDir() {
+			return fmt.Errorf("mirrorDir %s is not a directory", c.MirrorDir)
+		}
+	}
+	if c.OpsHome != "" {
+		opsHomeEnv.setDefault(c.OpsHome)
+	}
+	return nil
+}
+
+// setDefaultProvider sets the provider input to the default provider of the
+// provider configuration if it is not specified.
+func setDefaultProvider(ctx context.Context, inputs property.Map) property.Map {
+	if _, ok := inputs.GetOk("provider"); ok {
+		return inputs
+	}
+	if defaultProvider := infer.GetConfig[Config](ctx).DefaultProvider; defaultProvider != "" {
+		return inputs.Set("provider", property.New(defaultProvider))
+	}
+	return inputs
+}
+
+// resolveProviderName returns name, or the default provider if name is empty.
+func resolveProviderName(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		name = infer.GetConfig[Config](ctx).DefaultProvider
+	}
+	if name == "" {
+		return "", fmt.Errorf("provider not specified and no defaultProvider configured")
+	}
+	return name, nil
+}
+
+// cloudProvider returns the ops provider with the given name, it is replaced
+// by a fake in tests.
+var cloudProvider = provider.CloudProvider
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nanovms/ops/lepton"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	defaultReadinessTimeout      = "5m"
+	defaultReadinessPollInterval = "2s"
+)
+
+type InstanceReadiness struct {
+	Port         *int   `pulumi:"port,optional"`
+	HTTPPath     string `pulumi:"httpPath,optional"`
+	Timeout      string `pulumi:"timeout,optional"`
+	PollInterval string `pulumi:"pollInterval,optional"`
+}
+
+func (r *InstanceReadiness) Annotate(a infer.Annotator) {
+	a.Describe(&r, "Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path")
+	a.Describe(&r.Port, "The TCP port that must accept connections before the instance is considered ready")
+	a.Describe(&r.HTTPPath, "The HTTP path (e.g. '/health') on port that must answer with a non-error status code")
+	a.Describe(&r.Timeout, "The maximum time to wait for the instance to become ready (e.g. '5m')")
+	a.SetDefault(&r.Timeout, defaultReadinessTimeout)
+	a.Describe(&r.PollInterval, "The time between readiness checks (e.g. '2s')")
+	a.SetDefault(&r.PollInterval, defaultReadinessPollInterval)
+}
+
+// durations returns the parsed timeout and poll interval, using the defaults
+// for values that are not set.
+func (r *InstanceReadiness) durations() (time.Duration, time.Duration, error) {
+	timeout, pollInterval := r.Timeout, r.PollInterval
+	if timeout == "" {
+		timeout = defaultReadinessTimeout
+	}
+	if pollInterval == "" {
+		pollInterval = defaultReadinessPollInterval
+	}
+	t, err := time.ParseDuration(timeout)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid timeout: %w", err)
+	}
+	i, err := time.ParseDuration(pollInterval)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid pollInterval: %w", err)
+	}
+	if t <= 0 || i <= 0 {
+		return 0, 0, fmt.Errorf("timeout and pollInterval must be positive")
+	}
+	return t, i, nil
+}
+
+// validate returns the check failures for the readiness settings.
+func (r *InstanceReadiness) validate() []p.CheckFailure {
+	var fails []p.CheckFailure
+	if _, _, err := r.durations(); err != nil {
+		fails = append(fails, p.CheckFailure{
+			Property: "readiness",
+			Reason:   err.Error(),
+		})
+	}
+	if r.Port != nil && (*r.Port < 1 || *r.Port > 65535) {
+		fails = append(fails, p.CheckFailure{
+			Property: "readiness.port",
+			Reason:   "port must be between 1 and 65535",
+		})
+	}
+	if r.HTTPPath != "" {
+		if r.Port == nil {
+			fails = append(fails, p.CheckFailure{
+				Property: "readiness.httpPath",
+				Reason:   "httpPath requires port to be set",
+			})
+		}
+		if !strings.HasPrefix(r.HTTPPath, "/") {
+			fails = append(fails, p.CheckFailure{
+				Property: "readiness.httpPath",
+				Reason:   "httpPath must start with '/'",
+			})
+		}
+	}
+	return fails
+}
+
+// waitForReady polls the instance until it is running and, if configured, the
+// port or HTTP path answers. It returns the last retrieved instance information.
+func waitForReady(ctx context.Context, provider lepton.Provider, opsContext *lepton.Context, name string, readiness *InstanceReadiness) (*lepton.CloudInstance, error) {
+	timeout, pollInterval, err := readiness.durations()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	p.GetLogger(ctx).Infof("waiting up to %v for instance %v to become ready", timeout, name)
+
+	var instance *lepton.CloudInstance
+	var lastErr error
+	for {
+		instance, lastErr = checkReady(ctx, provider, opsContext, name, readiness, pollInterval)
+		if lastErr == nil {
+			p.GetLogger(ctx).Infof("instance %v is ready", name)
+			return instance, nil
+		}
+		p.GetLogger(ctx).Debugf("instance %v not ready: %v", name, lastErr)
+
+		select {
+		case <-ctx.Done():
+			return instance, fmt.Errorf("instance %v not ready after %v: %w", name, timeout, lastErr)
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func checkReady(ctx context.Context, provider lepton.Provider, opsContext *lepton.Context, name string, readiness *InstanceReadiness, pollInterval time.Duration) (*lepton.CloudInstance, error) {
+	// The ops home is only used while polling, so a slow instance does not
+	// hold up operations on other ops homes.
+	release := useOpsHome(opsContext.Config().Home)
+	instance, err := provider.GetInstanceByName(opsContext, name)
+	release()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get instance information: %w", err)
+	}
+	if strings.ToUpper(instance.Status) != "RUNNING" {
+		return instance, fmt.Errorf("status is %v", instance.Status)
+	}
+	if readiness.Port == nil {
+		return instance, nil
+	}
+
+	address := net.JoinHostPort(instanceHost(instance), strconv.Itoa(*readiness.Port))
+	if readiness.HTTPPath == "" {
+		dialer := net.Dialer{Timeout: pollInterval}
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return instance, err
+		}
+		conn.Close()
+		return instance, nil
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+address+readiness.HTTPPath, nil)
+	if err != nil {
+		return instance, err
+	}
+	client := http.Client{Timeout: pollInterval}
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return instance, err
+	}
+	httpResp.Body.Close()
+	if httpResp.StatusCode >= 400 {
+		return instance, fmt.Errorf("%s answered with status %v", httpReq.URL, httpResp.Status)
+	}
+	return instance, nil
+}
+
+// instanceHost returns the address to reach the instance at, onprem instances
+// without a bridge are only reachable through the ports forwarded to localhost.
+func instanceHost(instance *lepton.CloudInstance) string {
+	if len(instance.PublicIps) > 0 && instance.PublicIps[0] != "" {
+		return instance.PublicIps[0]
+	}
+	if len(instance.PrivateIps) > 0 && instance.PrivateIps[0] != "" {
+		return instance.PrivateIps[0]
+	}
+	return "127.0.0.1"
+}
@@ -11,7 +11,7 @@ import (
 
 	"github.com/nanovms/ops/lepton"
 	p "github.com/pulumi/pulumi-go-provider"
-	"github.com/ttacon/chalk"
+	"github.com/pulumi/pulumi-go-provider/infer"
 )
 
 // Utility functions copied from nanovms' ops sources
@@ -21,58 +21,97 @@ func getCurrentVersion(ctx context.Context, useLatestKernel bool, arch string) (
 
 	local, remote := lepton.LocalReleaseVersion, lepton.LatestReleaseVersion
 	if local == "0.0" || (useLatestKernel && remote != local) {
-		if runtime.GOARCH != arch {
+		if altGOARCHFor(arch) != "" {
 			p.GetLogger(ctx).Warningf("Detected %s architecture in Elf binary, but running on %s, downloading kernel for %s", arch, runtime.GOARCH, arch)
 		}
-		err = lepton.DownloadReleaseImages(remote, arch)
+		err = downloadNanosKernel(remote, arch)
 		if err != nil {
 			return "", err
 		}
 		return remote, nil
 	}
 
-	if parseVersion(local, 4) != parseVersion(remote, 4) {
-		fmt.Println(chalk.Red, "You are running an older version of Ops.", chalk.Reset)
-		fmt.Println(chalk.Red, "Update: Run", chalk.Reset, chalk.Bold.TextStyle("`ops update`"))
+	localVersion, err := parseVersion(local, 4)
+	if err != nil {
+		return "", err
+	}
+	remoteVersion, err := parseVersion(remote, 4)
+	if err != nil {
+		return "", err
+	}
+	if localVersion != remoteVersion {
+		p.GetLogger(ctx).Warningf("Using nanos %s while %s is available, set useLatestKernel or kernelVersion to upgrade", local, remote)
 	}
 
 	return local, nil
 }
 
-func parseVersion(s string, width int) int64 {
+// resolveNanosVersion returns the nanos version to build with: the latest if
+// useLatestKernel is set, otherwise kernelVersion or the kernelVersion of the
+// provider configuration (downloaded if necessary) or the current local version.
+// In offline mode the latest version is the current local version.
+func resolveNanosVersion(ctx context.Context, useLatestKernel bool, kernelVersion string, arch string) (string, error) {
+	config := infer.GetConfig[Config](ctx)
+	version := kernelVersion
+	if version == "" {
+		version = config.KernelVersion
+	}
+	if useLatestKernel || version == "" {
+		if !config.Offline {
+			// The local version may only have been downloaded for another
+			// architecture, it is downloaded for arch below.
+			var err error
+			version, err = getCurrentVersion(ctx, useLatestKernel, arch)
+			if err != nil {
+				return "", err
+			}
+		} else if lepton.LocalReleaseVersion == "0.0" {
+			return "", fmt.Errorf("offline mode, no nanos release in %s, set kernelVersion", lepton.GetOpsHome())
+		} else {
+			if useLatestKernel {
+				p.GetLogger(ctx).Warningf("Offline mode, using the local nanos %s instead of the latest", lepton.LocalReleaseVersion)
+			}
+			version = lepton.LocalReleaseVersion
+		}
+	}
+
+	if err := prepareAssets(ctx, releaseAsset(version, arch), commonAsset); err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(getKernelVersion(releaseDirName(version, arch))); os.IsNotExist(err) {
+		p.GetLogger(ctx).Infof("Downloading nanos kernel version %s for %s", version, arch)
+		if err := downloadNanosKernel(version, arch); err != nil {
+			return "", err
+		}
+	}
+	return version, nil
+}
+
+// parseVersion turns a version like 0.1.54 into a number that can be compared,
+// with width digits per part.
+func parseVersion(s string, width int) (int64, error) {
 	strList := strings.Split(s, ".")
 	format := fmt.Sprintf("%%s%%0%ds", width)
 	v := ""
 	for _, value := range strList {
 		v = fmt.Sprintf(format, v, value)
 	}
-	var result int64
-	var err error
-	if result, err = strconv.ParseInt(v, 10, 64); err != nil {
-		fmt.Printf("Failed to parse version %s, error is: %s", v, err)
-		os.Exit(1)
-	}
-	return result
-}
-
-func getKernelVersion(version string) string {
-	return path.Join(lepton.GetOpsHome(), version, "kernel.img")
-}
-
-// b7 = 183 = arm; 3e = 62 = x86
-func archCheck(imgpath string) string {
-	f, err := os.Open(imgpath)
+	result, err := strconv.ParseInt(v, 10, 64)
 	if err != nil {
-		fmt.Println(err)
+		return 0, fmt.Errorf("failed to parse version %s: %w", s, err)
 	}
-	defer f.Close()
-
-	h := make([]byte, 19)
-	_, err = f.Read(h)
+	return result, nil
+}
 
-	if h[18] == 183 {
-		return "arm"
+// releaseDirName returns the name of the directory in the ops home a nanos
+// release for arch is downloaded to.
+func releaseDirName(version string, arch string) string {
+	if strings.Contains(arch, "arm") {
+		return version + "-arm"
 	}
+	return version
+}
 
-	return "amd64"
+func getKernelVersion(version string) string {
+	return path.Join(lepton.GetOpsHome(), version, "kernel.img")
 }
@@ -0,0 +1,408 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+type Volume struct{}
+
+var _ = (infer.CustomCreate[VolumeArgs, VolumeState])((*Volume)(nil))
+var _ = (infer.CustomDelete[VolumeState])((*Volume)(nil))
+var _ = (infer.CustomCheck[VolumeArgs])((*Volume)(nil))
+var _ = (infer.CustomUpdate[VolumeArgs, VolumeState])((*Volume)(nil))
+var _ = (infer.CustomDiff[VolumeArgs, VolumeState])((*Volume)(nil))
+var _ = (infer.CustomRead[VolumeArgs, VolumeState])((*Volume)(nil))
+var _ = (infer.Annotated)((*Volume)(nil))
+var _ = (infer.Annotated)((*VolumeArgs)(nil))
+var _ = (infer.Annotated)((*VolumeState)(nil))
+
+func (v *Volume) Annotate(a infer.Annotator) {
+	a.Describe(&v, "A NanoVMs volume resource for persistent data disks")
+}
+
+type VolumeArgs struct {
+	Name       string     `pulumi:"name"`
+	Provider   string     `pulumi:"provider,optional"`
+	Data       string     `pulumi:"data,optional"`
+	Size       string     `pulumi:"size,optional"`
+	Typeof     string     `pulumi:"typeof,optional"`
+	Iops       int64      `pulumi:"iops,optional"`
+	Throughput int64      `pulumi:"throughput,optional"`
+	OpsConfig  *OpsConfig `pulumi:"opsConfig,optional"`
+	OpsHome    string     `pulumi:"opsHome,optional"`
+}
+
+func (v *VolumeArgs) Annotate(a infer.Annotator) {
+	a.Describe(&v.Name, "The name (label) of the volume")
+	a.Describe(&v.Provider, "The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)")
+	a.Describe(&v.Data, "The path to a local directory to fill the volume with, an empty volume is created if not set")
+	a.Describe(&v.Size, "The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it")
+	a.Describe(&v.Typeof, "The provider specific volume type")
+	a.Describe(&v.Iops, "The provisioned IOPS for the volume")
+	a.Describe(&v.Throughput, "The provisioned throughput for the volume")
+	a.Describe(&v.OpsConfig, "The configuration, used for the cloud provider settings such as zone and bucket")
+	a.Describe(&v.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
+}
+
+type VolumeState struct {
+	VolumeID   string `pulumi:"volumeID"`
+	Name       string `pulumi:"name"`
+	Provider   string `pulumi:"provider"`
+	Data       string `pulumi:"data"`
+	Size       string `pulumi:"size"`
+	Typeof     string `pulumi:"typeof"`
+	Iops       int64  `pulumi:"iops"`
+	Throughput int64  `pulumi:"throughput"`
+	Path       string `pulumi:"path"`
+	Status     string `pulumi:"status"`
+	AttachedTo string `pulumi:"attachedTo"`
+	Config     string `pulumi:"config"`
+}
+
+func (v *VolumeState) Annotate(a infer.Annotator) {
+	a.Describe(&v.VolumeID, "The provider ID of the volume (the UUID for onprem volumes)")
+	a.Describe(&v.Name, "The name (label) of the volume")
+	a.Describe(&v.Provider, "The cloud provider of the volume")
+	a.Describe(&v.Data, "The path to the local directory the volume was filled with")
+	a.Describe(&v.Size, "The size of the volume")
+	a.Describe(&v.Typeof, "The provider specific volume type")
+	a.Describe(&v.Iops, "The provisioned IOPS for the volume")
+	a.Describe(&v.Throughput, "The provisioned throughput for the volume")
+	a.Describe(&v.Path, "The local path of the volume (onprem)")
+	a.Describe(&v.Status, "The status of the volume")
+	a.Describe(&v.AttachedTo, "The instance the volume is attached to")
+	a.Describe(&v.Config, "The configuration used to create the volume as a JSON encoded string")
+}
+
+func (*Volume) Create(ctx context.Context, req infer.CreateRequest[VolumeArgs]) (infer.CreateResponse[VolumeState], error) {
+	var resp infer.CreateResponse[VolumeState]
+
+	if req.Inputs.Data != "" {
+		if _, err := os.Stat(req.Inputs.Data); os.IsNotExist(err) {
+			return resp, fmt.Errorf("data directory with path %s not found", req.Inputs.Data)
+		}
+	}
+
+	config, configAsJson, err := volumeConfig(ctx, req.Inputs)
+	if err != nil {
+		return resp, err
+	}
+
+	defer useOpsHome(config.Home)()
+
+	resp.ID = req.Inputs.Name
+	resp.Output = VolumeState{
+		Name:       req.Inputs.Name,
+		Provider:   req.Inputs.Provider,
+		Data:       req.Inputs.Data,
+		Size:       req.Inputs.Size,
+		Typeof:     req.Inputs.Typeof,
+		Iops:       req.Inputs.Iops,
+		Throughput: req.Inputs.Throughput,
+		Config:     configAsJson,
+	}
+
+	if req.DryRun { // Don't do the actual creating if in preview
+		return resp, nil
+	}
+
+	provider, err := cloudProvider(req.Inputs.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	p.GetLogger(ctx).Infof("creating volume %s on provider %s", req.Inputs.Name, req.Inputs.Provider)
+
+	opsContext := lepton.NewContext(config)
+	cv := types.CloudVolume{
+		Name:       req.Inputs.Name,
+		Typeof:     req.Inputs.Typeof,
+		Iops:       req.Inputs.Iops,
+		Throughput: req.Inputs.Throughput,
+	}
+	volume, err := provider.CreateVolume(opsContext, cv, req.Inputs.Data, req.Inputs.Provider)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create volume: %w", err)
+	}
+	p.GetLogger(ctx).Infof("created volume %s with ID %s", volume.Name, volume.ID)
+
+	resp.Output.VolumeID = volume.ID
+	resp.Output.Path = volume.Path
+	resp.Output.Status = volume.Status
+
+	return resp, nil
+}
+
+func (*Volume) Delete(ctx context.Context, req infer.DeleteRequest[VolumeState]) (infer.DeleteResponse, error) {
+	var resp infer.DeleteResponse
+
+	var config types.Config
+	if err := json.Unmarshal([]byte(req.State.Config), &config); err != nil {
+		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	p.GetLogger(ctx).Infof("deleting volume %v on provider %v", req.State.Name, req.State.Provider)
+
+	opsContext := lepton.NewContext(&config)
+	err = provider.DeleteVolume(opsContext, volumeRef(req.State))
+	if err != nil {
+		p.GetLogger(ctx).Warningf("failed to delete volume: %v", err)
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (*Volume) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[VolumeArgs], error) {
+	if _, ok := req.NewInputs.GetOk("name"); !ok {
+		req.NewInputs = req.NewInputs.Set("name", property.New(req.Name))
+	}
+	req.NewInputs = setDefaultProvider(ctx, req.NewInputs)
+	args, fails, err := infer.DefaultCheck[VolumeArgs](ctx, req.NewInputs)
+
+	provider, ok := req.NewInputs.GetOk("provider")
+	if !ok {
+		fails = append(fails, p.CheckFailure{
+			Property: "provider",
+			Reason:   "provider not specified",
+		})
+	} else if !provider.IsString() || provider.AsString() == "" {
+		fails = append(fails, p.CheckFailure{
+			Property: "provider",
+			Reason:   "provider must be a non-empty string",
+		})
+	}
+
+	name, ok := req.NewInputs.GetOk("name")
+	if ok && name.IsString() && strings.Contains(name.AsString(), lepton.VolumeDelimiter) {
+		fails = append(fails, p.CheckFailure{
+			Property: "name",
+			Reason:   fmt.Sprintf("name cannot contain '%s'", lepton.VolumeDelimiter),
+		})
+	}
+
+	size, ok := req.NewInputs.GetOk("size")
+	if ok && size.IsString() && size.AsString() != "" {
+		if _, err := volumeSizeInBytes(size.AsString()); err != nil {
+			fails = append(fails, p.CheckFailure{
+				Property: "size",
+				Reason:   fmt.Sprintf("invalid size: %v", err),
+			})
+		}
+	}
+
+	return infer.CheckResponse[VolumeArgs]{
+		Inputs:   args,
+		Failures: fails,
+	}, err
+}
+
+func (*Volume) Update(ctx context.Context, req infer.UpdateRequest[VolumeArgs, VolumeState]) (infer.UpdateResponse[VolumeState], error) {
+	resp := infer.UpdateResponse[VolumeState]{Output: req.State}
+
+	// Only onprem volumes can be resized in place (see Diff), all other changes
+	// result in a replacement.
+	if req.Inputs.Size == "" || req.Inputs.Size == req.State.Size {
+		return resp, nil
+	}
+	newSize, err := volumeSizeInBytes(req.Inputs.Size)
+	if err != nil {
+		return resp, err
+	}
+	_, configAsJson, err := volumeConfig(ctx, req.Inputs)
+	if err != nil {
+		return resp, err
+	}
+	resp.Output.Size = req.Inputs.Size
+	resp.Output.Config = configAsJson
+	if req.DryRun {
+		return resp, nil
+	}
+
+	// Like 'ops image resize' this only grows the disk, the filesystem on it
+	// keeps its size.
+	p.GetLogger(ctx).Infof("resizing volume %s to %s", req.State.Name, req.Inputs.Size)
+	if err := os.Truncate(req.State.Path, newSize); err != nil {
+		return resp, fmt.Errorf("failed to resize volume: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (*Volume) Diff(ctx context.Context, req infer.DiffRequest[VolumeArgs, VolumeState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.Inputs.Name != req.State.Name {
+		diff["name"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Provider != req.State.Provider {
+		diff["provider"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Data != req.State.Data {
+		diff["data"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Typeof != req.State.Typeof {
+		diff["typeof"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Iops != req.State.Iops {
+		diff["iops"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Throughput != req.State.Throughput {
+		diff["throughput"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Size != req.State.Size && req.Inputs.Size != "" {
+		diff["size"] = p.PropertyDiff{Kind: volumeResizeKind(req.State, req.Inputs.Size)}
+	}
+
+	// The size is part of the configuration, its changes are handled above.
+	sameSize := req.Inputs
+	sameSize.Size = req.State.Size
+	_, configAsJson, err := volumeConfig(ctx, sameSize)
+	if err != nil {
+		return infer.DiffResponse{}, err
+	}
+	if configAsJson != req.State.Config {
+		p.GetLogger(ctx).Infof("volume config changed: %s", configAsJson)
+		diff["opsConfig"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+
+	// Volume names have to be unique for attaching, so delete the old volume first.
+	return infer.DiffResponse{
+		DeleteBeforeReplace: true,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+func (Volume) Read(ctx context.Context, req infer.ReadRequest[VolumeArgs, VolumeState]) (infer.ReadResponse[VolumeArgs, VolumeState], error) {
+	resp := infer.ReadResponse[VolumeArgs, VolumeState](req)
+
+	var config types.Config
+	if err := json.Unmarshal([]byte(req.State.Config), &config); err != nil {
+		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if config.VolumesDir == "" {
+		config.VolumesDir = localVolumeDir(config.Home)
+	}
+
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	opsContext := lepton.NewContext(&config)
+	volumes, err := provider.GetAllVolumes(opsContext)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list volumes: %w", err)
+	}
+
+	ref := volumeRef(req.State)
+	for _, volume := range *volumes {
+		p.GetLogger(ctx).Debugf("volume: %v ; %v ; %v ; %v", volume.ID, volume.Name, volume.Path, volume)
+		if volume.ID == ref || volume.Name == ref {
+			p.GetLogger(ctx).Debugf("volume %v found", volume.Name)
+			resp.State.Status = volume.Status
+			resp.State.AttachedTo = volume.AttachedTo
+			if volume.Path != "" {
+				resp.State.Path = volume.Path
+			}
+			return resp, nil
+		}
+	}
+
+	p.GetLogger(ctx).Errorf("volume with name %v not found", req.State.Name)
+	resp.ID = ""
+	resp.State.Name = ""
+	return resp, nil
+}
+
+// volumeConfig creates the ops configuration for a volume and returns it
+// together with its JSON encoded form.
+func volumeConfig(ctx context.Context, args VolumeArgs) (*types.Config, string, error) {
+	config := &types.Config{}
+	if err := mergeConfig(ctx, config, args.OpsConfig, ""); err != nil {
+		return nil, "", err
+	}
+	if args.Size != "" {
+		config.BaseVolumeSz = args.Size
+	}
+	if args.OpsHome != "" {
+		config.Home = args.OpsHome
+	}
+	if config.VolumesDir == "" {
+		config.VolumesDir = localVolumeDir(config.Home)
+	}
+
+	resultingConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return config, string(resultingConfig), nil
+}
+
+// volumeRef returns the reference ops uses to look up the volume, onprem volumes
+// are looked up by UUID as labels are not guaranteed to be unique.
+func volumeRef(state VolumeState) string {
+	if state.Provider == "onprem" && state.VolumeID != "" {
+		return state.VolumeID
+	}
+	return state.Name
+}
+
+// volumeResizeKind returns if a volume can be resized in place, which is only
+// possible for growing onprem volumes.
+func volumeResizeKind(state VolumeState, size string) p.DiffKind {
+	if state.Provider != "onprem" || state.Path == "" {
+		return p.UpdateReplace
+	}
+	newSize, err := volumeSizeInBytes(size)
+	if err != nil {
+		return p.UpdateReplace
+	}
+	info, err := os.Stat(state.Path)
+	if err != nil || newSize < info.Size() {
+		return p.UpdateReplace
+	}
+	return p.Update
+}
+
+// volumeSizeInBytes parses sizes as used by ops, e.g. '512k', '100m' or '1g'.
+func volumeSizeInBytes(size string) (int64, error) {
+	s := strings.ToLower(strings.TrimSpace(size))
+	mul := int64(1)
+	switch {
+	case strings.HasSuffix(s, "k"):
+		mul = 1024
+	case strings.HasSuffix(s, "m"):
+		mul = 1024 * 1024
+	case strings.HasSuffix(s, "g"):
+		mul = 1024 * 1024 * 1024
+	}
+	if mul > 1 {
+		s = s[:len(s)-1]
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("size %q must be a positive number, optionally followed by 'k', 'm' or 'g'", size)
+	}
+	return n * mul, nil
+}
@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/nanovms/ops/lepton"
+	"github.com/nanovms/ops/types"
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+type VolumeAttachment struct{}
+
+var _ = (infer.CustomCreate[VolumeAttachmentArgs, VolumeAttachmentState])((*VolumeAttachment)(nil))
+var _ = (infer.CustomDelete[VolumeAttachmentState])((*VolumeAttachment)(nil))
+var _ = (infer.CustomCheck[VolumeAttachmentArgs])((*VolumeAttachment)(nil))
+var _ = (infer.CustomDiff[VolumeAttachmentArgs, VolumeAttachmentState])((*VolumeAttachment)(nil))
+var _ = (infer.CustomRead[VolumeAttachmentArgs, VolumeAttachmentState])((*VolumeAttachment)(nil))
+var _ = (infer.Annotated)((*VolumeAttachment)(nil))
+var _ = (infer.Annotated)((*VolumeAttachmentArgs)(nil))
+var _ = (infer.Annotated)((*VolumeAttachmentState)(nil))
+
+func (v *VolumeAttachment) Annotate(a infer.Annotator) {
+	a.Describe(&v, "Attaches a NanoVMs volume to a running instance")
+}
+
+type VolumeAttachmentArgs struct {
+	Instance  string     `pulumi:"instance"`
+	Volume    string     `pulumi:"volume"`
+	MountPath string     `pulumi:"mountPath"`
+	Provider  string     `pulumi:"provider,optional"`
+	AttachID  *int       `pulumi:"attachID,optional"`
+	OpsConfig *OpsConfig `pulumi:"opsConfig,optional"`
+	Config    string     `pulumi:"config,optional"`
+	OpsHome   string     `pulumi:"opsHome,optional"`
+}
+
+func (v *VolumeAttachmentArgs) Annotate(a infer.Annotator) {
+	a.Describe(&v.Instance, "The ID (name) of the instance to attach the volume to")
+	a.Describe(&v.Volume, "The name of the volume to attach")
+	a.Describe(&v.MountPath, "The path the volume is mounted at in the instance, must match the mounts of the image")
+	a.Describe(&v.Provider, "The cloud provider of the instance and volume")
+	a.Describe(&v.AttachID, "The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')")
+	a.Describe(&v.OpsConfig, "The configuration, used for the cloud provider settings such as zone")
+	a.Describe(&v.Config, "The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath")
+	a.Describe(&v.OpsHome, "The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME")
+}
+
+type VolumeAttachmentState struct {
+	Instance  string `pulumi:"instance"`
+	Volume    string `pulumi:"volume"`
+	MountPath string `pulumi:"mountPath"`
+	Provider  string `pulumi:"provider"`
+	AttachID  *int   `pulumi:"attachID,optional"`
+	Config    string `pulumi:"config"`
+}
+
+func (v *VolumeAttachmentState) Annotate(a infer.Annotator) {
+	a.Describe(&v.Instance, "The ID (name) of the instance the volume is attached to")
+	a.Describe(&v.Volume, "The name of the attached volume")
+	a.Describe(&v.MountPath, "The path the volume is mounted at in the instance")
+	a.Describe(&v.Provider, "The cloud provider of the instance and volume")
+	a.Describe(&v.AttachID, "The persistent disk ID the volume is attached as")
+	a.Describe(&v.Config, "The configuration used to attach the volume as a JSON encoded string")
+}
+
+func (*VolumeAttachment) Create(ctx context.Context, req infer.CreateRequest[VolumeAttachmentArgs]) (infer.CreateResponse[VolumeAttachmentState], error) {
+	var resp infer.CreateResponse[VolumeAttachmentState]
+
+	config, configAsJson, err := volumeAttachmentConfig(ctx, req.Inputs)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.ID = fmt.Sprintf("%s/%s", req.Inputs.Instance, req.Inputs.Volume)
+	resp.Output = VolumeAttachmentState{
+		Instance:  req.Inputs.Instance,
+		Volume:    req.Inputs.Volume,
+		MountPath: req.Inputs.MountPath,
+		Provider:  req.Inputs.Provider,
+		AttachID:  req.Inputs.AttachID,
+		Config:    configAsJson,
+	}
+
+	if req.DryRun { // Don't do the actual attaching if in preview
+		return resp, nil
+	}
+
+	defer useOpsHome(req.Inputs.OpsHome)()
+
+	provider, err := cloudProvider(req.Inputs.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	attachID := -1
+	if req.Inputs.AttachID != nil {
+		attachID = *req.Inputs.AttachID
+	}
+
+	p.GetLogger(ctx).Infof("attaching volume %s to instance %s at %s", req.Inputs.Volume, req.Inputs.Instance, req.Inputs.MountPath)
+
+	opsContext := lepton.NewContext(config)
+	err = provider.AttachVolume(opsContext, req.Inputs.Instance, req.Inputs.Volume, attachID)
+	if err != nil {
+		return resp, fmt.Errorf("failed to attach volume: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (*VolumeAttachment) Delete(ctx context.Context, req infer.DeleteRequest[VolumeAttachmentState]) (infer.DeleteResponse, error) {
+	var resp infer.DeleteResponse
+
+	var config types.Config
+	if err := json.Unmarshal([]byte(req.State.Config), &config); err != nil {
+		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	p.GetLogger(ctx).Infof("detaching volume %s from instance %s", req.State.Volume, req.State.Instance)
+
+	opsContext := lepton.NewContext(&config)
+	err = provider.DetachVolume(opsContext, req.State.Instance, req.State.Volume)
+	if err != nil {
+		if lepton.IsInstanceNotFoundError(err) {
+			p.GetLogger(ctx).Infof("instance %v not found - volume no longer attached", req.State.Instance)
+		} else {
+			return resp, fmt.Errorf("failed to detach volume: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (*VolumeAttachment) Check(ctx context.Context, req infer.CheckRequest) (infer.CheckResponse[VolumeAttachmentArgs], error) {
+	req.NewInputs = setDefaultProvider(ctx, req.NewInputs)
+	args, fails, err := infer.DefaultCheck[VolumeAttachmentArgs](ctx, req.NewInputs)
+
+	if _, ok := req.NewInputs.GetOk("provider"); !ok {
+		fails = append(fails, p.CheckFailure{
+			Property: "provider",
+			Reason:   "provider not specified",
+		})
+	}
+
+	for _, name := range []string{"instance", "volume", "provider"} {
+		value, ok := req.NewInputs.GetOk(name)
+		if ok && value.IsString() && value.AsString() == "" {
+			fails = append(fails, p.CheckFailure{
+				Property: name,
+				Reason:   fmt.Sprintf("%s must be a non-empty string", name),
+			})
+		}
+	}
+
+	mountPath, ok := req.NewInputs.GetOk("mountPath")
+	if ok && mountPath.IsString() {
+		if !strings.HasPrefix(mountPath.AsString(), "/") {
+			fails = append(fails, p.CheckFailure{
+				Property: "mountPath",
+				Reason:   "mountPath must be an absolute path",
+			})
+		} else if config, _, err := volumeAttachmentConfig(ctx, args); err == nil {
+			if reason := checkMountPath(config.Mounts, args.Volume, args.MountPath); reason != "" {
+				fails = append(fails, p.CheckFailure{
+					Property: "mountPath",
+					Reason:   reason,
+				})
+			}
+		}
+	}
+
+	return infer.CheckResponse[VolumeAttachmentArgs]{
+		Inputs:   args,
+		Failures: fails,
+	}, err
+}
+
+func (*VolumeAttachment) Diff(ctx context.Context, req infer.DiffRequest[VolumeAttachmentArgs, VolumeAttachmentState]) (infer.DiffResponse, error) {
+	diff := map[string]p.PropertyDiff{}
+	if req.Inputs.Instance != req.State.Instance {
+		diff["instance"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Volume != req.State.Volume {
+		diff["volume"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.MountPath != req.State.MountPath {
+		diff["mountPath"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if req.Inputs.Provider != req.State.Provider {
+		diff["provider"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+	if !equalIntPtr(req.Inputs.AttachID, req.State.AttachID) {
+		diff["attachID"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+
+	_, configAsJson, err := volumeAttachmentConfig(ctx, req.Inputs)
+	if err != nil {
+		return infer.DiffResponse{}, err
+	}
+	if configAsJson != req.State.Config {
+		diff["opsConfig"] = p.PropertyDiff{Kind: p.UpdateReplace}
+	}
+
+	// A volume can only be attached once, so detach before attaching again.
+	return infer.DiffResponse{
+		DeleteBeforeReplace: true,
+		HasChanges:          len(diff) > 0,
+		DetailedDiff:        diff,
+	}, nil
+}
+
+func (VolumeAttachment) Read(ctx context.Context, req infer.ReadRequest[VolumeAttachmentArgs, VolumeAttachmentState]) (infer.ReadResponse[VolumeAttachmentArgs, VolumeAttachmentState], error) {
+	resp := infer.ReadResponse[VolumeAttachmentArgs, VolumeAttachmentState](req)
+
+	var config types.Config
+	if err := json.Unmarshal([]byte(req.State.Config), &config); err != nil {
+		return resp, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	defer useOpsHome(config.Home)()
+
+	provider, err := cloudProvider(req.State.Provider, &config.CloudConfig)
+	if err != nil {
+		return resp, fmt.Errorf("failed to create provider: %w", err)
+	}
+
+	opsContext := lepton.NewContext(&config)
+
+	if _, err := provider.GetInstanceByName(opsContext, req.State.Instance); err != nil {
+		if lepton.IsInstanceNotFoundError(err) {
+			p.GetLogger(ctx).Infof("instance %v not found - volume no longer attached", req.State.Instance)
+			resp.ID = ""
+			return resp, nil
+		}
+		return resp, fmt.Errorf("failed to get instance information: %w", err)
+	}
+
+	volumes, err := provider.GetAllVolumes(opsContext)
+	if err != nil {
+		return resp, fmt.Errorf("failed to list volumes: %w", err)
+	}
+	for _, volume := range *volumes {
+		if volume.Name != req.State.Volume {
+			continue
+		}
+		// Not all providers report where a volume is attached, e.g. onprem.
+		if volume.AttachedTo != "" && volume.AttachedTo != req.State.Instance {
+			p.GetLogger(ctx).Infof("volume %v is attached to %v instead of %v", volume.Name, volume.AttachedTo, req.State.Instance)
+			resp.ID = ""
+		}
+		return resp, nil
+	}
+
+	p.GetLogger(ctx).Infof("volume %v not found", req.State.Volume)
+	resp.ID = ""
+	return resp, nil
+}
+
+// volumeAttachmentConfig creates the ops configuration for attaching a volume
+// and returns it together with its JSON encoded form.
+func volumeAttachmentConfig(ctx context.Context, args VolumeAttachmentArgs) (*types.Config, string, error) {
+	config := &types.Config{}
+	if err := mergeConfig(ctx, config, args.OpsConfig, args.Config); err != nil {
+		return nil, "", err
+	}
+	config.Home = args.OpsHome
+	if config.VolumesDir == "" {
+		config.VolumesDir = localVolumeDir(args.OpsHome)
+	}
+
+	resultingConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+	return config, string(resultingConfig), nil
+}
+
+// checkMountPath returns why volume can't be mounted at mountPath by an image
+// with mounts, or "" if it can. Without mounts, e.g. because the config of the
+// image is not known yet, any path is accepted.
+func checkMountPath(mounts map[string]string, volume string, mountPath string) string {
+	if len(mounts) == 0 {
+		return ""
+	}
+	if path, ok := mounts[volume]; ok {
+		if path != mountPath {
+			return fmt.Sprintf("the image mounts volume %s at %s, not at %s", volume, path, mountPath)
+		}
+		return ""
+	}
+	var paths []string
+	for _, path := range mounts {
+		if path == mountPath {
+			// Mounted by label, e.g. "%label".
+			return ""
+		}
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return fmt.Sprintf("mountPath %s is not one of the mounts of the image (%s), add %q: %q to its mounts", mountPath, strings.Join(paths, ", "), volume, mountPath)
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
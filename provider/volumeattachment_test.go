@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/property"
+)
+
+func TestVolumeAttachmentCheckMountPath(t *testing.T) {
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("data", "nanovms:index:VolumeAttachment", "", "project", "stack")
+
+	tests := []struct {
+		name       string
+		mountPath  string
+		config     string
+		mounts     map[string]string
+		wantReason string
+	}{
+		{name: "no mounts known", mountPath: "/data"},
+		{name: "relative", mountPath: "data", wantReason: "mountPath must be an absolute path"},
+		{name: "mount of the config", mountPath: "/data", config: `{"Mounts":{"data":"/data"}}`},
+		{name: "mount of the opsConfig", mountPath: "/data", mounts: map[string]string{"data": "/data"}},
+		{name: "mounted by label", mountPath: "/data", config: `{"Mounts":{"%data":"/data"}}`},
+		{
+			name:       "volume mounted elsewhere",
+			mountPath:  "/data",
+			config:     `{"Mounts":{"data":"/var/data"}}`,
+			wantReason: "the image mounts volume data at /var/data, not at /data",
+		},
+		{
+			name:       "not a mount",
+			mountPath:  "/data",
+			mounts:     map[string]string{"logs": "/logs", "cache": "/cache"},
+			wantReason: `mountPath /data is not one of the mounts of the image (/cache, /logs), add "data": "/data" to its mounts`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inputs := map[string]property.Value{
+				"instance":  property.New("web"),
+				"volume":    property.New("data"),
+				"mountPath": property.New(tt.mountPath),
+				"provider":  property.New("onprem"),
+			}
+			if tt.config != "" {
+				inputs["config"] = property.New(tt.config)
+			}
+			if tt.mounts != nil {
+				mounts := map[string]property.Value{}
+				for volume, path := range tt.mounts {
+					mounts[volume] = property.New(path)
+				}
+				inputs["opsConfig"] = property.New(property.NewMap(map[string]property.Value{
+					"mounts": property.New(property.NewMap(mounts)),
+				}))
+			}
+
+			resp, err := server.Check(p.CheckRequest{Urn: urn, Inputs: property.NewMap(inputs)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantReason == "" {
+				if len(resp.Failures) > 0 {
+					t.Fatalf("check failed: %v", resp.Failures)
+				}
+				return
+			}
+			if len(resp.Failures) != 1 || resp.Failures[0].Property != "mountPath" || resp.Failures[0].Reason != tt.wantReason {
+				t.Fatalf("failures are %v, want mountPath: %s", resp.Failures, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestVolumeAttachmentMountPathReplaces(t *testing.T) {
+	useFakeCloud(t)
+	server := newTestServer(t, p.ConfigureRequest{})
+	urn := resource.CreateURN("data", "nanovms:index:VolumeAttachment", "", "project", "stack")
+	inputs := func(mountPath string) property.Map {
+		return property.NewMap(map[string]property.Value{
+			"instance":  property.New("web"),
+			"volume":    property.New("data"),
+			"mountPath": property.New(mountPath),
+			"provider":  property.New("onprem"),
+		})
+	}
+
+	created, err := server.Create(p.CreateRequest{Urn: urn, Properties: inputs("/data"), DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mountPath := created.Properties.Get("mountPath").AsString(); mountPath != "/data" {
+		t.Fatalf("mountPath is %v in the state, want /data", mountPath)
+	}
+
+	diff, err := server.Diff(p.DiffRequest{ID: created.ID, Urn: urn, State: created.Properties, Inputs: inputs("/srv/data")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.HasChanges || !diff.DeleteBeforeReplace || diff.DetailedDiff["mountPath"].Kind != p.UpdateReplace {
+		t.Fatalf("mountPath change does not replace the attachment: %+v", diff)
+	}
+}
@@ -0,0 +1,253 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Immutable;
+
+namespace Tpjg.Nanovms
+{
+    public static class Config
+    {
+        [global::System.Diagnostics.CodeAnalysis.SuppressMessage("Microsoft.Design", "IDE1006", Justification = 
+        "Double underscore prefix used to avoid conflicts with variable names.")]
+        private sealed class __Value<T>
+        {
+            private readonly Func<T> _getter;
+            private T _value = default!;
+            private bool _set;
+
+            public __Value(Func<T> getter)
+            {
+                _getter = getter;
+            }
+
+            public T Get() => _set ? _value : _getter();
+
+            public void Set(T value)
+            {
+                _value = value;
+                _set = true;
+            }
+        }
+
+        private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("nanovms");
+
+        private static readonly __Value<string?> _architecture = new __Value<string?>(() => __config.Get("architecture"));
+        /// <summary>
+        /// The default target architecture of package images (amd64 or arm64)
+        /// </summary>
+        public static string? Architecture
+        {
+            get => _architecture.Get();
+            set => _architecture.Set(value);
+        }
+
+        private static readonly __Value<Types.OpsCloudConfig?> _cloudConfig = new __Value<Types.OpsCloudConfig?>(() => __config.GetObject<Types.OpsCloudConfig>("cloudConfig"));
+        /// <summary>
+        /// The default cloud provider settings, merged under the configuration of each resource
+        /// </summary>
+        public static Types.OpsCloudConfig? CloudConfig
+        {
+            get => _cloudConfig.Get();
+            set => _cloudConfig.Set(value);
+        }
+
+        private static readonly __Value<string?> _defaultProvider = new __Value<string?>(() => __config.Get("defaultProvider"));
+        /// <summary>
+        /// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        /// </summary>
+        public static string? DefaultProvider
+        {
+            get => _defaultProvider.Get();
+            set => _defaultProvider.Set(value);
+        }
+
+        private static readonly __Value<string?> _kernelVersion = new __Value<string?>(() => __config.Get("kernelVersion"));
+        /// <summary>
+        /// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        /// </summary>
+        public static string? KernelVersion
+        {
+            get => _kernelVersion.Get();
+            set => _kernelVersion.Set(value);
+        }
+
+        private static readonly __Value<string?> _mirrorDir = new __Value<string?>(() => __config.Get("mirrorDir") ?? Utilities.GetEnv("NANOVMS_MIRROR_DIR") ?? "");
+        /// <summary>
+        /// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        /// </summary>
+        public static string? MirrorDir
+        {
+            get => _mirrorDir.Get();
+            set => _mirrorDir.Set(value);
+        }
+
+        private static readonly __Value<bool?> _offline = new __Value<bool?>(() => __config.GetBoolean("offline") ?? Utilities.GetEnvBoolean("NANOVMS_OFFLINE") ?? false);
+        /// <summary>
+        /// If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        /// </summary>
+        public static bool? Offline
+        {
+            get => _offline.Get();
+            set => _offline.Set(value);
+        }
+
+        private static readonly __Value<string?> _opsHome = new __Value<string?>(() => __config.Get("opsHome"));
+        /// <summary>
+        /// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        /// </summary>
+        public static string? OpsHome
+        {
+            get => _opsHome.Get();
+            set => _opsHome.Set(value);
+        }
+
+        public static class Types
+        {
+
+             public class OpsCloudConfig
+             {
+            /// <summary>
+            /// The bucket to store the image artifacts in
+            /// </summary>
+                public string? BucketName { get; set; } = null!;
+            /// <summary>
+            /// The bucket namespace, required for oci
+            /// </summary>
+                public string? BucketNamespace { get; set; } = null!;
+            /// <summary>
+            /// If confidential computing should be enabled
+            /// </summary>
+                public bool? ConfidentialVM { get; set; }
+            /// <summary>
+            /// The ID of the dedicated host to run on
+            /// </summary>
+                public string? DedicatedHostID { get; set; } = null!;
+            /// <summary>
+            /// The domain name to create a DNS record for
+            /// </summary>
+                public string? DomainName { get; set; } = null!;
+            /// <summary>
+            /// If IPv6 should be enabled when creating a VPC
+            /// </summary>
+                public bool? EnableIPv6 { get; set; }
+            /// <summary>
+            /// The instance flavor or machine type
+            /// </summary>
+                public string? Flavor { get; set; } = null!;
+            /// <summary>
+            /// The image type
+            /// </summary>
+                public string? ImageType { get; set; } = null!;
+            /// <summary>
+            /// The IAM instance profile (aws)
+            /// </summary>
+                public string? InstanceProfile { get; set; } = null!;
+            /// <summary>
+            /// The KMS key to encrypt images with, 'default' or an arn (aws)
+            /// </summary>
+                public string? Kms { get; set; } = null!;
+            /// <summary>
+            /// The cloud platform
+            /// </summary>
+                public string? Platform { get; set; } = null!;
+            /// <summary>
+            /// The project ID (gcp)
+            /// </summary>
+                public string? ProjectID { get; set; } = null!;
+            /// <summary>
+            /// Settings for the root volume
+            /// </summary>
+                public Types.OpsCloudVolume? RootVolume { get; set; } = null!;
+            /// <summary>
+            /// The security group
+            /// </summary>
+                public string? SecurityGroup { get; set; } = null!;
+            /// <summary>
+            /// Skip verifying that a vm importer role exists (aws)
+            /// </summary>
+                public bool? SkipImportVerify { get; set; }
+            /// <summary>
+            /// If spot provisioning should be used
+            /// </summary>
+                public bool? Spot { get; set; }
+            /// <summary>
+            /// The static public IP to assign
+            /// </summary>
+                public string? StaticIP { get; set; } = null!;
+            /// <summary>
+            /// The subnet
+            /// </summary>
+                public string? Subnet { get; set; } = null!;
+            /// <summary>
+            /// Tags (labels) for images and instances
+            /// </summary>
+                public ImmutableArray<Types.OpsTag> Tags { get; set; }
+            /// <summary>
+            /// User data passed to the instance
+            /// </summary>
+                public string? UserData { get; set; } = null!;
+            /// <summary>
+            /// The VPC
+            /// </summary>
+                public string? Vpc { get; set; } = null!;
+            /// <summary>
+            /// The zone or region
+            /// </summary>
+                public string? Zone { get; set; } = null!;
+            }
+
+             public class OpsCloudVolume
+             {
+            /// <summary>
+            /// The provisioned IOPS
+            /// </summary>
+                public int? Iops { get; set; }
+            /// <summary>
+            /// The name of the volume
+            /// </summary>
+                public string? Name { get; set; } = null!;
+            /// <summary>
+            /// The size of the volume in GB
+            /// </summary>
+                public int? Size { get; set; }
+            /// <summary>
+            /// The provisioned throughput
+            /// </summary>
+                public int? Throughput { get; set; }
+            /// <summary>
+            /// The volume type
+            /// </summary>
+                public string? Typeof { get; set; } = null!;
+            }
+
+             public class OpsTag
+             {
+            /// <summary>
+            /// If the tag should be used as an image label
+            /// </summary>
+                public bool? ImageLabel { get; set; }
+            /// <summary>
+            /// If the tag should be used as an instance label
+            /// </summary>
+                public bool? InstanceLabel { get; set; }
+            /// <summary>
+            /// If the tag should be used as instance metadata
+            /// </summary>
+                public bool? InstanceMetadata { get; set; }
+            /// <summary>
+            /// If the tag value should be used as an instance network tag
+            /// </summary>
+                public bool? InstanceNetwork { get; set; }
+            /// <summary>
+            /// The tag key
+            /// </summary>
+                public string Key { get; set; }
+            /// <summary>
+            /// The tag value
+            /// </summary>
+                public string Value { get; set; }
+            }
+        }
+    }
+}
@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetImage
+    {
+        /// <summary>
+        /// Looks up an image of a cloud provider by name
+        /// </summary>
+        public static Task<GetImageResult> InvokeAsync(GetImageArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetImageResult>("nanovms:index:getImage", args ?? new GetImageArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up an image of a cloud provider by name
+        /// </summary>
+        public static Output<GetImageResult> Invoke(GetImageInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetImageResult>("nanovms:index:getImage", args ?? new GetImageInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up an image of a cloud provider by name
+        /// </summary>
+        public static Output<GetImageResult> Invoke(GetImageInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetImageResult>("nanovms:index:getImage", args ?? new GetImageInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetImageArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// The name of the image
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider of the image
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetImageArgs()
+        {
+        }
+        public static new GetImageArgs Empty => new GetImageArgs();
+    }
+
+    public sealed class GetImageInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The name of the image
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider of the image
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetImageInvokeArgs()
+        {
+        }
+        public static new GetImageInvokeArgs Empty => new GetImageInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetImageResult
+    {
+        /// <summary>
+        /// The creation time of the image (RFC 3339)
+        /// </summary>
+        public readonly string Created;
+        /// <summary>
+        /// The provider ID of the image
+        /// </summary>
+        public readonly string ImageID;
+        /// <summary>
+        /// The labels of the image
+        /// </summary>
+        public readonly ImmutableArray<string> Labels;
+        /// <summary>
+        /// The name of the image
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The (local) path of the image
+        /// </summary>
+        public readonly string Path;
+        /// <summary>
+        /// The size of the image in bytes
+        /// </summary>
+        public readonly int Size;
+        /// <summary>
+        /// The status of the image
+        /// </summary>
+        public readonly string Status;
+
+        [OutputConstructor]
+        private GetImageResult(
+            string created,
+
+            string imageID,
+
+            ImmutableArray<string> labels,
+
+            string name,
+
+            string path,
+
+            int size,
+
+            string status)
+        {
+            Created = created;
+            ImageID = imageID;
+            Labels = labels;
+            Name = name;
+            Path = path;
+            Size = size;
+            Status = status;
+        }
+    }
+}
@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetImages
+    {
+        /// <summary>
+        /// Lists the images of a cloud provider
+        /// </summary>
+        public static Task<GetImagesResult> InvokeAsync(GetImagesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetImagesResult>("nanovms:index:getImages", args ?? new GetImagesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the images of a cloud provider
+        /// </summary>
+        public static Output<GetImagesResult> Invoke(GetImagesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetImagesResult>("nanovms:index:getImages", args ?? new GetImagesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the images of a cloud provider
+        /// </summary>
+        public static Output<GetImagesResult> Invoke(GetImagesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetImagesResult>("nanovms:index:getImages", args ?? new GetImagesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetImagesArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// Only return images matching the filter, the format depends on the cloud provider
+        /// </summary>
+        [Input("filter")]
+        public string? Filter { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the images of
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetImagesArgs()
+        {
+        }
+        public static new GetImagesArgs Empty => new GetImagesArgs();
+    }
+
+    public sealed class GetImagesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// Only return images matching the filter, the format depends on the cloud provider
+        /// </summary>
+        [Input("filter")]
+        public Input<string>? Filter { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the images of
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetImagesInvokeArgs()
+        {
+        }
+        public static new GetImagesInvokeArgs Empty => new GetImagesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetImagesResult
+    {
+        /// <summary>
+        /// The images
+        /// </summary>
+        public readonly ImmutableArray<Outputs.ImageInfo> Images;
+
+        [OutputConstructor]
+        private GetImagesResult(ImmutableArray<Outputs.ImageInfo> images)
+        {
+            Images = images;
+        }
+    }
+}
@@ -0,0 +1,164 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetInstance
+    {
+        /// <summary>
+        /// Looks up an instance of a cloud provider by name
+        /// </summary>
+        public static Task<GetInstanceResult> InvokeAsync(GetInstanceArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInstanceResult>("nanovms:index:getInstance", args ?? new GetInstanceArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up an instance of a cloud provider by name
+        /// </summary>
+        public static Output<GetInstanceResult> Invoke(GetInstanceInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstanceResult>("nanovms:index:getInstance", args ?? new GetInstanceInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Looks up an instance of a cloud provider by name
+        /// </summary>
+        public static Output<GetInstanceResult> Invoke(GetInstanceInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstanceResult>("nanovms:index:getInstance", args ?? new GetInstanceInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetInstanceArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        [Input("name", required: true)]
+        public string Name { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider of the instance
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetInstanceArgs()
+        {
+        }
+        public static new GetInstanceArgs Empty => new GetInstanceArgs();
+    }
+
+    public sealed class GetInstanceInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider of the instance
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetInstanceInvokeArgs()
+        {
+        }
+        public static new GetInstanceInvokeArgs Empty => new GetInstanceInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInstanceResult
+    {
+        /// <summary>
+        /// The creation time of the instance as reported by the cloud provider
+        /// </summary>
+        public readonly string Created;
+        /// <summary>
+        /// The image the instance runs
+        /// </summary>
+        public readonly string Image;
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The provider instance ID
+        /// </summary>
+        public readonly string Pid;
+        /// <summary>
+        /// The ports of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Ports;
+        /// <summary>
+        /// The private IP addresses of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Private_ips;
+        /// <summary>
+        /// The public IP addresses of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Public_ips;
+        /// <summary>
+        /// The status of the instance
+        /// </summary>
+        public readonly string Status;
+
+        [OutputConstructor]
+        private GetInstanceResult(
+            string created,
+
+            string image,
+
+            string name,
+
+            string pid,
+
+            ImmutableArray<string> ports,
+
+            ImmutableArray<string> private_ips,
+
+            ImmutableArray<string> public_ips,
+
+            string status)
+        {
+            Created = created;
+            Image = image;
+            Name = name;
+            Pid = pid;
+            Ports = ports;
+            Private_ips = private_ips;
+            Public_ips = public_ips;
+            Status = status;
+        }
+    }
+}
@@ -0,0 +1,126 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetInstanceLogs
+    {
+        /// <summary>
+        /// Retrieves the console output of a NanoVMs instance
+        /// </summary>
+        public static Task<GetInstanceLogsResult> InvokeAsync(GetInstanceLogsArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInstanceLogsResult>("nanovms:index:getInstanceLogs", args ?? new GetInstanceLogsArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves the console output of a NanoVMs instance
+        /// </summary>
+        public static Output<GetInstanceLogsResult> Invoke(GetInstanceLogsInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstanceLogsResult>("nanovms:index:getInstanceLogs", args ?? new GetInstanceLogsInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Retrieves the console output of a NanoVMs instance
+        /// </summary>
+        public static Output<GetInstanceLogsResult> Invoke(GetInstanceLogsInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstanceLogsResult>("nanovms:index:getInstanceLogs", args ?? new GetInstanceLogsInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetInstanceLogsArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, e.g. the config output of the instance
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// The ID (name) of the instance
+        /// </summary>
+        [Input("instanceID", required: true)]
+        public string InstanceID { get; set; } = null!;
+
+        /// <summary>
+        /// Only return the last number of lines, all output is returned if not set
+        /// </summary>
+        [Input("lines")]
+        public int? Lines { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The provider (type) of the instance
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetInstanceLogsArgs()
+        {
+        }
+        public static new GetInstanceLogsArgs Empty => new GetInstanceLogsArgs();
+    }
+
+    public sealed class GetInstanceLogsInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, e.g. the config output of the instance
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The ID (name) of the instance
+        /// </summary>
+        [Input("instanceID", required: true)]
+        public Input<string> InstanceID { get; set; } = null!;
+
+        /// <summary>
+        /// Only return the last number of lines, all output is returned if not set
+        /// </summary>
+        [Input("lines")]
+        public Input<int>? Lines { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The provider (type) of the instance
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetInstanceLogsInvokeArgs()
+        {
+        }
+        public static new GetInstanceLogsInvokeArgs Empty => new GetInstanceLogsInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInstanceLogsResult
+    {
+        /// <summary>
+        /// The console output of the instance
+        /// </summary>
+        public readonly string Logs;
+
+        [OutputConstructor]
+        private GetInstanceLogsResult(string logs)
+        {
+            Logs = logs;
+        }
+    }
+}
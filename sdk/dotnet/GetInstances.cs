@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetInstances
+    {
+        /// <summary>
+        /// Lists the instances of a cloud provider
+        /// </summary>
+        public static Task<GetInstancesResult> InvokeAsync(GetInstancesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetInstancesResult>("nanovms:index:getInstances", args ?? new GetInstancesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the instances of a cloud provider
+        /// </summary>
+        public static Output<GetInstancesResult> Invoke(GetInstancesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstancesResult>("nanovms:index:getInstances", args ?? new GetInstancesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the instances of a cloud provider
+        /// </summary>
+        public static Output<GetInstancesResult> Invoke(GetInstancesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetInstancesResult>("nanovms:index:getInstances", args ?? new GetInstancesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetInstancesArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the instances of
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetInstancesArgs()
+        {
+        }
+        public static new GetInstancesArgs Empty => new GetInstancesArgs();
+    }
+
+    public sealed class GetInstancesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the instances of
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetInstancesInvokeArgs()
+        {
+        }
+        public static new GetInstancesInvokeArgs Empty => new GetInstancesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetInstancesResult
+    {
+        /// <summary>
+        /// The instances
+        /// </summary>
+        public readonly ImmutableArray<Outputs.InstanceInfo> Instances;
+
+        [OutputConstructor]
+        private GetInstancesResult(ImmutableArray<Outputs.InstanceInfo> instances)
+        {
+            Instances = instances;
+        }
+    }
+}
@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    public static class GetVolumes
+    {
+        /// <summary>
+        /// Lists the volumes of a cloud provider
+        /// </summary>
+        public static Task<GetVolumesResult> InvokeAsync(GetVolumesArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetVolumesResult>("nanovms:index:getVolumes", args ?? new GetVolumesArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the volumes of a cloud provider
+        /// </summary>
+        public static Output<GetVolumesResult> Invoke(GetVolumesInvokeArgs? args = null, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetVolumesResult>("nanovms:index:getVolumes", args ?? new GetVolumesInvokeArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Lists the volumes of a cloud provider
+        /// </summary>
+        public static Output<GetVolumesResult> Invoke(GetVolumesInvokeArgs args, InvokeOutputOptions options)
+            => global::Pulumi.Deployment.Instance.Invoke<GetVolumesResult>("nanovms:index:getVolumes", args ?? new GetVolumesInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetVolumesArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public string? Config { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Inputs.OpsConfig? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the volumes of
+        /// </summary>
+        [Input("provider")]
+        public string? Provider { get; set; }
+
+        public GetVolumesArgs()
+        {
+        }
+        public static new GetVolumesArgs Empty => new GetVolumesArgs();
+    }
+
+    public sealed class GetVolumesInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as project and zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider to list the volumes of
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        public GetVolumesInvokeArgs()
+        {
+        }
+        public static new GetVolumesInvokeArgs Empty => new GetVolumesInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetVolumesResult
+    {
+        /// <summary>
+        /// The volumes
+        /// </summary>
+        public readonly ImmutableArray<Outputs.VolumeInfo> Volumes;
+
+        [OutputConstructor]
+        private GetVolumesResult(ImmutableArray<Outputs.VolumeInfo> volumes)
+        {
+            Volumes = volumes;
+        }
+    }
+}
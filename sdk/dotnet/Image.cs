@@ -16,12 +16,24 @@ namespace Tpjg.Nanovms
     [NanovmsResourceType("nanovms:index:Image")]
     public partial class Image : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// The command line of the program in the image, the program name followed by its arguments
+        /// </summary>
+        [Output("args")]
+        public Output<ImmutableArray<string>> Args { get; private set; } = null!;
+
         /// <summary>
         /// The configuration of the built image as a JSON encoded string
         /// </summary>
         [Output("config")]
         public Output<string> Config { get; private set; } = null!;
 
+        /// <summary>
+        /// The digest of the elf and all files and directories included into the image
+        /// </summary>
+        [Output("contentHash")]
+        public Output<string?> ContentHash { get; private set; } = null!;
+
         /// <summary>
         /// The name of the built image
         /// </summary>
@@ -34,12 +46,24 @@ namespace Tpjg.Nanovms
         [Output("imagePath")]
         public Output<string> ImagePath { get; private set; } = null!;
 
+        /// <summary>
+        /// The nanos kernel version the image is built with
+        /// </summary>
+        [Output("kernelVersion")]
+        public Output<string?> KernelVersion { get; private set; } = null!;
+
         /// <summary>
         /// The cloud provider of the built image
         /// </summary>
         [Output("provider")]
         public Output<string> Provider { get; private set; } = null!;
 
+        /// <summary>
+        /// The paths of the dynamic loader and shared libraries added to the image because of includeLdd
+        /// </summary>
+        [Output("sharedLibraries")]
+        public Output<ImmutableArray<string>> SharedLibraries { get; private set; } = null!;
+
         /// <summary>
         /// If the latest kernel should be used, download it if necessary
         /// </summary>
@@ -91,8 +115,20 @@ namespace Tpjg.Nanovms
 
     public sealed class ImageArgs : global::Pulumi.ResourceArgs
     {
+        [Input("args")]
+        private InputList<string>? _args;
+
         /// <summary>
-        /// The configuration as a JSON encoded string
+        /// The arguments the program is started with, appended to the args of the configuration
+        /// </summary>
+        public InputList<string> Args
+        {
+            get => _args ?? (_args = new InputList<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
         /// </summary>
         [Input("config")]
         public Input<string>? Config { get; set; }
@@ -109,17 +145,47 @@ namespace Tpjg.Nanovms
         [Input("force")]
         public Input<bool>? Force { get; set; }
 
+        /// <summary>
+        /// If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+        /// </summary>
+        [Input("includeLdd")]
+        public Input<bool>? IncludeLdd { get; set; }
+
+        /// <summary>
+        /// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        /// </summary>
+        [Input("kernelVersion")]
+        public Input<string>? KernelVersion { get; set; }
+
         /// <summary>
         /// The name of the image
         /// </summary>
         [Input("name", required: true)]
         public Input<string> Name { get; set; } = null!;
 
+        /// <summary>
+        /// The configuration of the image
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
         /// <summary>
         /// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
         /// </summary>
-        [Input("provider", required: true)]
-        public Input<string> Provider { get; set; } = null!;
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        /// <summary>
+        /// The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+        /// </summary>
+        [Input("sysroot")]
+        public Input<string>? Sysroot { get; set; }
 
         /// <summary>
         /// If the latest kernel should be used, download it if necessary
@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+    /// </summary>
+    public sealed class InstanceReadinessArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+        /// </summary>
+        [Input("httpPath")]
+        public Input<string>? HttpPath { get; set; }
+
+        /// <summary>
+        /// The time between readiness checks (e.g. '2s')
+        /// </summary>
+        [Input("pollInterval")]
+        public Input<string>? PollInterval { get; set; }
+
+        /// <summary>
+        /// The TCP port that must accept connections before the instance is considered ready
+        /// </summary>
+        [Input("port")]
+        public Input<int>? Port { get; set; }
+
+        /// <summary>
+        /// The maximum time to wait for the instance to become ready (e.g. '5m')
+        /// </summary>
+        [Input("timeout")]
+        public Input<string>? Timeout { get; set; }
+
+        public InstanceReadinessArgs()
+        {
+            PollInterval = "2s";
+            Timeout = "5m";
+        }
+        public static new InstanceReadinessArgs Empty => new InstanceReadinessArgs();
+    }
+}
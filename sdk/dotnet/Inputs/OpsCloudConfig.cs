@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The cloud provider specific configuration
+    /// </summary>
+    public sealed class OpsCloudConfig : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The bucket to store the image artifacts in
+        /// </summary>
+        [Input("bucketName")]
+        public string? BucketName { get; set; }
+
+        /// <summary>
+        /// The bucket namespace, required for oci
+        /// </summary>
+        [Input("bucketNamespace")]
+        public string? BucketNamespace { get; set; }
+
+        /// <summary>
+        /// If confidential computing should be enabled
+        /// </summary>
+        [Input("confidentialVM")]
+        public bool? ConfidentialVM { get; set; }
+
+        /// <summary>
+        /// The ID of the dedicated host to run on
+        /// </summary>
+        [Input("dedicatedHostID")]
+        public string? DedicatedHostID { get; set; }
+
+        /// <summary>
+        /// The domain name to create a DNS record for
+        /// </summary>
+        [Input("domainName")]
+        public string? DomainName { get; set; }
+
+        /// <summary>
+        /// If IPv6 should be enabled when creating a VPC
+        /// </summary>
+        [Input("enableIPv6")]
+        public bool? EnableIPv6 { get; set; }
+
+        /// <summary>
+        /// The instance flavor or machine type
+        /// </summary>
+        [Input("flavor")]
+        public string? Flavor { get; set; }
+
+        /// <summary>
+        /// The image type
+        /// </summary>
+        [Input("imageType")]
+        public string? ImageType { get; set; }
+
+        /// <summary>
+        /// The IAM instance profile (aws)
+        /// </summary>
+        [Input("instanceProfile")]
+        public string? InstanceProfile { get; set; }
+
+        /// <summary>
+        /// The KMS key to encrypt images with, 'default' or an arn (aws)
+        /// </summary>
+        [Input("kms")]
+        public string? Kms { get; set; }
+
+        /// <summary>
+        /// The cloud platform
+        /// </summary>
+        [Input("platform")]
+        public string? Platform { get; set; }
+
+        /// <summary>
+        /// The project ID (gcp)
+        /// </summary>
+        [Input("projectID")]
+        public string? ProjectID { get; set; }
+
+        /// <summary>
+        /// Settings for the root volume
+        /// </summary>
+        [Input("rootVolume")]
+        public Inputs.OpsCloudVolume? RootVolume { get; set; }
+
+        /// <summary>
+        /// The security group
+        /// </summary>
+        [Input("securityGroup")]
+        public string? SecurityGroup { get; set; }
+
+        /// <summary>
+        /// Skip verifying that a vm importer role exists (aws)
+        /// </summary>
+        [Input("skipImportVerify")]
+        public bool? SkipImportVerify { get; set; }
+
+        /// <summary>
+        /// If spot provisioning should be used
+        /// </summary>
+        [Input("spot")]
+        public bool? Spot { get; set; }
+
+        /// <summary>
+        /// The static public IP to assign
+        /// </summary>
+        [Input("staticIP")]
+        public string? StaticIP { get; set; }
+
+        /// <summary>
+        /// The subnet
+        /// </summary>
+        [Input("subnet")]
+        public string? Subnet { get; set; }
+
+        [Input("tags")]
+        private List<Inputs.OpsTag>? _tags;
+
+        /// <summary>
+        /// Tags (labels) for images and instances
+        /// </summary>
+        public List<Inputs.OpsTag> Tags
+        {
+            get => _tags ?? (_tags = new List<Inputs.OpsTag>());
+            set => _tags = value;
+        }
+
+        /// <summary>
+        /// User data passed to the instance
+        /// </summary>
+        [Input("userData")]
+        public string? UserData { get; set; }
+
+        /// <summary>
+        /// The VPC
+        /// </summary>
+        [Input("vpc")]
+        public string? Vpc { get; set; }
+
+        /// <summary>
+        /// The zone or region
+        /// </summary>
+        [Input("zone")]
+        public string? Zone { get; set; }
+
+        public OpsCloudConfig()
+        {
+        }
+        public static new OpsCloudConfig Empty => new OpsCloudConfig();
+    }
+}
@@ -0,0 +1,162 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The cloud provider specific configuration
+    /// </summary>
+    public sealed class OpsCloudConfigArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The bucket to store the image artifacts in
+        /// </summary>
+        [Input("bucketName")]
+        public Input<string>? BucketName { get; set; }
+
+        /// <summary>
+        /// The bucket namespace, required for oci
+        /// </summary>
+        [Input("bucketNamespace")]
+        public Input<string>? BucketNamespace { get; set; }
+
+        /// <summary>
+        /// If confidential computing should be enabled
+        /// </summary>
+        [Input("confidentialVM")]
+        public Input<bool>? ConfidentialVM { get; set; }
+
+        /// <summary>
+        /// The ID of the dedicated host to run on
+        /// </summary>
+        [Input("dedicatedHostID")]
+        public Input<string>? DedicatedHostID { get; set; }
+
+        /// <summary>
+        /// The domain name to create a DNS record for
+        /// </summary>
+        [Input("domainName")]
+        public Input<string>? DomainName { get; set; }
+
+        /// <summary>
+        /// If IPv6 should be enabled when creating a VPC
+        /// </summary>
+        [Input("enableIPv6")]
+        public Input<bool>? EnableIPv6 { get; set; }
+
+        /// <summary>
+        /// The instance flavor or machine type
+        /// </summary>
+        [Input("flavor")]
+        public Input<string>? Flavor { get; set; }
+
+        /// <summary>
+        /// The image type
+        /// </summary>
+        [Input("imageType")]
+        public Input<string>? ImageType { get; set; }
+
+        /// <summary>
+        /// The IAM instance profile (aws)
+        /// </summary>
+        [Input("instanceProfile")]
+        public Input<string>? InstanceProfile { get; set; }
+
+        /// <summary>
+        /// The KMS key to encrypt images with, 'default' or an arn (aws)
+        /// </summary>
+        [Input("kms")]
+        public Input<string>? Kms { get; set; }
+
+        /// <summary>
+        /// The cloud platform
+        /// </summary>
+        [Input("platform")]
+        public Input<string>? Platform { get; set; }
+
+        /// <summary>
+        /// The project ID (gcp)
+        /// </summary>
+        [Input("projectID")]
+        public Input<string>? ProjectID { get; set; }
+
+        /// <summary>
+        /// Settings for the root volume
+        /// </summary>
+        [Input("rootVolume")]
+        public Input<Inputs.OpsCloudVolumeArgs>? RootVolume { get; set; }
+
+        /// <summary>
+        /// The security group
+        /// </summary>
+        [Input("securityGroup")]
+        public Input<string>? SecurityGroup { get; set; }
+
+        /// <summary>
+        /// Skip verifying that a vm importer role exists (aws)
+        /// </summary>
+        [Input("skipImportVerify")]
+        public Input<bool>? SkipImportVerify { get; set; }
+
+        /// <summary>
+        /// If spot provisioning should be used
+        /// </summary>
+        [Input("spot")]
+        public Input<bool>? Spot { get; set; }
+
+        /// <summary>
+        /// The static public IP to assign
+        /// </summary>
+        [Input("staticIP")]
+        public Input<string>? StaticIP { get; set; }
+
+        /// <summary>
+        /// The subnet
+        /// </summary>
+        [Input("subnet")]
+        public Input<string>? Subnet { get; set; }
+
+        [Input("tags")]
+        private InputList<Inputs.OpsTagArgs>? _tags;
+
+        /// <summary>
+        /// Tags (labels) for images and instances
+        /// </summary>
+        public InputList<Inputs.OpsTagArgs> Tags
+        {
+            get => _tags ?? (_tags = new InputList<Inputs.OpsTagArgs>());
+            set => _tags = value;
+        }
+
+        /// <summary>
+        /// User data passed to the instance
+        /// </summary>
+        [Input("userData")]
+        public Input<string>? UserData { get; set; }
+
+        /// <summary>
+        /// The VPC
+        /// </summary>
+        [Input("vpc")]
+        public Input<string>? Vpc { get; set; }
+
+        /// <summary>
+        /// The zone or region
+        /// </summary>
+        [Input("zone")]
+        public Input<string>? Zone { get; set; }
+
+        public OpsCloudConfigArgs()
+        {
+        }
+        public static new OpsCloudConfigArgs Empty => new OpsCloudConfigArgs();
+    }
+}
@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// Cloud volume settings
+    /// </summary>
+    public sealed class OpsCloudVolumeArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The provisioned IOPS
+        /// </summary>
+        [Input("iops")]
+        public Input<int>? Iops { get; set; }
+
+        /// <summary>
+        /// The name of the volume
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The size of the volume in GB
+        /// </summary>
+        [Input("size")]
+        public Input<int>? Size { get; set; }
+
+        /// <summary>
+        /// The provisioned throughput
+        /// </summary>
+        [Input("throughput")]
+        public Input<int>? Throughput { get; set; }
+
+        /// <summary>
+        /// The volume type
+        /// </summary>
+        [Input("typeof")]
+        public Input<string>? Typeof { get; set; }
+
+        public OpsCloudVolumeArgs()
+        {
+        }
+        public static new OpsCloudVolumeArgs Empty => new OpsCloudVolumeArgs();
+    }
+}
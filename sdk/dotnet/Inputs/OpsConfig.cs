@@ -0,0 +1,264 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The nanovms ops configuration, mirrors the ops JSON configuration file
+    /// </summary>
+    public sealed class OpsConfig : global::Pulumi.InvokeArgs
+    {
+        [Input("args")]
+        private List<string>? _args;
+
+        /// <summary>
+        /// The arguments passed to the program when the image is launched
+        /// </summary>
+        public List<string> Args
+        {
+            get => _args ?? (_args = new List<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        /// </summary>
+        [Input("baseVolumeSz")]
+        public string? BaseVolumeSz { get; set; }
+
+        /// <summary>
+        /// The path to the boot image
+        /// </summary>
+        [Input("boot")]
+        public string? Boot { get; set; }
+
+        /// <summary>
+        /// The cloud provider specific configuration
+        /// </summary>
+        [Input("cloudConfig")]
+        public Inputs.OpsCloudConfig? CloudConfig { get; set; }
+
+        [Input("debugflags")]
+        private List<string>? _debugflags;
+
+        /// <summary>
+        /// The kernel debug flags
+        /// </summary>
+        public List<string> Debugflags
+        {
+            get => _debugflags ?? (_debugflags = new List<string>());
+            set => _debugflags = value;
+        }
+
+        /// <summary>
+        /// The description of the image
+        /// </summary>
+        [Input("description")]
+        public string? Description { get; set; }
+
+        [Input("dirs")]
+        private List<string>? _dirs;
+
+        /// <summary>
+        /// Local directories to include into the image
+        /// </summary>
+        public List<string> Dirs
+        {
+            get => _dirs ?? (_dirs = new List<string>());
+            set => _dirs = value;
+        }
+
+        [Input("env")]
+        private Dictionary<string, string>? _env;
+
+        /// <summary>
+        /// Environment variables for the image runtime
+        /// </summary>
+        public Dictionary<string, string> Env
+        {
+            get => _env ?? (_env = new Dictionary<string, string>());
+            set => _env = value;
+        }
+
+        [Input("files")]
+        private List<string>? _files;
+
+        /// <summary>
+        /// Local files to include into the image
+        /// </summary>
+        public List<string> Files
+        {
+            get => _files ?? (_files = new List<string>());
+            set => _files = value;
+        }
+
+        /// <summary>
+        /// The path to the kernel image
+        /// </summary>
+        [Input("kernel")]
+        public string? Kernel { get; set; }
+
+        /// <summary>
+        /// The host location of the klibs
+        /// </summary>
+        [Input("klibDir")]
+        public string? KlibDir { get; set; }
+
+        [Input("klibs")]
+        private List<string>? _klibs;
+
+        /// <summary>
+        /// The klibs to include into the image (e.g. 'tls', 'ntp')
+        /// </summary>
+        public List<string> Klibs
+        {
+            get => _klibs ?? (_klibs = new List<string>());
+            set => _klibs = value;
+        }
+
+        /// <summary>
+        /// The language of the program
+        /// </summary>
+        [Input("language")]
+        public string? Language { get; set; }
+
+        /// <summary>
+        /// The parent directory of the files and directories specified in files and dirs
+        /// </summary>
+        [Input("localFilesParentDirectory")]
+        public string? LocalFilesParentDirectory { get; set; }
+
+        [Input("manifestPassthrough")]
+        private Dictionary<string, object>? _manifestPassthrough;
+
+        /// <summary>
+        /// Options passed straight through to the image manifest
+        /// </summary>
+        public Dictionary<string, object> ManifestPassthrough
+        {
+            get => _manifestPassthrough ?? (_manifestPassthrough = new Dictionary<string, object>());
+            set => _manifestPassthrough = value;
+        }
+
+        [Input("mapDirs")]
+        private Dictionary<string, string>? _mapDirs;
+
+        /// <summary>
+        /// Local directories (keys) to map to a path in the image (values)
+        /// </summary>
+        public Dictionary<string, string> MapDirs
+        {
+            get => _mapDirs ?? (_mapDirs = new Dictionary<string, string>());
+            set => _mapDirs = value;
+        }
+
+        [Input("mounts")]
+        private Dictionary<string, string>? _mounts;
+
+        /// <summary>
+        /// Volumes (keys) to mount at a path in the image (values)
+        /// </summary>
+        public Dictionary<string, string> Mounts
+        {
+            get => _mounts ?? (_mounts = new Dictionary<string, string>());
+            set => _mounts = value;
+        }
+
+        [Input("nameServers")]
+        private List<string>? _nameServers;
+
+        /// <summary>
+        /// The DNS servers to use, defaults to '8.8.8.8'
+        /// </summary>
+        public List<string> NameServers
+        {
+            get => _nameServers ?? (_nameServers = new List<string>());
+            set => _nameServers = value;
+        }
+
+        /// <summary>
+        /// The nanos kernel version
+        /// </summary>
+        [Input("nanosVersion")]
+        public string? NanosVersion { get; set; }
+
+        /// <summary>
+        /// If the nightly kernel build should be used
+        /// </summary>
+        [Input("nightlyBuild")]
+        public bool? NightlyBuild { get; set; }
+
+        [Input("noTrace")]
+        private List<string>? _noTrace;
+
+        /// <summary>
+        /// Syscalls to exclude from tracing
+        /// </summary>
+        public List<string> NoTrace
+        {
+            get => _noTrace ?? (_noTrace = new List<string>());
+            set => _noTrace = value;
+        }
+
+        /// <summary>
+        /// If the image should reboot automatically when an error occurs
+        /// </summary>
+        [Input("rebootOnExit")]
+        public bool? RebootOnExit { get; set; }
+
+        /// <summary>
+        /// The runtime configuration
+        /// </summary>
+        [Input("runConfig")]
+        public Inputs.OpsRunConfig? RunConfig { get; set; }
+
+        [Input("targetConfig")]
+        private Dictionary<string, string>? _targetConfig;
+
+        /// <summary>
+        /// Configuration specific to the target provider
+        /// </summary>
+        public Dictionary<string, string> TargetConfig
+        {
+            get => _targetConfig ?? (_targetConfig = new Dictionary<string, string>());
+            set => _targetConfig = value;
+        }
+
+        /// <summary>
+        /// The target root filesystem
+        /// </summary>
+        [Input("targetRoot")]
+        public string? TargetRoot { get; set; }
+
+        /// <summary>
+        /// If the deprecated TFS version 4 encoding should be used
+        /// </summary>
+        [Input("tfsv4")]
+        public bool? Tfsv4 { get; set; }
+
+        /// <summary>
+        /// If the image should support booting via UEFI
+        /// </summary>
+        [Input("uefi")]
+        public bool? Uefi { get; set; }
+
+        /// <summary>
+        /// The version of the image
+        /// </summary>
+        [Input("version")]
+        public string? Version { get; set; }
+
+        public OpsConfig()
+        {
+        }
+        public static new OpsConfig Empty => new OpsConfig();
+    }
+}
@@ -0,0 +1,264 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The nanovms ops configuration, mirrors the ops JSON configuration file
+    /// </summary>
+    public sealed class OpsConfigArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("args")]
+        private InputList<string>? _args;
+
+        /// <summary>
+        /// The arguments passed to the program when the image is launched
+        /// </summary>
+        public InputList<string> Args
+        {
+            get => _args ?? (_args = new InputList<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        /// </summary>
+        [Input("baseVolumeSz")]
+        public Input<string>? BaseVolumeSz { get; set; }
+
+        /// <summary>
+        /// The path to the boot image
+        /// </summary>
+        [Input("boot")]
+        public Input<string>? Boot { get; set; }
+
+        /// <summary>
+        /// The cloud provider specific configuration
+        /// </summary>
+        [Input("cloudConfig")]
+        public Input<Inputs.OpsCloudConfigArgs>? CloudConfig { get; set; }
+
+        [Input("debugflags")]
+        private InputList<string>? _debugflags;
+
+        /// <summary>
+        /// The kernel debug flags
+        /// </summary>
+        public InputList<string> Debugflags
+        {
+            get => _debugflags ?? (_debugflags = new InputList<string>());
+            set => _debugflags = value;
+        }
+
+        /// <summary>
+        /// The description of the image
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        [Input("dirs")]
+        private InputList<string>? _dirs;
+
+        /// <summary>
+        /// Local directories to include into the image
+        /// </summary>
+        public InputList<string> Dirs
+        {
+            get => _dirs ?? (_dirs = new InputList<string>());
+            set => _dirs = value;
+        }
+
+        [Input("env")]
+        private InputMap<string>? _env;
+
+        /// <summary>
+        /// Environment variables for the image runtime
+        /// </summary>
+        public InputMap<string> Env
+        {
+            get => _env ?? (_env = new InputMap<string>());
+            set => _env = value;
+        }
+
+        [Input("files")]
+        private InputList<string>? _files;
+
+        /// <summary>
+        /// Local files to include into the image
+        /// </summary>
+        public InputList<string> Files
+        {
+            get => _files ?? (_files = new InputList<string>());
+            set => _files = value;
+        }
+
+        /// <summary>
+        /// The path to the kernel image
+        /// </summary>
+        [Input("kernel")]
+        public Input<string>? Kernel { get; set; }
+
+        /// <summary>
+        /// The host location of the klibs
+        /// </summary>
+        [Input("klibDir")]
+        public Input<string>? KlibDir { get; set; }
+
+        [Input("klibs")]
+        private InputList<string>? _klibs;
+
+        /// <summary>
+        /// The klibs to include into the image (e.g. 'tls', 'ntp')
+        /// </summary>
+        public InputList<string> Klibs
+        {
+            get => _klibs ?? (_klibs = new InputList<string>());
+            set => _klibs = value;
+        }
+
+        /// <summary>
+        /// The language of the program
+        /// </summary>
+        [Input("language")]
+        public Input<string>? Language { get; set; }
+
+        /// <summary>
+        /// The parent directory of the files and directories specified in files and dirs
+        /// </summary>
+        [Input("localFilesParentDirectory")]
+        public Input<string>? LocalFilesParentDirectory { get; set; }
+
+        [Input("manifestPassthrough")]
+        private InputMap<object>? _manifestPassthrough;
+
+        /// <summary>
+        /// Options passed straight through to the image manifest
+        /// </summary>
+        public InputMap<object> ManifestPassthrough
+        {
+            get => _manifestPassthrough ?? (_manifestPassthrough = new InputMap<object>());
+            set => _manifestPassthrough = value;
+        }
+
+        [Input("mapDirs")]
+        private InputMap<string>? _mapDirs;
+
+        /// <summary>
+        /// Local directories (keys) to map to a path in the image (values)
+        /// </summary>
+        public InputMap<string> MapDirs
+        {
+            get => _mapDirs ?? (_mapDirs = new InputMap<string>());
+            set => _mapDirs = value;
+        }
+
+        [Input("mounts")]
+        private InputMap<string>? _mounts;
+
+        /// <summary>
+        /// Volumes (keys) to mount at a path in the image (values)
+        /// </summary>
+        public InputMap<string> Mounts
+        {
+            get => _mounts ?? (_mounts = new InputMap<string>());
+            set => _mounts = value;
+        }
+
+        [Input("nameServers")]
+        private InputList<string>? _nameServers;
+
+        /// <summary>
+        /// The DNS servers to use, defaults to '8.8.8.8'
+        /// </summary>
+        public InputList<string> NameServers
+        {
+            get => _nameServers ?? (_nameServers = new InputList<string>());
+            set => _nameServers = value;
+        }
+
+        /// <summary>
+        /// The nanos kernel version
+        /// </summary>
+        [Input("nanosVersion")]
+        public Input<string>? NanosVersion { get; set; }
+
+        /// <summary>
+        /// If the nightly kernel build should be used
+        /// </summary>
+        [Input("nightlyBuild")]
+        public Input<bool>? NightlyBuild { get; set; }
+
+        [Input("noTrace")]
+        private InputList<string>? _noTrace;
+
+        /// <summary>
+        /// Syscalls to exclude from tracing
+        /// </summary>
+        public InputList<string> NoTrace
+        {
+            get => _noTrace ?? (_noTrace = new InputList<string>());
+            set => _noTrace = value;
+        }
+
+        /// <summary>
+        /// If the image should reboot automatically when an error occurs
+        /// </summary>
+        [Input("rebootOnExit")]
+        public Input<bool>? RebootOnExit { get; set; }
+
+        /// <summary>
+        /// The runtime configuration
+        /// </summary>
+        [Input("runConfig")]
+        public Input<Inputs.OpsRunConfigArgs>? RunConfig { get; set; }
+
+        [Input("targetConfig")]
+        private InputMap<string>? _targetConfig;
+
+        /// <summary>
+        /// Configuration specific to the target provider
+        /// </summary>
+        public InputMap<string> TargetConfig
+        {
+            get => _targetConfig ?? (_targetConfig = new InputMap<string>());
+            set => _targetConfig = value;
+        }
+
+        /// <summary>
+        /// The target root filesystem
+        /// </summary>
+        [Input("targetRoot")]
+        public Input<string>? TargetRoot { get; set; }
+
+        /// <summary>
+        /// If the deprecated TFS version 4 encoding should be used
+        /// </summary>
+        [Input("tfsv4")]
+        public Input<bool>? Tfsv4 { get; set; }
+
+        /// <summary>
+        /// If the image should support booting via UEFI
+        /// </summary>
+        [Input("uefi")]
+        public Input<bool>? Uefi { get; set; }
+
+        /// <summary>
+        /// The version of the image
+        /// </summary>
+        [Input("version")]
+        public Input<string>? Version { get; set; }
+
+        public OpsConfigArgs()
+        {
+        }
+        public static new OpsConfigArgs Empty => new OpsConfigArgs();
+    }
+}
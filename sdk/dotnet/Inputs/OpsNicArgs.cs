@@ -0,0 +1,54 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// A network card configuration
+    /// </summary>
+    public sealed class OpsNicArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the bridge
+        /// </summary>
+        [Input("bridgeName")]
+        public Input<string>? BridgeName { get; set; }
+
+        /// <summary>
+        /// The gateway IP address
+        /// </summary>
+        [Input("gateway")]
+        public Input<string>? Gateway { get; set; }
+
+        /// <summary>
+        /// The IP address
+        /// </summary>
+        [Input("ipAddress")]
+        public Input<string>? IpAddress { get; set; }
+
+        /// <summary>
+        /// The IPv6 address
+        /// </summary>
+        [Input("ipv6Address")]
+        public Input<string>? Ipv6Address { get; set; }
+
+        /// <summary>
+        /// The network mask
+        /// </summary>
+        [Input("netMask")]
+        public Input<string>? NetMask { get; set; }
+
+        public OpsNicArgs()
+        {
+        }
+        public static new OpsNicArgs Empty => new OpsNicArgs();
+    }
+}
@@ -0,0 +1,246 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The runtime configuration
+    /// </summary>
+    public sealed class OpsRunConfig : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// If hardware acceleration should be enabled
+        /// </summary>
+        [Input("accel")]
+        public bool? Accel { get; set; }
+
+        /// <summary>
+        /// A hook to run after the instance stops
+        /// </summary>
+        [Input("atExit")]
+        public string? AtExit { get; set; }
+
+        /// <summary>
+        /// If the volumes in mounts should be attached when the instance is created
+        /// </summary>
+        [Input("attachVolumeOnInstanceCreate")]
+        public bool? AttachVolumeOnInstanceCreate { get; set; }
+
+        /// <summary>
+        /// The IP address of the bridge
+        /// </summary>
+        [Input("bridgeIPAddress")]
+        public string? BridgeIPAddress { get; set; }
+
+        /// <summary>
+        /// The name of the bridge
+        /// </summary>
+        [Input("bridgeName")]
+        public string? BridgeName { get; set; }
+
+        /// <summary>
+        /// If bridged networking should be used
+        /// </summary>
+        [Input("bridged")]
+        public bool? Bridged { get; set; }
+
+        /// <summary>
+        /// If IP forwarding should be enabled (gcp)
+        /// </summary>
+        [Input("canIPForward")]
+        public bool? CanIPForward { get; set; }
+
+        /// <summary>
+        /// The number of CPU cores
+        /// </summary>
+        [Input("cpus")]
+        public int? Cpus { get; set; }
+
+        /// <summary>
+        /// If debugging should be enabled
+        /// </summary>
+        [Input("debug")]
+        public bool? Debug { get; set; }
+
+        /// <summary>
+        /// The gateway IP address
+        /// </summary>
+        [Input("gateway")]
+        public string? Gateway { get; set; }
+
+        /// <summary>
+        /// The port for the gdb server
+        /// </summary>
+        [Input("gdbPort")]
+        public int? GdbPort { get; set; }
+
+        /// <summary>
+        /// The GPU type
+        /// </summary>
+        [Input("gpuType")]
+        public string? GpuType { get; set; }
+
+        /// <summary>
+        /// The number of GPUs
+        /// </summary>
+        [Input("gpus")]
+        public int? Gpus { get; set; }
+
+        /// <summary>
+        /// The instance group
+        /// </summary>
+        [Input("instanceGroup")]
+        public string? InstanceGroup { get; set; }
+
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        [Input("instanceName")]
+        public string? InstanceName { get; set; }
+
+        /// <summary>
+        /// The static IP address
+        /// </summary>
+        [Input("ipAddress")]
+        public string? IpAddress { get; set; }
+
+        /// <summary>
+        /// The static IPv6 address
+        /// </summary>
+        [Input("ipv6Address")]
+        public string? Ipv6Address { get; set; }
+
+        /// <summary>
+        /// The amount of memory, optionally suffixed with 'M' or 'G'
+        /// </summary>
+        [Input("memory")]
+        public string? Memory { get; set; }
+
+        /// <summary>
+        /// The management port for QMP access (onprem)
+        /// </summary>
+        [Input("mgmt")]
+        public string? Mgmt { get; set; }
+
+        [Input("mounts")]
+        private List<string>? _mounts;
+
+        /// <summary>
+        /// Volumes to mount, in the form '&lt;volume&gt;:&lt;path&gt;'
+        /// </summary>
+        public List<string> Mounts
+        {
+            get => _mounts ?? (_mounts = new List<string>());
+            set => _mounts = value;
+        }
+
+        /// <summary>
+        /// The network mask
+        /// </summary>
+        [Input("netMask")]
+        public string? NetMask { get; set; }
+
+        [Input("nics")]
+        private List<Inputs.OpsNic>? _nics;
+
+        /// <summary>
+        /// Pre-configured network cards (proxmox)
+        /// </summary>
+        public List<Inputs.OpsNic> Nics
+        {
+            get => _nics ?? (_nics = new List<Inputs.OpsNic>());
+            set => _nics = value;
+        }
+
+        [Input("ports")]
+        private List<string>? _ports;
+
+        /// <summary>
+        /// The TCP ports to expose
+        /// </summary>
+        public List<string> Ports
+        {
+            get => _ports ?? (_ports = new List<string>());
+            set => _ports = value;
+        }
+
+        /// <summary>
+        /// If the QMP interface should be enabled (onprem)
+        /// </summary>
+        [Input("qmp")]
+        public bool? Qmp { get; set; }
+
+        /// <summary>
+        /// If debug messages should be shown
+        /// </summary>
+        [Input("showDebug")]
+        public bool? ShowDebug { get; set; }
+
+        /// <summary>
+        /// If errors should be shown
+        /// </summary>
+        [Input("showErrors")]
+        public bool? ShowErrors { get; set; }
+
+        /// <summary>
+        /// If warnings should be shown
+        /// </summary>
+        [Input("showWarnings")]
+        public bool? ShowWarnings { get; set; }
+
+        /// <summary>
+        /// The name of the tap device
+        /// </summary>
+        [Input("tapName")]
+        public string? TapName { get; set; }
+
+        /// <summary>
+        /// The number of threads per physical core
+        /// </summary>
+        [Input("threadsPerCore")]
+        public int? ThreadsPerCore { get; set; }
+
+        [Input("udpPorts")]
+        private List<string>? _udpPorts;
+
+        /// <summary>
+        /// The UDP ports to expose
+        /// </summary>
+        public List<string> UdpPorts
+        {
+            get => _udpPorts ?? (_udpPorts = new List<string>());
+            set => _udpPorts = value;
+        }
+
+        /// <summary>
+        /// If verbose logging should be enabled
+        /// </summary>
+        [Input("verbose")]
+        public bool? Verbose { get; set; }
+
+        /// <summary>
+        /// If a VGA output device should be emulated
+        /// </summary>
+        [Input("vga")]
+        public bool? Vga { get; set; }
+
+        /// <summary>
+        /// The volume size in GB (openstack)
+        /// </summary>
+        [Input("volumeSizeInGb")]
+        public int? VolumeSizeInGb { get; set; }
+
+        public OpsRunConfig()
+        {
+        }
+        public static new OpsRunConfig Empty => new OpsRunConfig();
+    }
+}
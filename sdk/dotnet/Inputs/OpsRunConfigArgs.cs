@@ -0,0 +1,246 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// The runtime configuration
+    /// </summary>
+    public sealed class OpsRunConfigArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// If hardware acceleration should be enabled
+        /// </summary>
+        [Input("accel")]
+        public Input<bool>? Accel { get; set; }
+
+        /// <summary>
+        /// A hook to run after the instance stops
+        /// </summary>
+        [Input("atExit")]
+        public Input<string>? AtExit { get; set; }
+
+        /// <summary>
+        /// If the volumes in mounts should be attached when the instance is created
+        /// </summary>
+        [Input("attachVolumeOnInstanceCreate")]
+        public Input<bool>? AttachVolumeOnInstanceCreate { get; set; }
+
+        /// <summary>
+        /// The IP address of the bridge
+        /// </summary>
+        [Input("bridgeIPAddress")]
+        public Input<string>? BridgeIPAddress { get; set; }
+
+        /// <summary>
+        /// The name of the bridge
+        /// </summary>
+        [Input("bridgeName")]
+        public Input<string>? BridgeName { get; set; }
+
+        /// <summary>
+        /// If bridged networking should be used
+        /// </summary>
+        [Input("bridged")]
+        public Input<bool>? Bridged { get; set; }
+
+        /// <summary>
+        /// If IP forwarding should be enabled (gcp)
+        /// </summary>
+        [Input("canIPForward")]
+        public Input<bool>? CanIPForward { get; set; }
+
+        /// <summary>
+        /// The number of CPU cores
+        /// </summary>
+        [Input("cpus")]
+        public Input<int>? Cpus { get; set; }
+
+        /// <summary>
+        /// If debugging should be enabled
+        /// </summary>
+        [Input("debug")]
+        public Input<bool>? Debug { get; set; }
+
+        /// <summary>
+        /// The gateway IP address
+        /// </summary>
+        [Input("gateway")]
+        public Input<string>? Gateway { get; set; }
+
+        /// <summary>
+        /// The port for the gdb server
+        /// </summary>
+        [Input("gdbPort")]
+        public Input<int>? GdbPort { get; set; }
+
+        /// <summary>
+        /// The GPU type
+        /// </summary>
+        [Input("gpuType")]
+        public Input<string>? GpuType { get; set; }
+
+        /// <summary>
+        /// The number of GPUs
+        /// </summary>
+        [Input("gpus")]
+        public Input<int>? Gpus { get; set; }
+
+        /// <summary>
+        /// The instance group
+        /// </summary>
+        [Input("instanceGroup")]
+        public Input<string>? InstanceGroup { get; set; }
+
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        [Input("instanceName")]
+        public Input<string>? InstanceName { get; set; }
+
+        /// <summary>
+        /// The static IP address
+        /// </summary>
+        [Input("ipAddress")]
+        public Input<string>? IpAddress { get; set; }
+
+        /// <summary>
+        /// The static IPv6 address
+        /// </summary>
+        [Input("ipv6Address")]
+        public Input<string>? Ipv6Address { get; set; }
+
+        /// <summary>
+        /// The amount of memory, optionally suffixed with 'M' or 'G'
+        /// </summary>
+        [Input("memory")]
+        public Input<string>? Memory { get; set; }
+
+        /// <summary>
+        /// The management port for QMP access (onprem)
+        /// </summary>
+        [Input("mgmt")]
+        public Input<string>? Mgmt { get; set; }
+
+        [Input("mounts")]
+        private InputList<string>? _mounts;
+
+        /// <summary>
+        /// Volumes to mount, in the form '&lt;volume&gt;:&lt;path&gt;'
+        /// </summary>
+        public InputList<string> Mounts
+        {
+            get => _mounts ?? (_mounts = new InputList<string>());
+            set => _mounts = value;
+        }
+
+        /// <summary>
+        /// The network mask
+        /// </summary>
+        [Input("netMask")]
+        public Input<string>? NetMask { get; set; }
+
+        [Input("nics")]
+        private InputList<Inputs.OpsNicArgs>? _nics;
+
+        /// <summary>
+        /// Pre-configured network cards (proxmox)
+        /// </summary>
+        public InputList<Inputs.OpsNicArgs> Nics
+        {
+            get => _nics ?? (_nics = new InputList<Inputs.OpsNicArgs>());
+            set => _nics = value;
+        }
+
+        [Input("ports")]
+        private InputList<string>? _ports;
+
+        /// <summary>
+        /// The TCP ports to expose
+        /// </summary>
+        public InputList<string> Ports
+        {
+            get => _ports ?? (_ports = new InputList<string>());
+            set => _ports = value;
+        }
+
+        /// <summary>
+        /// If the QMP interface should be enabled (onprem)
+        /// </summary>
+        [Input("qmp")]
+        public Input<bool>? Qmp { get; set; }
+
+        /// <summary>
+        /// If debug messages should be shown
+        /// </summary>
+        [Input("showDebug")]
+        public Input<bool>? ShowDebug { get; set; }
+
+        /// <summary>
+        /// If errors should be shown
+        /// </summary>
+        [Input("showErrors")]
+        public Input<bool>? ShowErrors { get; set; }
+
+        /// <summary>
+        /// If warnings should be shown
+        /// </summary>
+        [Input("showWarnings")]
+        public Input<bool>? ShowWarnings { get; set; }
+
+        /// <summary>
+        /// The name of the tap device
+        /// </summary>
+        [Input("tapName")]
+        public Input<string>? TapName { get; set; }
+
+        /// <summary>
+        /// The number of threads per physical core
+        /// </summary>
+        [Input("threadsPerCore")]
+        public Input<int>? ThreadsPerCore { get; set; }
+
+        [Input("udpPorts")]
+        private InputList<string>? _udpPorts;
+
+        /// <summary>
+        /// The UDP ports to expose
+        /// </summary>
+        public InputList<string> UdpPorts
+        {
+            get => _udpPorts ?? (_udpPorts = new InputList<string>());
+            set => _udpPorts = value;
+        }
+
+        /// <summary>
+        /// If verbose logging should be enabled
+        /// </summary>
+        [Input("verbose")]
+        public Input<bool>? Verbose { get; set; }
+
+        /// <summary>
+        /// If a VGA output device should be emulated
+        /// </summary>
+        [Input("vga")]
+        public Input<bool>? Vga { get; set; }
+
+        /// <summary>
+        /// The volume size in GB (openstack)
+        /// </summary>
+        [Input("volumeSizeInGb")]
+        public Input<int>? VolumeSizeInGb { get; set; }
+
+        public OpsRunConfigArgs()
+        {
+        }
+        public static new OpsRunConfigArgs Empty => new OpsRunConfigArgs();
+    }
+}
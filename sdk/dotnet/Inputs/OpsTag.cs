@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// A tag (label) for images and instances
+    /// </summary>
+    public sealed class OpsTag : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// If the tag should be used as an image label
+        /// </summary>
+        [Input("imageLabel")]
+        public bool? ImageLabel { get; set; }
+
+        /// <summary>
+        /// If the tag should be used as an instance label
+        /// </summary>
+        [Input("instanceLabel")]
+        public bool? InstanceLabel { get; set; }
+
+        /// <summary>
+        /// If the tag should be used as instance metadata
+        /// </summary>
+        [Input("instanceMetadata")]
+        public bool? InstanceMetadata { get; set; }
+
+        /// <summary>
+        /// If the tag value should be used as an instance network tag
+        /// </summary>
+        [Input("instanceNetwork")]
+        public bool? InstanceNetwork { get; set; }
+
+        /// <summary>
+        /// The tag key
+        /// </summary>
+        [Input("key", required: true)]
+        public string Key { get; set; } = null!;
+
+        /// <summary>
+        /// The tag value
+        /// </summary>
+        [Input("value", required: true)]
+        public string Value { get; set; } = null!;
+
+        public OpsTag()
+        {
+        }
+        public static new OpsTag Empty => new OpsTag();
+    }
+}
@@ -0,0 +1,60 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Inputs
+{
+
+    /// <summary>
+    /// A tag (label) for images and instances
+    /// </summary>
+    public sealed class OpsTagArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// If the tag should be used as an image label
+        /// </summary>
+        [Input("imageLabel")]
+        public Input<bool>? ImageLabel { get; set; }
+
+        /// <summary>
+        /// If the tag should be used as an instance label
+        /// </summary>
+        [Input("instanceLabel")]
+        public Input<bool>? InstanceLabel { get; set; }
+
+        /// <summary>
+        /// If the tag should be used as instance metadata
+        /// </summary>
+        [Input("instanceMetadata")]
+        public Input<bool>? InstanceMetadata { get; set; }
+
+        /// <summary>
+        /// If the tag value should be used as an instance network tag
+        /// </summary>
+        [Input("instanceNetwork")]
+        public Input<bool>? InstanceNetwork { get; set; }
+
+        /// <summary>
+        /// The tag key
+        /// </summary>
+        [Input("key", required: true)]
+        public Input<string> Key { get; set; } = null!;
+
+        /// <summary>
+        /// The tag value
+        /// </summary>
+        [Input("value", required: true)]
+        public Input<string> Value { get; set; } = null!;
+
+        public OpsTagArgs()
+        {
+        }
+        public static new OpsTagArgs Empty => new OpsTagArgs();
+    }
+}
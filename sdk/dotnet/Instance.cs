@@ -34,6 +34,18 @@ namespace Tpjg.Nanovms
         [Output("instanceID")]
         public Output<string> InstanceID { get; private set; } = null!;
 
+        /// <summary>
+        /// The name the instance was given, through the name input or the configuration
+        /// </summary>
+        [Output("name")]
+        public Output<string?> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The prefix the name of the instance was generated from
+        /// </summary>
+        [Output("namePrefix")]
+        public Output<string?> NamePrefix { get; private set; } = null!;
+
         /// <summary>
         /// The provider instance ID
         /// </summary>
@@ -72,7 +84,7 @@ namespace Tpjg.Nanovms
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public Instance(string name, InstanceArgs args, CustomResourceOptions? options = null)
+        public Instance(string name, InstanceArgs? args = null, CustomResourceOptions? options = null)
             : base("nanovms:index:Instance", name, args ?? new InstanceArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -110,10 +122,16 @@ namespace Tpjg.Nanovms
     public sealed class InstanceArgs : global::Pulumi.ResourceArgs
     {
         /// <summary>
-        /// The configuration for the instance
+        /// The number of console log lines to include in the error when the instance does not become ready
         /// </summary>
-        [Input("config", required: true)]
-        public Input<string> Config { get; set; } = null!;
+        [Input("captureLogsOnFailure")]
+        public Input<int>? CaptureLogsOnFailure { get; set; }
+
+        /// <summary>
+        /// The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
 
         /// <summary>
         /// The name of the image to deploy
@@ -121,11 +139,41 @@ namespace Tpjg.Nanovms
         [Input("image")]
         public Input<string>? Image { get; set; }
 
+        /// <summary>
+        /// The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+        /// </summary>
+        [Input("namePrefix")]
+        public Input<string>? NamePrefix { get; set; }
+
+        /// <summary>
+        /// The configuration for the instance
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
         /// <summary>
         /// The provider for the instance
         /// </summary>
-        [Input("provider", required: true)]
-        public Input<string> Provider { get; set; } = null!;
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        /// <summary>
+        /// Wait for the instance to become ready before completing, so its status and IP addresses are known
+        /// </summary>
+        [Input("readiness")]
+        public Input<Inputs.InstanceReadinessArgs>? Readiness { get; set; }
 
         public InstanceArgs()
         {
@@ -0,0 +1,141 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    /// <summary>
+    /// A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with
+    /// </summary>
+    [NanovmsResourceType("nanovms:index:NanosKernel")]
+    public partial class NanosKernel : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The architecture of the release
+        /// </summary>
+        [Output("architecture")]
+        public Output<string> Architecture { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of boot.img, not part of arm64 releases
+        /// </summary>
+        [Output("bootPath")]
+        public Output<string?> BootPath { get; private set; } = null!;
+
+        /// <summary>
+        /// The SHA-256 digest of boot.img
+        /// </summary>
+        [Output("bootSha256")]
+        public Output<string?> BootSha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of kernel.img
+        /// </summary>
+        [Output("kernelPath")]
+        public Output<string> KernelPath { get; private set; } = null!;
+
+        /// <summary>
+        /// The SHA-256 digest of kernel.img
+        /// </summary>
+        [Output("kernelSha256")]
+        public Output<string> KernelSha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory containing the klibs of the release
+        /// </summary>
+        [Output("klibsDir")]
+        public Output<string> KlibsDir { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) the release is downloaded into
+        /// </summary>
+        [Output("opsHome")]
+        public Output<string?> OpsHome { get; private set; } = null!;
+
+        /// <summary>
+        /// The nanos release version
+        /// </summary>
+        [Output("version")]
+        public Output<string> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a NanosKernel resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public NanosKernel(string name, NanosKernelArgs args, CustomResourceOptions? options = null)
+            : base("nanovms:index:NanosKernel", name, args ?? new NanosKernelArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private NanosKernel(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("nanovms:index:NanosKernel", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing NanosKernel resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static NanosKernel Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new NanosKernel(name, id, options);
+        }
+    }
+
+    public sealed class NanosKernelArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        /// </summary>
+        [Input("architecture")]
+        public Input<string>? Architecture { get; set; }
+
+        /// <summary>
+        /// The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+        /// </summary>
+        [Input("expectedKernelSha256")]
+        public Input<string>? ExpectedKernelSha256 { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
+        /// <summary>
+        /// The nanos release version (e.g. '0.1.54')
+        /// </summary>
+        [Input("version", required: true)]
+        public Input<string> Version { get; set; } = null!;
+
+        public NanosKernelArgs()
+        {
+        }
+        public static new NanosKernelArgs Empty => new NanosKernelArgs();
+    }
+}
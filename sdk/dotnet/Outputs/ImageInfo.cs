@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Outputs
+{
+
+    [OutputType]
+    public sealed class ImageInfo
+    {
+        /// <summary>
+        /// The creation time of the image (RFC 3339)
+        /// </summary>
+        public readonly string Created;
+        /// <summary>
+        /// The provider ID of the image
+        /// </summary>
+        public readonly string ImageID;
+        /// <summary>
+        /// The labels of the image
+        /// </summary>
+        public readonly ImmutableArray<string> Labels;
+        /// <summary>
+        /// The name of the image
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The (local) path of the image
+        /// </summary>
+        public readonly string Path;
+        /// <summary>
+        /// The size of the image in bytes
+        /// </summary>
+        public readonly int Size;
+        /// <summary>
+        /// The status of the image
+        /// </summary>
+        public readonly string Status;
+
+        [OutputConstructor]
+        private ImageInfo(
+            string created,
+
+            string imageID,
+
+            ImmutableArray<string> labels,
+
+            string name,
+
+            string path,
+
+            int size,
+
+            string status)
+        {
+            Created = created;
+            ImageID = imageID;
+            Labels = labels;
+            Name = name;
+            Path = path;
+            Size = size;
+            Status = status;
+        }
+    }
+}
@@ -0,0 +1,78 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Outputs
+{
+
+    [OutputType]
+    public sealed class InstanceInfo
+    {
+        /// <summary>
+        /// The creation time of the instance as reported by the cloud provider
+        /// </summary>
+        public readonly string Created;
+        /// <summary>
+        /// The image the instance runs
+        /// </summary>
+        public readonly string Image;
+        /// <summary>
+        /// The name of the instance
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The provider instance ID
+        /// </summary>
+        public readonly string Pid;
+        /// <summary>
+        /// The ports of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Ports;
+        /// <summary>
+        /// The private IP addresses of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Private_ips;
+        /// <summary>
+        /// The public IP addresses of the instance
+        /// </summary>
+        public readonly ImmutableArray<string> Public_ips;
+        /// <summary>
+        /// The status of the instance
+        /// </summary>
+        public readonly string Status;
+
+        [OutputConstructor]
+        private InstanceInfo(
+            string created,
+
+            string image,
+
+            string name,
+
+            string pid,
+
+            ImmutableArray<string> ports,
+
+            ImmutableArray<string> private_ips,
+
+            ImmutableArray<string> public_ips,
+
+            string status)
+        {
+            Created = created;
+            Image = image;
+            Name = name;
+            Pid = pid;
+            Ports = ports;
+            Private_ips = private_ips;
+            Public_ips = public_ips;
+            Status = status;
+        }
+    }
+}
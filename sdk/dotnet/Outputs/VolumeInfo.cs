@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms.Outputs
+{
+
+    [OutputType]
+    public sealed class VolumeInfo
+    {
+        /// <summary>
+        /// The instance the volume is attached to
+        /// </summary>
+        public readonly string AttachedTo;
+        /// <summary>
+        /// The creation time of the volume as reported by the cloud provider
+        /// </summary>
+        public readonly string Created;
+        /// <summary>
+        /// The name of the volume
+        /// </summary>
+        public readonly string Name;
+        /// <summary>
+        /// The (local) path of the volume
+        /// </summary>
+        public readonly string Path;
+        /// <summary>
+        /// The size of the volume as reported by the cloud provider
+        /// </summary>
+        public readonly string Size;
+        /// <summary>
+        /// The status of the volume
+        /// </summary>
+        public readonly string Status;
+        /// <summary>
+        /// The provider ID of the volume
+        /// </summary>
+        public readonly string VolumeID;
+
+        [OutputConstructor]
+        private VolumeInfo(
+            string attachedTo,
+
+            string created,
+
+            string name,
+
+            string path,
+
+            string size,
+
+            string status,
+
+            string volumeID)
+        {
+            AttachedTo = attachedTo;
+            Created = created;
+            Name = name;
+            Path = path;
+            Size = size;
+            Status = status;
+            VolumeID = volumeID;
+        }
+    }
+}
@@ -0,0 +1,225 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    /// <summary>
+    /// A local ops package built from a sysroot directory, to be used by a PackageImage
+    /// </summary>
+    [NanovmsResourceType("nanovms:index:Package")]
+    public partial class Package : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        /// </summary>
+        [Output("architecture")]
+        public Output<string?> Architecture { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the .tar.gz archive of the package
+        /// </summary>
+        [Output("archivePath")]
+        public Output<string> ArchivePath { get; private set; } = null!;
+
+        /// <summary>
+        /// The arguments to start the program with, after the program name
+        /// </summary>
+        [Output("args")]
+        public Output<ImmutableArray<string>> Args { get; private set; } = null!;
+
+        /// <summary>
+        /// The digest of the manifest and all files in the sysroot of the package
+        /// </summary>
+        [Output("contentHash")]
+        public Output<string> ContentHash { get; private set; } = null!;
+
+        /// <summary>
+        /// A description of the package
+        /// </summary>
+        [Output("description")]
+        public Output<string?> Description { get; private set; } = null!;
+
+        /// <summary>
+        /// The environment variables of the program
+        /// </summary>
+        [Output("env")]
+        public Output<ImmutableDictionary<string, string>?> Env { get; private set; } = null!;
+
+        /// <summary>
+        /// The language of the program
+        /// </summary>
+        [Output("language")]
+        public Output<string?> Language { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the package, without version
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Output("opsHome")]
+        public Output<string?> OpsHome { get; private set; } = null!;
+
+        /// <summary>
+        /// The qualified name of the package (name_version), to use as packageName of a PackageImage with localPackage set
+        /// </summary>
+        [Output("packageName")]
+        public Output<string> PackageName { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory of the package in the local packages of the ops home
+        /// </summary>
+        [Output("packagePath")]
+        public Output<string> PackagePath { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        /// </summary>
+        [Output("program")]
+        public Output<string> Program { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory with the files of the package, copied as its sysroot
+        /// </summary>
+        [Output("sysroot")]
+        public Output<string> Sysroot { get; private set; } = null!;
+
+        /// <summary>
+        /// The version of the package
+        /// </summary>
+        [Output("version")]
+        public Output<string> Version { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Package resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Package(string name, PackageArgs args, CustomResourceOptions? options = null)
+            : base("nanovms:index:Package", name, args ?? new PackageArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Package(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("nanovms:index:Package", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Package resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Package Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Package(name, id, options);
+        }
+    }
+
+    public sealed class PackageArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        /// </summary>
+        [Input("architecture")]
+        public Input<string>? Architecture { get; set; }
+
+        [Input("args")]
+        private InputList<string>? _args;
+
+        /// <summary>
+        /// The arguments to start the program with, after the program name
+        /// </summary>
+        public InputList<string> Args
+        {
+            get => _args ?? (_args = new InputList<string>());
+            set => _args = value;
+        }
+
+        /// <summary>
+        /// A description of the package
+        /// </summary>
+        [Input("description")]
+        public Input<string>? Description { get; set; }
+
+        [Input("env")]
+        private InputMap<string>? _env;
+
+        /// <summary>
+        /// The environment variables of the program
+        /// </summary>
+        public InputMap<string> Env
+        {
+            get => _env ?? (_env = new InputMap<string>());
+            set => _env = value;
+        }
+
+        /// <summary>
+        /// The language of the program
+        /// </summary>
+        [Input("language")]
+        public Input<string>? Language { get; set; }
+
+        /// <summary>
+        /// The name of the package, without version
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
+        /// <summary>
+        /// The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        /// </summary>
+        [Input("program", required: true)]
+        public Input<string> Program { get; set; } = null!;
+
+        /// <summary>
+        /// The directory with the files of the package, copied as its sysroot
+        /// </summary>
+        [Input("sysroot", required: true)]
+        public Input<string> Sysroot { get; set; } = null!;
+
+        /// <summary>
+        /// The version of the package
+        /// </summary>
+        [Input("version", required: true)]
+        public Input<string> Version { get; set; } = null!;
+
+        public PackageArgs()
+        {
+        }
+        public static new PackageArgs Empty => new PackageArgs();
+    }
+}
@@ -28,6 +28,18 @@ namespace Tpjg.Nanovms
         [Output("config")]
         public Output<string> Config { get; private set; } = null!;
 
+        /// <summary>
+        /// The digest of the configuration of the container image used
+        /// </summary>
+        [Output("containerDigest")]
+        public Output<string?> ContainerDigest { get; private set; } = null!;
+
+        /// <summary>
+        /// The path to the container image used
+        /// </summary>
+        [Output("containerImage")]
+        public Output<string?> ContainerImage { get; private set; } = null!;
+
         /// <summary>
         /// The name of the built image
         /// </summary>
@@ -40,12 +52,36 @@ namespace Tpjg.Nanovms
         [Output("imagePath")]
         public Output<string> ImagePath { get; private set; } = null!;
 
+        /// <summary>
+        /// The nanos kernel version the image is built with
+        /// </summary>
+        [Output("kernelVersion")]
+        public Output<string?> KernelVersion { get; private set; } = null!;
+
+        /// <summary>
+        /// If the package is a local package
+        /// </summary>
+        [Output("localPackage")]
+        public Output<bool?> LocalPackage { get; private set; } = null!;
+
+        /// <summary>
+        /// The digest of the package.manifest of the package
+        /// </summary>
+        [Output("manifestDigest")]
+        public Output<string?> ManifestDigest { get; private set; } = null!;
+
         /// <summary>
         /// The name of the package used
         /// </summary>
         [Output("packageName")]
         public Output<string> PackageName { get; private set; } = null!;
 
+        /// <summary>
+        /// The path to the package directory used
+        /// </summary>
+        [Output("packagePath")]
+        public Output<string?> PackagePath { get; private set; } = null!;
+
         /// <summary>
         /// The cloud provider of the built image
         /// </summary>
@@ -110,17 +146,35 @@ namespace Tpjg.Nanovms
         public Input<string>? Architecture { get; set; }
 
         /// <summary>
-        /// The configuration as a JSON encoded string
+        /// The configuration as a JSON encoded string, merged on top of opsConfig
         /// </summary>
         [Input("config")]
         public Input<string>? Config { get; set; }
 
+        /// <summary>
+        /// The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+        /// </summary>
+        [Input("containerImage")]
+        public Input<string>? ContainerImage { get; set; }
+
         /// <summary>
         /// If an already existing image should be deleted if it exists
         /// </summary>
         [Input("force")]
         public Input<bool>? Force { get; set; }
 
+        /// <summary>
+        /// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        /// </summary>
+        [Input("kernelVersion")]
+        public Input<string>? KernelVersion { get; set; }
+
+        /// <summary>
+        /// If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+        /// </summary>
+        [Input("localPackage")]
+        public Input<bool>? LocalPackage { get; set; }
+
         /// <summary>
         /// The name of the image
         /// </summary>
@@ -128,16 +182,34 @@ namespace Tpjg.Nanovms
         public Input<string> Name { get; set; } = null!;
 
         /// <summary>
-        /// The name of the package to use (e.g., 'node_v18.7.0')
+        /// The configuration of the image
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
+        /// <summary>
+        /// The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+        /// </summary>
+        [Input("packageName")]
+        public Input<string>? PackageName { get; set; }
+
+        /// <summary>
+        /// The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
         /// </summary>
-        [Input("packageName", required: true)]
-        public Input<string> PackageName { get; set; } = null!;
+        [Input("packagePath")]
+        public Input<string>? PackagePath { get; set; }
 
         /// <summary>
         /// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
         /// </summary>
-        [Input("provider", required: true)]
-        public Input<string> Provider { get; set; } = null!;
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
 
         /// <summary>
         /// If the latest kernel should be used, download it if necessary
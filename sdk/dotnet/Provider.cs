@@ -13,6 +13,37 @@ namespace Tpjg.Nanovms
     [NanovmsResourceType("pulumi:providers:nanovms")]
     public partial class Provider : global::Pulumi.ProviderResource
     {
+        /// <summary>
+        /// The default target architecture of package images (amd64 or arm64)
+        /// </summary>
+        [Output("architecture")]
+        public Output<string?> Architecture { get; private set; } = null!;
+
+        /// <summary>
+        /// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        /// </summary>
+        [Output("defaultProvider")]
+        public Output<string?> DefaultProvider { get; private set; } = null!;
+
+        /// <summary>
+        /// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        /// </summary>
+        [Output("kernelVersion")]
+        public Output<string?> KernelVersion { get; private set; } = null!;
+
+        /// <summary>
+        /// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        /// </summary>
+        [Output("mirrorDir")]
+        public Output<string?> MirrorDir { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        /// </summary>
+        [Output("opsHome")]
+        public Output<string?> OpsHome { get; private set; } = null!;
+
+
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
         /// </summary>
@@ -40,8 +71,52 @@ namespace Tpjg.Nanovms
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// The default target architecture of package images (amd64 or arm64)
+        /// </summary>
+        [Input("architecture")]
+        public Input<string>? Architecture { get; set; }
+
+        /// <summary>
+        /// The default cloud provider settings, merged under the configuration of each resource
+        /// </summary>
+        [Input("cloudConfig", json: true)]
+        public Input<Inputs.OpsCloudConfigArgs>? CloudConfig { get; set; }
+
+        /// <summary>
+        /// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        /// </summary>
+        [Input("defaultProvider")]
+        public Input<string>? DefaultProvider { get; set; }
+
+        /// <summary>
+        /// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        /// </summary>
+        [Input("kernelVersion")]
+        public Input<string>? KernelVersion { get; set; }
+
+        /// <summary>
+        /// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        /// </summary>
+        [Input("mirrorDir")]
+        public Input<string>? MirrorDir { get; set; }
+
+        /// <summary>
+        /// If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        /// </summary>
+        [Input("offline", json: true)]
+        public Input<bool>? Offline { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
         public ProviderArgs()
         {
+            MirrorDir = Utilities.GetEnv("NANOVMS_MIRROR_DIR") ?? "";
+            Offline = Utilities.GetEnvBoolean("NANOVMS_OFFLINE") ?? false;
         }
         public static new ProviderArgs Empty => new ProviderArgs();
     }
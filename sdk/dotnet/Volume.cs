@@ -0,0 +1,195 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    /// <summary>
+    /// A NanoVMs volume resource for persistent data disks
+    /// </summary>
+    [NanovmsResourceType("nanovms:index:Volume")]
+    public partial class Volume : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The instance the volume is attached to
+        /// </summary>
+        [Output("attachedTo")]
+        public Output<string> AttachedTo { get; private set; } = null!;
+
+        /// <summary>
+        /// The configuration used to create the volume as a JSON encoded string
+        /// </summary>
+        [Output("config")]
+        public Output<string> Config { get; private set; } = null!;
+
+        /// <summary>
+        /// The path to the local directory the volume was filled with
+        /// </summary>
+        [Output("data")]
+        public Output<string> Data { get; private set; } = null!;
+
+        /// <summary>
+        /// The provisioned IOPS for the volume
+        /// </summary>
+        [Output("iops")]
+        public Output<int> Iops { get; private set; } = null!;
+
+        /// <summary>
+        /// The name (label) of the volume
+        /// </summary>
+        [Output("name")]
+        public Output<string> Name { get; private set; } = null!;
+
+        /// <summary>
+        /// The local path of the volume (onprem)
+        /// </summary>
+        [Output("path")]
+        public Output<string> Path { get; private set; } = null!;
+
+        /// <summary>
+        /// The cloud provider of the volume
+        /// </summary>
+        [Output("provider")]
+        public Output<string> Provider { get; private set; } = null!;
+
+        /// <summary>
+        /// The size of the volume
+        /// </summary>
+        [Output("size")]
+        public Output<string> Size { get; private set; } = null!;
+
+        /// <summary>
+        /// The status of the volume
+        /// </summary>
+        [Output("status")]
+        public Output<string> Status { get; private set; } = null!;
+
+        /// <summary>
+        /// The provisioned throughput for the volume
+        /// </summary>
+        [Output("throughput")]
+        public Output<int> Throughput { get; private set; } = null!;
+
+        /// <summary>
+        /// The provider specific volume type
+        /// </summary>
+        [Output("typeof")]
+        public Output<string> Typeof { get; private set; } = null!;
+
+        /// <summary>
+        /// The provider ID of the volume (the UUID for onprem volumes)
+        /// </summary>
+        [Output("volumeID")]
+        public Output<string> VolumeID { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a Volume resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public Volume(string name, VolumeArgs args, CustomResourceOptions? options = null)
+            : base("nanovms:index:Volume", name, args ?? new VolumeArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private Volume(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("nanovms:index:Volume", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing Volume resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static Volume Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new Volume(name, id, options);
+        }
+    }
+
+    public sealed class VolumeArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The path to a local directory to fill the volume with, an empty volume is created if not set
+        /// </summary>
+        [Input("data")]
+        public Input<string>? Data { get; set; }
+
+        /// <summary>
+        /// The provisioned IOPS for the volume
+        /// </summary>
+        [Input("iops")]
+        public Input<int>? Iops { get; set; }
+
+        /// <summary>
+        /// The name (label) of the volume
+        /// </summary>
+        [Input("name", required: true)]
+        public Input<string> Name { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as zone and bucket
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
+        /// <summary>
+        /// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        /// <summary>
+        /// The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it
+        /// </summary>
+        [Input("size")]
+        public Input<string>? Size { get; set; }
+
+        /// <summary>
+        /// The provisioned throughput for the volume
+        /// </summary>
+        [Input("throughput")]
+        public Input<int>? Throughput { get; set; }
+
+        /// <summary>
+        /// The provider specific volume type
+        /// </summary>
+        [Input("typeof")]
+        public Input<string>? Typeof { get; set; }
+
+        public VolumeArgs()
+        {
+        }
+        public static new VolumeArgs Empty => new VolumeArgs();
+    }
+}
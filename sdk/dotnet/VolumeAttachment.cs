@@ -0,0 +1,153 @@
+// *** WARNING: this file was generated by pulumi-language-dotnet. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+using Pulumi;
+
+namespace Tpjg.Nanovms
+{
+    /// <summary>
+    /// Attaches a NanoVMs volume to a running instance
+    /// </summary>
+    [NanovmsResourceType("nanovms:index:VolumeAttachment")]
+    public partial class VolumeAttachment : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The persistent disk ID the volume is attached as
+        /// </summary>
+        [Output("attachID")]
+        public Output<int?> AttachID { get; private set; } = null!;
+
+        /// <summary>
+        /// The configuration used to attach the volume as a JSON encoded string
+        /// </summary>
+        [Output("config")]
+        public Output<string> Config { get; private set; } = null!;
+
+        /// <summary>
+        /// The ID (name) of the instance the volume is attached to
+        /// </summary>
+        [Output("instance")]
+        public Output<string> Instance { get; private set; } = null!;
+
+        /// <summary>
+        /// The path the volume is mounted at in the instance
+        /// </summary>
+        [Output("mountPath")]
+        public Output<string> MountPath { get; private set; } = null!;
+
+        /// <summary>
+        /// The cloud provider of the instance and volume
+        /// </summary>
+        [Output("provider")]
+        public Output<string> Provider { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of the attached volume
+        /// </summary>
+        [Output("volume")]
+        public Output<string> Volume { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a VolumeAttachment resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public VolumeAttachment(string name, VolumeAttachmentArgs args, CustomResourceOptions? options = null)
+            : base("nanovms:index:VolumeAttachment", name, args ?? new VolumeAttachmentArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private VolumeAttachment(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("nanovms:index:VolumeAttachment", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing VolumeAttachment resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static VolumeAttachment Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new VolumeAttachment(name, id, options);
+        }
+    }
+
+    public sealed class VolumeAttachmentArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+        /// </summary>
+        [Input("attachID")]
+        public Input<int>? AttachID { get; set; }
+
+        /// <summary>
+        /// The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+        /// </summary>
+        [Input("config")]
+        public Input<string>? Config { get; set; }
+
+        /// <summary>
+        /// The ID (name) of the instance to attach the volume to
+        /// </summary>
+        [Input("instance", required: true)]
+        public Input<string> Instance { get; set; } = null!;
+
+        /// <summary>
+        /// The path the volume is mounted at in the instance, must match the mounts of the image
+        /// </summary>
+        [Input("mountPath", required: true)]
+        public Input<string> MountPath { get; set; } = null!;
+
+        /// <summary>
+        /// The configuration, used for the cloud provider settings such as zone
+        /// </summary>
+        [Input("opsConfig")]
+        public Input<Inputs.OpsConfigArgs>? OpsConfig { get; set; }
+
+        /// <summary>
+        /// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        /// </summary>
+        [Input("opsHome")]
+        public Input<string>? OpsHome { get; set; }
+
+        /// <summary>
+        /// The cloud provider of the instance and volume
+        /// </summary>
+        [Input("provider")]
+        public Input<string>? Provider { get; set; }
+
+        /// <summary>
+        /// The name of the volume to attach
+        /// </summary>
+        [Input("volume", required: true)]
+        public Input<string> Volume { get; set; } = null!;
+
+        public VolumeAttachmentArgs()
+        {
+        }
+        public static new VolumeAttachmentArgs Empty => new VolumeAttachmentArgs();
+    }
+}
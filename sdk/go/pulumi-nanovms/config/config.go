@@ -0,0 +1,63 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// The default target architecture of package images (amd64 or arm64)
+func GetArchitecture(ctx *pulumi.Context) string {
+	return config.Get(ctx, "nanovms:architecture")
+}
+
+// The default cloud provider settings, merged under the configuration of each resource
+func GetCloudConfig(ctx *pulumi.Context) string {
+	return config.Get(ctx, "nanovms:cloudConfig")
+}
+
+// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+func GetDefaultProvider(ctx *pulumi.Context) string {
+	return config.Get(ctx, "nanovms:defaultProvider")
+}
+
+// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+func GetKernelVersion(ctx *pulumi.Context) string {
+	return config.Get(ctx, "nanovms:kernelVersion")
+}
+
+// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+func GetMirrorDir(ctx *pulumi.Context) string {
+	v, err := config.Try(ctx, "nanovms:mirrorDir")
+	if err == nil {
+		return v
+	}
+	var value string
+	if d := internal.GetEnvOrDefault("", nil, "NANOVMS_MIRROR_DIR"); d != nil {
+		value = d.(string)
+	}
+	return value
+}
+
+// If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+func GetOffline(ctx *pulumi.Context) bool {
+	v, err := config.TryBool(ctx, "nanovms:offline")
+	if err == nil {
+		return v
+	}
+	var value bool
+	if d := internal.GetEnvOrDefault(false, internal.ParseEnvBool, "NANOVMS_OFFLINE"); d != nil {
+		value = d.(bool)
+	}
+	return value
+}
+
+// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+func GetOpsHome(ctx *pulumi.Context) string {
+	return config.Get(ctx, "nanovms:opsHome")
+}
@@ -0,0 +1,128 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Looks up an image of a cloud provider by name
+func LookupImage(ctx *pulumi.Context, args *LookupImageArgs, opts ...pulumi.InvokeOption) (*LookupImageResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupImageResult
+	err := ctx.Invoke("nanovms:index:getImage", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupImageArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config *string `pulumi:"config"`
+	// The name of the image
+	Name string `pulumi:"name"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The cloud provider of the image
+	Provider *string `pulumi:"provider"`
+}
+
+type LookupImageResult struct {
+	// The creation time of the image (RFC 3339)
+	Created string `pulumi:"created"`
+	// The provider ID of the image
+	ImageID string `pulumi:"imageID"`
+	// The labels of the image
+	Labels []string `pulumi:"labels"`
+	// The name of the image
+	Name string `pulumi:"name"`
+	// The (local) path of the image
+	Path string `pulumi:"path"`
+	// The size of the image in bytes
+	Size int `pulumi:"size"`
+	// The status of the image
+	Status string `pulumi:"status"`
+}
+
+func LookupImageOutput(ctx *pulumi.Context, args LookupImageOutputArgs, opts ...pulumi.InvokeOption) LookupImageResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupImageResultOutput, error) {
+			args := v.(LookupImageArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getImage", args, LookupImageResultOutput{}, options).(LookupImageResultOutput), nil
+		}).(LookupImageResultOutput)
+}
+
+type LookupImageOutputArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// The name of the image
+	Name pulumi.StringInput `pulumi:"name"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The cloud provider of the image
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (LookupImageOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupImageArgs)(nil)).Elem()
+}
+
+type LookupImageResultOutput struct{ *pulumi.OutputState }
+
+func (LookupImageResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupImageResult)(nil)).Elem()
+}
+
+func (o LookupImageResultOutput) ToLookupImageResultOutput() LookupImageResultOutput {
+	return o
+}
+
+func (o LookupImageResultOutput) ToLookupImageResultOutputWithContext(ctx context.Context) LookupImageResultOutput {
+	return o
+}
+
+// The creation time of the image (RFC 3339)
+func (o LookupImageResultOutput) Created() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupImageResult) string { return v.Created }).(pulumi.StringOutput)
+}
+
+// The provider ID of the image
+func (o LookupImageResultOutput) ImageID() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupImageResult) string { return v.ImageID }).(pulumi.StringOutput)
+}
+
+// The labels of the image
+func (o LookupImageResultOutput) Labels() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupImageResult) []string { return v.Labels }).(pulumi.StringArrayOutput)
+}
+
+// The name of the image
+func (o LookupImageResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupImageResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The (local) path of the image
+func (o LookupImageResultOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupImageResult) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// The size of the image in bytes
+func (o LookupImageResultOutput) Size() pulumi.IntOutput {
+	return o.ApplyT(func(v LookupImageResult) int { return v.Size }).(pulumi.IntOutput)
+}
+
+// The status of the image
+func (o LookupImageResultOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupImageResult) string { return v.Status }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupImageResultOutput{})
+}
@@ -0,0 +1,86 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Lists the images of a cloud provider
+func GetImages(ctx *pulumi.Context, args *GetImagesArgs, opts ...pulumi.InvokeOption) (*GetImagesResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetImagesResult
+	err := ctx.Invoke("nanovms:index:getImages", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetImagesArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config *string `pulumi:"config"`
+	// Only return images matching the filter, the format depends on the cloud provider
+	Filter *string `pulumi:"filter"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The cloud provider to list the images of
+	Provider *string `pulumi:"provider"`
+}
+
+type GetImagesResult struct {
+	// The images
+	Images []ImageInfo `pulumi:"images"`
+}
+
+func GetImagesOutput(ctx *pulumi.Context, args GetImagesOutputArgs, opts ...pulumi.InvokeOption) GetImagesResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetImagesResultOutput, error) {
+			args := v.(GetImagesArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getImages", args, GetImagesResultOutput{}, options).(GetImagesResultOutput), nil
+		}).(GetImagesResultOutput)
+}
+
+type GetImagesOutputArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// Only return images matching the filter, the format depends on the cloud provider
+	Filter pulumi.StringPtrInput `pulumi:"filter"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The cloud provider to list the images of
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (GetImagesOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetImagesArgs)(nil)).Elem()
+}
+
+type GetImagesResultOutput struct{ *pulumi.OutputState }
+
+func (GetImagesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetImagesResult)(nil)).Elem()
+}
+
+func (o GetImagesResultOutput) ToGetImagesResultOutput() GetImagesResultOutput {
+	return o
+}
+
+func (o GetImagesResultOutput) ToGetImagesResultOutputWithContext(ctx context.Context) GetImagesResultOutput {
+	return o
+}
+
+// The images
+func (o GetImagesResultOutput) Images() ImageInfoArrayOutput {
+	return o.ApplyT(func(v GetImagesResult) []ImageInfo { return v.Images }).(ImageInfoArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetImagesResultOutput{})
+}
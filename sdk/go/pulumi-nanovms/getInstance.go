@@ -0,0 +1,135 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Looks up an instance of a cloud provider by name
+func LookupInstance(ctx *pulumi.Context, args *LookupInstanceArgs, opts ...pulumi.InvokeOption) (*LookupInstanceResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupInstanceResult
+	err := ctx.Invoke("nanovms:index:getInstance", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupInstanceArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config *string `pulumi:"config"`
+	// The name of the instance
+	Name string `pulumi:"name"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The cloud provider of the instance
+	Provider *string `pulumi:"provider"`
+}
+
+type LookupInstanceResult struct {
+	// The creation time of the instance as reported by the cloud provider
+	Created string `pulumi:"created"`
+	// The image the instance runs
+	Image string `pulumi:"image"`
+	// The name of the instance
+	Name string `pulumi:"name"`
+	// The provider instance ID
+	Pid string `pulumi:"pid"`
+	// The ports of the instance
+	Ports []string `pulumi:"ports"`
+	// The private IP addresses of the instance
+	Private_ips []string `pulumi:"private_ips"`
+	// The public IP addresses of the instance
+	Public_ips []string `pulumi:"public_ips"`
+	// The status of the instance
+	Status string `pulumi:"status"`
+}
+
+func LookupInstanceOutput(ctx *pulumi.Context, args LookupInstanceOutputArgs, opts ...pulumi.InvokeOption) LookupInstanceResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (LookupInstanceResultOutput, error) {
+			args := v.(LookupInstanceArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getInstance", args, LookupInstanceResultOutput{}, options).(LookupInstanceResultOutput), nil
+		}).(LookupInstanceResultOutput)
+}
+
+type LookupInstanceOutputArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// The name of the instance
+	Name pulumi.StringInput `pulumi:"name"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The cloud provider of the instance
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (LookupInstanceOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupInstanceArgs)(nil)).Elem()
+}
+
+type LookupInstanceResultOutput struct{ *pulumi.OutputState }
+
+func (LookupInstanceResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupInstanceResult)(nil)).Elem()
+}
+
+func (o LookupInstanceResultOutput) ToLookupInstanceResultOutput() LookupInstanceResultOutput {
+	return o
+}
+
+func (o LookupInstanceResultOutput) ToLookupInstanceResultOutputWithContext(ctx context.Context) LookupInstanceResultOutput {
+	return o
+}
+
+// The creation time of the instance as reported by the cloud provider
+func (o LookupInstanceResultOutput) Created() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupInstanceResult) string { return v.Created }).(pulumi.StringOutput)
+}
+
+// The image the instance runs
+func (o LookupInstanceResultOutput) Image() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupInstanceResult) string { return v.Image }).(pulumi.StringOutput)
+}
+
+// The name of the instance
+func (o LookupInstanceResultOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupInstanceResult) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The provider instance ID
+func (o LookupInstanceResultOutput) Pid() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupInstanceResult) string { return v.Pid }).(pulumi.StringOutput)
+}
+
+// The ports of the instance
+func (o LookupInstanceResultOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupInstanceResult) []string { return v.Ports }).(pulumi.StringArrayOutput)
+}
+
+// The private IP addresses of the instance
+func (o LookupInstanceResultOutput) Private_ips() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupInstanceResult) []string { return v.Private_ips }).(pulumi.StringArrayOutput)
+}
+
+// The public IP addresses of the instance
+func (o LookupInstanceResultOutput) Public_ips() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v LookupInstanceResult) []string { return v.Public_ips }).(pulumi.StringArrayOutput)
+}
+
+// The status of the instance
+func (o LookupInstanceResultOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupInstanceResult) string { return v.Status }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupInstanceResultOutput{})
+}
@@ -0,0 +1,90 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Retrieves the console output of a NanoVMs instance
+func GetInstanceLogs(ctx *pulumi.Context, args *GetInstanceLogsArgs, opts ...pulumi.InvokeOption) (*GetInstanceLogsResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetInstanceLogsResult
+	err := ctx.Invoke("nanovms:index:getInstanceLogs", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetInstanceLogsArgs struct {
+	// The configuration as a JSON encoded string, e.g. the config output of the instance
+	Config *string `pulumi:"config"`
+	// The ID (name) of the instance
+	InstanceID string `pulumi:"instanceID"`
+	// Only return the last number of lines, all output is returned if not set
+	Lines *int `pulumi:"lines"`
+	// The configuration, used for the cloud provider settings such as zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The provider (type) of the instance
+	Provider *string `pulumi:"provider"`
+}
+
+type GetInstanceLogsResult struct {
+	// The console output of the instance
+	Logs string `pulumi:"logs"`
+}
+
+func GetInstanceLogsOutput(ctx *pulumi.Context, args GetInstanceLogsOutputArgs, opts ...pulumi.InvokeOption) GetInstanceLogsResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetInstanceLogsResultOutput, error) {
+			args := v.(GetInstanceLogsArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getInstanceLogs", args, GetInstanceLogsResultOutput{}, options).(GetInstanceLogsResultOutput), nil
+		}).(GetInstanceLogsResultOutput)
+}
+
+type GetInstanceLogsOutputArgs struct {
+	// The configuration as a JSON encoded string, e.g. the config output of the instance
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// The ID (name) of the instance
+	InstanceID pulumi.StringInput `pulumi:"instanceID"`
+	// Only return the last number of lines, all output is returned if not set
+	Lines pulumi.IntPtrInput `pulumi:"lines"`
+	// The configuration, used for the cloud provider settings such as zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The provider (type) of the instance
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (GetInstanceLogsOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstanceLogsArgs)(nil)).Elem()
+}
+
+type GetInstanceLogsResultOutput struct{ *pulumi.OutputState }
+
+func (GetInstanceLogsResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstanceLogsResult)(nil)).Elem()
+}
+
+func (o GetInstanceLogsResultOutput) ToGetInstanceLogsResultOutput() GetInstanceLogsResultOutput {
+	return o
+}
+
+func (o GetInstanceLogsResultOutput) ToGetInstanceLogsResultOutputWithContext(ctx context.Context) GetInstanceLogsResultOutput {
+	return o
+}
+
+// The console output of the instance
+func (o GetInstanceLogsResultOutput) Logs() pulumi.StringOutput {
+	return o.ApplyT(func(v GetInstanceLogsResult) string { return v.Logs }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetInstanceLogsResultOutput{})
+}
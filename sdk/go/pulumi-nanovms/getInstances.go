@@ -0,0 +1,82 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Lists the instances of a cloud provider
+func GetInstances(ctx *pulumi.Context, args *GetInstancesArgs, opts ...pulumi.InvokeOption) (*GetInstancesResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetInstancesResult
+	err := ctx.Invoke("nanovms:index:getInstances", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetInstancesArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config *string `pulumi:"config"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The cloud provider to list the instances of
+	Provider *string `pulumi:"provider"`
+}
+
+type GetInstancesResult struct {
+	// The instances
+	Instances []InstanceInfo `pulumi:"instances"`
+}
+
+func GetInstancesOutput(ctx *pulumi.Context, args GetInstancesOutputArgs, opts ...pulumi.InvokeOption) GetInstancesResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetInstancesResultOutput, error) {
+			args := v.(GetInstancesArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getInstances", args, GetInstancesResultOutput{}, options).(GetInstancesResultOutput), nil
+		}).(GetInstancesResultOutput)
+}
+
+type GetInstancesOutputArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The cloud provider to list the instances of
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (GetInstancesOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstancesArgs)(nil)).Elem()
+}
+
+type GetInstancesResultOutput struct{ *pulumi.OutputState }
+
+func (GetInstancesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetInstancesResult)(nil)).Elem()
+}
+
+func (o GetInstancesResultOutput) ToGetInstancesResultOutput() GetInstancesResultOutput {
+	return o
+}
+
+func (o GetInstancesResultOutput) ToGetInstancesResultOutputWithContext(ctx context.Context) GetInstancesResultOutput {
+	return o
+}
+
+// The instances
+func (o GetInstancesResultOutput) Instances() InstanceInfoArrayOutput {
+	return o.ApplyT(func(v GetInstancesResult) []InstanceInfo { return v.Instances }).(InstanceInfoArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetInstancesResultOutput{})
+}
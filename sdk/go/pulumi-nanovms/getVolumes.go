@@ -0,0 +1,82 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Lists the volumes of a cloud provider
+func GetVolumes(ctx *pulumi.Context, args *GetVolumesArgs, opts ...pulumi.InvokeOption) (*GetVolumesResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv GetVolumesResult
+	err := ctx.Invoke("nanovms:index:getVolumes", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type GetVolumesArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config *string `pulumi:"config"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The cloud provider to list the volumes of
+	Provider *string `pulumi:"provider"`
+}
+
+type GetVolumesResult struct {
+	// The volumes
+	Volumes []VolumeInfo `pulumi:"volumes"`
+}
+
+func GetVolumesOutput(ctx *pulumi.Context, args GetVolumesOutputArgs, opts ...pulumi.InvokeOption) GetVolumesResultOutput {
+	return pulumi.ToOutputWithContext(ctx.Context(), args).
+		ApplyT(func(v interface{}) (GetVolumesResultOutput, error) {
+			args := v.(GetVolumesArgs)
+			options := pulumi.InvokeOutputOptions{InvokeOptions: internal.PkgInvokeDefaultOpts(opts)}
+			return ctx.InvokeOutput("nanovms:index:getVolumes", args, GetVolumesResultOutput{}, options).(GetVolumesResultOutput), nil
+		}).(GetVolumesResultOutput)
+}
+
+type GetVolumesOutputArgs struct {
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	Config pulumi.StringPtrInput `pulumi:"config"`
+	// The configuration, used for the cloud provider settings such as project and zone
+	OpsConfig OpsConfigPtrInput `pulumi:"opsConfig"`
+	// The cloud provider to list the volumes of
+	Provider pulumi.StringPtrInput `pulumi:"provider"`
+}
+
+func (GetVolumesOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetVolumesArgs)(nil)).Elem()
+}
+
+type GetVolumesResultOutput struct{ *pulumi.OutputState }
+
+func (GetVolumesResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GetVolumesResult)(nil)).Elem()
+}
+
+func (o GetVolumesResultOutput) ToGetVolumesResultOutput() GetVolumesResultOutput {
+	return o
+}
+
+func (o GetVolumesResultOutput) ToGetVolumesResultOutputWithContext(ctx context.Context) GetVolumesResultOutput {
+	return o
+}
+
+// The volumes
+func (o GetVolumesResultOutput) Volumes() VolumeInfoArrayOutput {
+	return o.ApplyT(func(v GetVolumesResult) []VolumeInfo { return v.Volumes }).(VolumeInfoArrayOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(GetVolumesResultOutput{})
+}
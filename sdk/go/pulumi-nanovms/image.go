@@ -16,14 +16,22 @@ import (
 type Image struct {
 	pulumi.CustomResourceState
 
+	// The command line of the program in the image, the program name followed by its arguments
+	Args pulumi.StringArrayOutput `pulumi:"args"`
 	// The configuration of the built image as a JSON encoded string
 	Config pulumi.StringOutput `pulumi:"config"`
+	// The digest of the elf and all files and directories included into the image
+	ContentHash pulumi.StringPtrOutput `pulumi:"contentHash"`
 	// The name of the built image
 	ImageName pulumi.StringOutput `pulumi:"imageName"`
 	// The path to the built image
 	ImagePath pulumi.StringOutput `pulumi:"imagePath"`
+	// The nanos kernel version the image is built with
+	KernelVersion pulumi.StringPtrOutput `pulumi:"kernelVersion"`
 	// The cloud provider of the built image
 	Provider pulumi.StringOutput `pulumi:"provider"`
+	// The paths of the dynamic loader and shared libraries added to the image because of includeLdd
+	SharedLibraries pulumi.StringArrayOutput `pulumi:"sharedLibraries"`
 	// If the latest kernel should be used, download it if necessary
 	UseLatestKernel pulumi.BoolOutput `pulumi:"useLatestKernel"`
 }
@@ -41,9 +49,6 @@ func NewImage(ctx *pulumi.Context,
 	if args.Name == nil {
 		return nil, errors.New("invalid value for required argument 'Name'")
 	}
-	if args.Provider == nil {
-		return nil, errors.New("invalid value for required argument 'Provider'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Image
 	err := ctx.RegisterResource("nanovms:index:Image", name, args, &resource, opts...)
@@ -77,32 +82,60 @@ func (ImageState) ElementType() reflect.Type {
 }
 
 type imageArgs struct {
-	// The configuration as a JSON encoded string
+	// The arguments the program is started with, appended to the args of the configuration
+	Args []string `pulumi:"args"`
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
 	Config *string `pulumi:"config"`
 	// The path to the executable file
 	Elf string `pulumi:"elf"`
 	// If an already existing image should be deleted if it exists
 	Force *bool `pulumi:"force"`
+	// If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+	IncludeLdd *bool `pulumi:"includeLdd"`
+	// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+	KernelVersion *string `pulumi:"kernelVersion"`
 	// The name of the image
 	Name string `pulumi:"name"`
+	// The configuration of the image
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
 	// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
-	Provider string `pulumi:"provider"`
+	Provider *string `pulumi:"provider"`
+	// The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+	Sysroot *string `pulumi:"sysroot"`
 	// If the latest kernel should be used, download it if necessary
 	UseLatestKernel *bool `pulumi:"useLatestKernel"`
 }
 
 // The set of arguments for constructing a Image resource.
 type ImageArgs struct {
-	// The configuration as a JSON encoded string
+	// The arguments the program is started with, appended to the args of the configuration
+	Args pulumi.StringArrayInput
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
 	Config pulumi.StringPtrInput
 	// The path to the executable file
 	Elf pulumi.StringInput
 	// If an already existing image should be deleted if it exists
 	Force pulumi.BoolPtrInput
+	// If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+	IncludeLdd pulumi.BoolPtrInput
+	// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+	KernelVersion pulumi.StringPtrInput
 	// The name of the image
 	Name pulumi.StringInput
+	// The configuration of the image
+	OpsConfig OpsConfigPtrInput
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
 	// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
-	Provider pulumi.StringInput
+	Provider pulumi.StringPtrInput
+	// The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+	Sysroot pulumi.StringPtrInput
 	// If the latest kernel should be used, download it if necessary
 	UseLatestKernel pulumi.BoolPtrInput
 }
@@ -194,11 +227,21 @@ func (o ImageOutput) ToImageOutputWithContext(ctx context.Context) ImageOutput {
 	return o
 }
 
+// The command line of the program in the image, the program name followed by its arguments
+func (o ImageOutput) Args() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.Args }).(pulumi.StringArrayOutput)
+}
+
 // The configuration of the built image as a JSON encoded string
 func (o ImageOutput) Config() pulumi.StringOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringOutput { return v.Config }).(pulumi.StringOutput)
 }
 
+// The digest of the elf and all files and directories included into the image
+func (o ImageOutput) ContentHash() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.ContentHash }).(pulumi.StringPtrOutput)
+}
+
 // The name of the built image
 func (o ImageOutput) ImageName() pulumi.StringOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringOutput { return v.ImageName }).(pulumi.StringOutput)
@@ -209,11 +252,21 @@ func (o ImageOutput) ImagePath() pulumi.StringOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringOutput { return v.ImagePath }).(pulumi.StringOutput)
 }
 
+// The nanos kernel version the image is built with
+func (o ImageOutput) KernelVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringPtrOutput { return v.KernelVersion }).(pulumi.StringPtrOutput)
+}
+
 // The cloud provider of the built image
 func (o ImageOutput) Provider() pulumi.StringOutput {
 	return o.ApplyT(func(v *Image) pulumi.StringOutput { return v.Provider }).(pulumi.StringOutput)
 }
 
+// The paths of the dynamic loader and shared libraries added to the image because of includeLdd
+func (o ImageOutput) SharedLibraries() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Image) pulumi.StringArrayOutput { return v.SharedLibraries }).(pulumi.StringArrayOutput)
+}
+
 // If the latest kernel should be used, download it if necessary
 func (o ImageOutput) UseLatestKernel() pulumi.BoolOutput {
 	return o.ApplyT(func(v *Image) pulumi.BoolOutput { return v.UseLatestKernel }).(pulumi.BoolOutput)
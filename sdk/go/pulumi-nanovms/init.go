@@ -25,8 +25,16 @@ func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi
 		r = &Image{}
 	case "nanovms:index:Instance":
 		r = &Instance{}
+	case "nanovms:index:NanosKernel":
+		r = &NanosKernel{}
+	case "nanovms:index:Package":
+		r = &Package{}
 	case "nanovms:index:PackageImage":
 		r = &PackageImage{}
+	case "nanovms:index:Volume":
+		r = &Volume{}
+	case "nanovms:index:VolumeAttachment":
+		r = &VolumeAttachment{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
 	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
 )
@@ -22,6 +21,10 @@ type Instance struct {
 	Image pulumi.StringOutput `pulumi:"image"`
 	// The unique identifier for the instance
 	InstanceID pulumi.StringOutput `pulumi:"instanceID"`
+	// The name the instance was given, through the name input or the configuration
+	Name pulumi.StringPtrOutput `pulumi:"name"`
+	// The prefix the name of the instance was generated from
+	NamePrefix pulumi.StringPtrOutput `pulumi:"namePrefix"`
 	// The provider instance ID
 	Pid pulumi.StringOutput `pulumi:"pid"`
 	// The private IP addresses of the instance
@@ -38,14 +41,11 @@ type Instance struct {
 func NewInstance(ctx *pulumi.Context,
 	name string, args *InstanceArgs, opts ...pulumi.ResourceOption) (*Instance, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &InstanceArgs{}
 	}
 
-	if args.Config == nil {
-		return nil, errors.New("invalid value for required argument 'Config'")
-	}
-	if args.Provider == nil {
-		return nil, errors.New("invalid value for required argument 'Provider'")
+	if args.Readiness != nil {
+		args.Readiness = args.Readiness.ToInstanceReadinessPtrOutput().ApplyT(func(v *InstanceReadiness) *InstanceReadiness { return v.Defaults() }).(InstanceReadinessPtrOutput)
 	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Instance
@@ -80,22 +80,50 @@ func (InstanceState) ElementType() reflect.Type {
 }
 
 type instanceArgs struct {
-	// The configuration for the instance
-	Config string `pulumi:"config"`
+	// The number of console log lines to include in the error when the instance does not become ready
+	CaptureLogsOnFailure *int `pulumi:"captureLogsOnFailure"`
+	// The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
+	Config *string `pulumi:"config"`
 	// The name of the image to deploy
 	Image *string `pulumi:"image"`
+	// The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+	Name *string `pulumi:"name"`
+	// The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+	NamePrefix *string `pulumi:"namePrefix"`
+	// The configuration for the instance
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
 	// The provider for the instance
-	Provider string `pulumi:"provider"`
+	Provider *string `pulumi:"provider"`
+	// Wait for the instance to become ready before completing, so its status and IP addresses are known
+	Readiness *InstanceReadiness `pulumi:"readiness"`
 }
 
 // The set of arguments for constructing a Instance resource.
 type InstanceArgs struct {
-	// The configuration for the instance
-	Config pulumi.StringInput
+	// The number of console log lines to include in the error when the instance does not become ready
+	CaptureLogsOnFailure pulumi.IntPtrInput
+	// The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
+	Config pulumi.StringPtrInput
 	// The name of the image to deploy
 	Image pulumi.StringPtrInput
+	// The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+	Name pulumi.StringPtrInput
+	// The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+	NamePrefix pulumi.StringPtrInput
+	// The configuration for the instance
+	OpsConfig OpsConfigPtrInput
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
 	// The provider for the instance
-	Provider pulumi.StringInput
+	Provider pulumi.StringPtrInput
+	// Wait for the instance to become ready before completing, so its status and IP addresses are known
+	Readiness InstanceReadinessPtrInput
 }
 
 func (InstanceArgs) ElementType() reflect.Type {
@@ -200,6 +228,16 @@ func (o InstanceOutput) InstanceID() pulumi.StringOutput {
 	return o.ApplyT(func(v *Instance) pulumi.StringOutput { return v.InstanceID }).(pulumi.StringOutput)
 }
 
+// The name the instance was given, through the name input or the configuration
+func (o InstanceOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Instance) pulumi.StringPtrOutput { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The prefix the name of the instance was generated from
+func (o InstanceOutput) NamePrefix() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Instance) pulumi.StringPtrOutput { return v.NamePrefix }).(pulumi.StringPtrOutput)
+}
+
 // The provider instance ID
 func (o InstanceOutput) Pid() pulumi.StringOutput {
 	return o.ApplyT(func(v *Instance) pulumi.StringOutput { return v.Pid }).(pulumi.StringOutput)
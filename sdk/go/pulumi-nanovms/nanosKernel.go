@@ -0,0 +1,276 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with
+type NanosKernel struct {
+	pulumi.CustomResourceState
+
+	// The architecture of the release
+	Architecture pulumi.StringOutput `pulumi:"architecture"`
+	// The path of boot.img, not part of arm64 releases
+	BootPath pulumi.StringPtrOutput `pulumi:"bootPath"`
+	// The SHA-256 digest of boot.img
+	BootSha256 pulumi.StringPtrOutput `pulumi:"bootSha256"`
+	// The path of kernel.img
+	KernelPath pulumi.StringOutput `pulumi:"kernelPath"`
+	// The SHA-256 digest of kernel.img
+	KernelSha256 pulumi.StringOutput `pulumi:"kernelSha256"`
+	// The directory containing the klibs of the release
+	KlibsDir pulumi.StringOutput `pulumi:"klibsDir"`
+	// The directory containing the ops home (.ops) the release is downloaded into
+	OpsHome pulumi.StringPtrOutput `pulumi:"opsHome"`
+	// The nanos release version
+	Version pulumi.StringOutput `pulumi:"version"`
+}
+
+// NewNanosKernel registers a new resource with the given unique name, arguments, and options.
+func NewNanosKernel(ctx *pulumi.Context,
+	name string, args *NanosKernelArgs, opts ...pulumi.ResourceOption) (*NanosKernel, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Version == nil {
+		return nil, errors.New("invalid value for required argument 'Version'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource NanosKernel
+	err := ctx.RegisterResource("nanovms:index:NanosKernel", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetNanosKernel gets an existing NanosKernel resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetNanosKernel(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *NanosKernelState, opts ...pulumi.ResourceOption) (*NanosKernel, error) {
+	var resource NanosKernel
+	err := ctx.ReadResource("nanovms:index:NanosKernel", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering NanosKernel resources.
+type nanosKernelState struct {
+}
+
+type NanosKernelState struct {
+}
+
+func (NanosKernelState) ElementType() reflect.Type {
+	return reflect.TypeOf((*nanosKernelState)(nil)).Elem()
+}
+
+type nanosKernelArgs struct {
+	// The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+	Architecture *string `pulumi:"architecture"`
+	// The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+	ExpectedKernelSha256 *string `pulumi:"expectedKernelSha256"`
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
+	// The nanos release version (e.g. '0.1.54')
+	Version string `pulumi:"version"`
+}
+
+// The set of arguments for constructing a NanosKernel resource.
+type NanosKernelArgs struct {
+	// The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+	Architecture pulumi.StringPtrInput
+	// The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+	ExpectedKernelSha256 pulumi.StringPtrInput
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
+	// The nanos release version (e.g. '0.1.54')
+	Version pulumi.StringInput
+}
+
+func (NanosKernelArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*nanosKernelArgs)(nil)).Elem()
+}
+
+type NanosKernelInput interface {
+	pulumi.Input
+
+	ToNanosKernelOutput() NanosKernelOutput
+	ToNanosKernelOutputWithContext(ctx context.Context) NanosKernelOutput
+}
+
+func (*NanosKernel) ElementType() reflect.Type {
+	return reflect.TypeOf((**NanosKernel)(nil)).Elem()
+}
+
+func (i *NanosKernel) ToNanosKernelOutput() NanosKernelOutput {
+	return i.ToNanosKernelOutputWithContext(context.Background())
+}
+
+func (i *NanosKernel) ToNanosKernelOutputWithContext(ctx context.Context) NanosKernelOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NanosKernelOutput)
+}
+
+// NanosKernelArrayInput is an input type that accepts NanosKernelArray and NanosKernelArrayOutput values.
+// You can construct a concrete instance of `NanosKernelArrayInput` via:
+//
+//	NanosKernelArray{ NanosKernelArgs{...} }
+type NanosKernelArrayInput interface {
+	pulumi.Input
+
+	ToNanosKernelArrayOutput() NanosKernelArrayOutput
+	ToNanosKernelArrayOutputWithContext(context.Context) NanosKernelArrayOutput
+}
+
+type NanosKernelArray []NanosKernelInput
+
+func (NanosKernelArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NanosKernel)(nil)).Elem()
+}
+
+func (i NanosKernelArray) ToNanosKernelArrayOutput() NanosKernelArrayOutput {
+	return i.ToNanosKernelArrayOutputWithContext(context.Background())
+}
+
+func (i NanosKernelArray) ToNanosKernelArrayOutputWithContext(ctx context.Context) NanosKernelArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NanosKernelArrayOutput)
+}
+
+// NanosKernelMapInput is an input type that accepts NanosKernelMap and NanosKernelMapOutput values.
+// You can construct a concrete instance of `NanosKernelMapInput` via:
+//
+//	NanosKernelMap{ "key": NanosKernelArgs{...} }
+type NanosKernelMapInput interface {
+	pulumi.Input
+
+	ToNanosKernelMapOutput() NanosKernelMapOutput
+	ToNanosKernelMapOutputWithContext(context.Context) NanosKernelMapOutput
+}
+
+type NanosKernelMap map[string]NanosKernelInput
+
+func (NanosKernelMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NanosKernel)(nil)).Elem()
+}
+
+func (i NanosKernelMap) ToNanosKernelMapOutput() NanosKernelMapOutput {
+	return i.ToNanosKernelMapOutputWithContext(context.Background())
+}
+
+func (i NanosKernelMap) ToNanosKernelMapOutputWithContext(ctx context.Context) NanosKernelMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(NanosKernelMapOutput)
+}
+
+type NanosKernelOutput struct{ *pulumi.OutputState }
+
+func (NanosKernelOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**NanosKernel)(nil)).Elem()
+}
+
+func (o NanosKernelOutput) ToNanosKernelOutput() NanosKernelOutput {
+	return o
+}
+
+func (o NanosKernelOutput) ToNanosKernelOutputWithContext(ctx context.Context) NanosKernelOutput {
+	return o
+}
+
+// The architecture of the release
+func (o NanosKernelOutput) Architecture() pulumi.StringOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringOutput { return v.Architecture }).(pulumi.StringOutput)
+}
+
+// The path of boot.img, not part of arm64 releases
+func (o NanosKernelOutput) BootPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringPtrOutput { return v.BootPath }).(pulumi.StringPtrOutput)
+}
+
+// The SHA-256 digest of boot.img
+func (o NanosKernelOutput) BootSha256() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringPtrOutput { return v.BootSha256 }).(pulumi.StringPtrOutput)
+}
+
+// The path of kernel.img
+func (o NanosKernelOutput) KernelPath() pulumi.StringOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringOutput { return v.KernelPath }).(pulumi.StringOutput)
+}
+
+// The SHA-256 digest of kernel.img
+func (o NanosKernelOutput) KernelSha256() pulumi.StringOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringOutput { return v.KernelSha256 }).(pulumi.StringOutput)
+}
+
+// The directory containing the klibs of the release
+func (o NanosKernelOutput) KlibsDir() pulumi.StringOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringOutput { return v.KlibsDir }).(pulumi.StringOutput)
+}
+
+// The directory containing the ops home (.ops) the release is downloaded into
+func (o NanosKernelOutput) OpsHome() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringPtrOutput { return v.OpsHome }).(pulumi.StringPtrOutput)
+}
+
+// The nanos release version
+func (o NanosKernelOutput) Version() pulumi.StringOutput {
+	return o.ApplyT(func(v *NanosKernel) pulumi.StringOutput { return v.Version }).(pulumi.StringOutput)
+}
+
+type NanosKernelArrayOutput struct{ *pulumi.OutputState }
+
+func (NanosKernelArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*NanosKernel)(nil)).Elem()
+}
+
+func (o NanosKernelArrayOutput) ToNanosKernelArrayOutput() NanosKernelArrayOutput {
+	return o
+}
+
+func (o NanosKernelArrayOutput) ToNanosKernelArrayOutputWithContext(ctx context.Context) NanosKernelArrayOutput {
+	return o
+}
+
+func (o NanosKernelArrayOutput) Index(i pulumi.IntInput) NanosKernelOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *NanosKernel {
+		return vs[0].([]*NanosKernel)[vs[1].(int)]
+	}).(NanosKernelOutput)
+}
+
+type NanosKernelMapOutput struct{ *pulumi.OutputState }
+
+func (NanosKernelMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*NanosKernel)(nil)).Elem()
+}
+
+func (o NanosKernelMapOutput) ToNanosKernelMapOutput() NanosKernelMapOutput {
+	return o
+}
+
+func (o NanosKernelMapOutput) ToNanosKernelMapOutputWithContext(ctx context.Context) NanosKernelMapOutput {
+	return o
+}
+
+func (o NanosKernelMapOutput) MapIndex(k pulumi.StringInput) NanosKernelOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *NanosKernel {
+		return vs[0].(map[string]*NanosKernel)[vs[1].(string)]
+	}).(NanosKernelOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*NanosKernelInput)(nil)).Elem(), &NanosKernel{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NanosKernelArrayInput)(nil)).Elem(), NanosKernelArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*NanosKernelMapInput)(nil)).Elem(), NanosKernelMap{})
+	pulumi.RegisterOutputType(NanosKernelOutput{})
+	pulumi.RegisterOutputType(NanosKernelArrayOutput{})
+	pulumi.RegisterOutputType(NanosKernelMapOutput{})
+}
@@ -20,12 +20,24 @@ type PackageImage struct {
 	Architecture pulumi.StringOutput `pulumi:"architecture"`
 	// The configuration of the built image as a JSON encoded string
 	Config pulumi.StringOutput `pulumi:"config"`
+	// The digest of the configuration of the container image used
+	ContainerDigest pulumi.StringPtrOutput `pulumi:"containerDigest"`
+	// The path to the container image used
+	ContainerImage pulumi.StringPtrOutput `pulumi:"containerImage"`
 	// The name of the built image
 	ImageName pulumi.StringOutput `pulumi:"imageName"`
 	// The path to the built image
 	ImagePath pulumi.StringOutput `pulumi:"imagePath"`
+	// The nanos kernel version the image is built with
+	KernelVersion pulumi.StringPtrOutput `pulumi:"kernelVersion"`
+	// If the package is a local package
+	LocalPackage pulumi.BoolPtrOutput `pulumi:"localPackage"`
+	// The digest of the package.manifest of the package
+	ManifestDigest pulumi.StringPtrOutput `pulumi:"manifestDigest"`
 	// The name of the package used
 	PackageName pulumi.StringOutput `pulumi:"packageName"`
+	// The path to the package directory used
+	PackagePath pulumi.StringPtrOutput `pulumi:"packagePath"`
 	// The cloud provider of the built image
 	Provider pulumi.StringOutput `pulumi:"provider"`
 	// If the latest kernel should be used, download it if necessary
@@ -42,12 +54,6 @@ func NewPackageImage(ctx *pulumi.Context,
 	if args.Name == nil {
 		return nil, errors.New("invalid value for required argument 'Name'")
 	}
-	if args.PackageName == nil {
-		return nil, errors.New("invalid value for required argument 'PackageName'")
-	}
-	if args.Provider == nil {
-		return nil, errors.New("invalid value for required argument 'Provider'")
-	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource PackageImage
 	err := ctx.RegisterResource("nanovms:index:PackageImage", name, args, &resource, opts...)
@@ -83,16 +89,30 @@ func (PackageImageState) ElementType() reflect.Type {
 type packageImageArgs struct {
 	// The target architecture (amd64 or arm64). If not specified, uses the current system architecture
 	Architecture *string `pulumi:"architecture"`
-	// The configuration as a JSON encoded string
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
 	Config *string `pulumi:"config"`
+	// The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+	ContainerImage *string `pulumi:"containerImage"`
 	// If an already existing image should be deleted if it exists
 	Force *bool `pulumi:"force"`
+	// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+	KernelVersion *string `pulumi:"kernelVersion"`
+	// If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+	LocalPackage *bool `pulumi:"localPackage"`
 	// The name of the image
 	Name string `pulumi:"name"`
-	// The name of the package to use (e.g., 'node_v18.7.0')
-	PackageName string `pulumi:"packageName"`
+	// The configuration of the image
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
+	// The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+	PackageName *string `pulumi:"packageName"`
+	// The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
+	PackagePath *string `pulumi:"packagePath"`
 	// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
-	Provider string `pulumi:"provider"`
+	Provider *string `pulumi:"provider"`
 	// If the latest kernel should be used, download it if necessary
 	UseLatestKernel *bool `pulumi:"useLatestKernel"`
 }
@@ -101,16 +121,30 @@ type packageImageArgs struct {
 type PackageImageArgs struct {
 	// The target architecture (amd64 or arm64). If not specified, uses the current system architecture
 	Architecture pulumi.StringPtrInput
-	// The configuration as a JSON encoded string
+	// The configuration as a JSON encoded string, merged on top of opsConfig
+	//
+	// Deprecated: use opsConfig instead
 	Config pulumi.StringPtrInput
+	// The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+	ContainerImage pulumi.StringPtrInput
 	// If an already existing image should be deleted if it exists
 	Force pulumi.BoolPtrInput
+	// The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+	KernelVersion pulumi.StringPtrInput
+	// If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+	LocalPackage pulumi.BoolPtrInput
 	// The name of the image
 	Name pulumi.StringInput
-	// The name of the package to use (e.g., 'node_v18.7.0')
-	PackageName pulumi.StringInput
+	// The configuration of the image
+	OpsConfig OpsConfigPtrInput
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
+	// The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+	PackageName pulumi.StringPtrInput
+	// The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
+	PackagePath pulumi.StringPtrInput
 	// The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
-	Provider pulumi.StringInput
+	Provider pulumi.StringPtrInput
 	// If the latest kernel should be used, download it if necessary
 	UseLatestKernel pulumi.BoolPtrInput
 }
@@ -212,6 +246,16 @@ func (o PackageImageOutput) Config() pulumi.StringOutput {
 	return o.ApplyT(func(v *PackageImage) pulumi.StringOutput { return v.Config }).(pulumi.StringOutput)
 }
 
+// The digest of the configuration of the container image used
+func (o PackageImageOutput) ContainerDigest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.StringPtrOutput { return v.ContainerDigest }).(pulumi.StringPtrOutput)
+}
+
+// The path to the container image used
+func (o PackageImageOutput) ContainerImage() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.StringPtrOutput { return v.ContainerImage }).(pulumi.StringPtrOutput)
+}
+
 // The name of the built image
 func (o PackageImageOutput) ImageName() pulumi.StringOutput {
 	return o.ApplyT(func(v *PackageImage) pulumi.StringOutput { return v.ImageName }).(pulumi.StringOutput)
@@ -222,11 +266,31 @@ func (o PackageImageOutput) ImagePath() pulumi.StringOutput {
 	return o.ApplyT(func(v *PackageImage) pulumi.StringOutput { return v.ImagePath }).(pulumi.StringOutput)
 }
 
+// The nanos kernel version the image is built with
+func (o PackageImageOutput) KernelVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.StringPtrOutput { return v.KernelVersion }).(pulumi.StringPtrOutput)
+}
+
+// If the package is a local package
+func (o PackageImageOutput) LocalPackage() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.BoolPtrOutput { return v.LocalPackage }).(pulumi.BoolPtrOutput)
+}
+
+// The digest of the package.manifest of the package
+func (o PackageImageOutput) ManifestDigest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.StringPtrOutput { return v.ManifestDigest }).(pulumi.StringPtrOutput)
+}
+
 // The name of the package used
 func (o PackageImageOutput) PackageName() pulumi.StringOutput {
 	return o.ApplyT(func(v *PackageImage) pulumi.StringOutput { return v.PackageName }).(pulumi.StringOutput)
 }
 
+// The path to the package directory used
+func (o PackageImageOutput) PackagePath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *PackageImage) pulumi.StringPtrOutput { return v.PackagePath }).(pulumi.StringPtrOutput)
+}
+
 // The cloud provider of the built image
 func (o PackageImageOutput) Provider() pulumi.StringOutput {
 	return o.ApplyT(func(v *PackageImage) pulumi.StringOutput { return v.Provider }).(pulumi.StringOutput)
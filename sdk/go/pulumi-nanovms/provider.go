@@ -13,6 +13,17 @@ import (
 
 type Provider struct {
 	pulumi.ProviderResourceState
+
+	// The default target architecture of package images (amd64 or arm64)
+	Architecture pulumi.StringPtrOutput `pulumi:"architecture"`
+	// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+	DefaultProvider pulumi.StringPtrOutput `pulumi:"defaultProvider"`
+	// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+	KernelVersion pulumi.StringPtrOutput `pulumi:"kernelVersion"`
+	// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+	MirrorDir pulumi.StringPtrOutput `pulumi:"mirrorDir"`
+	// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+	OpsHome pulumi.StringPtrOutput `pulumi:"opsHome"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -22,6 +33,16 @@ func NewProvider(ctx *pulumi.Context,
 		args = &ProviderArgs{}
 	}
 
+	if args.MirrorDir == nil {
+		if d := internal.GetEnvOrDefault("", nil, "NANOVMS_MIRROR_DIR"); d != nil {
+			args.MirrorDir = pulumi.StringPtr(d.(string))
+		}
+	}
+	if args.Offline == nil {
+		if d := internal.GetEnvOrDefault(false, internal.ParseEnvBool, "NANOVMS_OFFLINE"); d != nil {
+			args.Offline = pulumi.BoolPtr(d.(bool))
+		}
+	}
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:nanovms", name, args, &resource, opts...)
@@ -32,10 +53,38 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// The default target architecture of package images (amd64 or arm64)
+	Architecture *string `pulumi:"architecture"`
+	// The default cloud provider settings, merged under the configuration of each resource
+	CloudConfig *OpsCloudConfig `pulumi:"cloudConfig"`
+	// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+	DefaultProvider *string `pulumi:"defaultProvider"`
+	// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+	KernelVersion *string `pulumi:"kernelVersion"`
+	// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+	MirrorDir *string `pulumi:"mirrorDir"`
+	// If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+	Offline *bool `pulumi:"offline"`
+	// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// The default target architecture of package images (amd64 or arm64)
+	Architecture pulumi.StringPtrInput
+	// The default cloud provider settings, merged under the configuration of each resource
+	CloudConfig OpsCloudConfigPtrInput
+	// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+	DefaultProvider pulumi.StringPtrInput
+	// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+	KernelVersion pulumi.StringPtrInput
+	// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+	MirrorDir pulumi.StringPtrInput
+	// If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+	Offline pulumi.BoolPtrInput
+	// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -75,6 +124,31 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+// The default target architecture of package images (amd64 or arm64)
+func (o ProviderOutput) Architecture() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.Architecture }).(pulumi.StringPtrOutput)
+}
+
+// The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+func (o ProviderOutput) DefaultProvider() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.DefaultProvider }).(pulumi.StringPtrOutput)
+}
+
+// The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+func (o ProviderOutput) KernelVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.KernelVersion }).(pulumi.StringPtrOutput)
+}
+
+// A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+func (o ProviderOutput) MirrorDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.MirrorDir }).(pulumi.StringPtrOutput)
+}
+
+// The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+func (o ProviderOutput) OpsHome() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.OpsHome }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
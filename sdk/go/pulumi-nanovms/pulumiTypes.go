@@ -0,0 +1,2981 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+var _ = internal.GetEnvOrDefault
+
+type ImageInfo struct {
+	// The creation time of the image (RFC 3339)
+	Created string `pulumi:"created"`
+	// The provider ID of the image
+	ImageID string `pulumi:"imageID"`
+	// The labels of the image
+	Labels []string `pulumi:"labels"`
+	// The name of the image
+	Name string `pulumi:"name"`
+	// The (local) path of the image
+	Path string `pulumi:"path"`
+	// The size of the image in bytes
+	Size int `pulumi:"size"`
+	// The status of the image
+	Status string `pulumi:"status"`
+}
+
+type ImageInfoOutput struct{ *pulumi.OutputState }
+
+func (ImageInfoOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ImageInfo)(nil)).Elem()
+}
+
+func (o ImageInfoOutput) ToImageInfoOutput() ImageInfoOutput {
+	return o
+}
+
+func (o ImageInfoOutput) ToImageInfoOutputWithContext(ctx context.Context) ImageInfoOutput {
+	return o
+}
+
+// The creation time of the image (RFC 3339)
+func (o ImageInfoOutput) Created() pulumi.StringOutput {
+	return o.ApplyT(func(v ImageInfo) string { return v.Created }).(pulumi.StringOutput)
+}
+
+// The provider ID of the image
+func (o ImageInfoOutput) ImageID() pulumi.StringOutput {
+	return o.ApplyT(func(v ImageInfo) string { return v.ImageID }).(pulumi.StringOutput)
+}
+
+// The labels of the image
+func (o ImageInfoOutput) Labels() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v ImageInfo) []string { return v.Labels }).(pulumi.StringArrayOutput)
+}
+
+// The name of the image
+func (o ImageInfoOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v ImageInfo) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The (local) path of the image
+func (o ImageInfoOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v ImageInfo) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// The size of the image in bytes
+func (o ImageInfoOutput) Size() pulumi.IntOutput {
+	return o.ApplyT(func(v ImageInfo) int { return v.Size }).(pulumi.IntOutput)
+}
+
+// The status of the image
+func (o ImageInfoOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v ImageInfo) string { return v.Status }).(pulumi.StringOutput)
+}
+
+type ImageInfoArrayOutput struct{ *pulumi.OutputState }
+
+func (ImageInfoArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]ImageInfo)(nil)).Elem()
+}
+
+func (o ImageInfoArrayOutput) ToImageInfoArrayOutput() ImageInfoArrayOutput {
+	return o
+}
+
+func (o ImageInfoArrayOutput) ToImageInfoArrayOutputWithContext(ctx context.Context) ImageInfoArrayOutput {
+	return o
+}
+
+func (o ImageInfoArrayOutput) Index(i pulumi.IntInput) ImageInfoOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) ImageInfo {
+		return vs[0].([]ImageInfo)[vs[1].(int)]
+	}).(ImageInfoOutput)
+}
+
+type InstanceInfo struct {
+	// The creation time of the instance as reported by the cloud provider
+	Created string `pulumi:"created"`
+	// The image the instance runs
+	Image string `pulumi:"image"`
+	// The name of the instance
+	Name string `pulumi:"name"`
+	// The provider instance ID
+	Pid string `pulumi:"pid"`
+	// The ports of the instance
+	Ports []string `pulumi:"ports"`
+	// The private IP addresses of the instance
+	Private_ips []string `pulumi:"private_ips"`
+	// The public IP addresses of the instance
+	Public_ips []string `pulumi:"public_ips"`
+	// The status of the instance
+	Status string `pulumi:"status"`
+}
+
+type InstanceInfoOutput struct{ *pulumi.OutputState }
+
+func (InstanceInfoOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceInfo)(nil)).Elem()
+}
+
+func (o InstanceInfoOutput) ToInstanceInfoOutput() InstanceInfoOutput {
+	return o
+}
+
+func (o InstanceInfoOutput) ToInstanceInfoOutputWithContext(ctx context.Context) InstanceInfoOutput {
+	return o
+}
+
+// The creation time of the instance as reported by the cloud provider
+func (o InstanceInfoOutput) Created() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceInfo) string { return v.Created }).(pulumi.StringOutput)
+}
+
+// The image the instance runs
+func (o InstanceInfoOutput) Image() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceInfo) string { return v.Image }).(pulumi.StringOutput)
+}
+
+// The name of the instance
+func (o InstanceInfoOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceInfo) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The provider instance ID
+func (o InstanceInfoOutput) Pid() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceInfo) string { return v.Pid }).(pulumi.StringOutput)
+}
+
+// The ports of the instance
+func (o InstanceInfoOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceInfo) []string { return v.Ports }).(pulumi.StringArrayOutput)
+}
+
+// The private IP addresses of the instance
+func (o InstanceInfoOutput) Private_ips() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceInfo) []string { return v.Private_ips }).(pulumi.StringArrayOutput)
+}
+
+// The public IP addresses of the instance
+func (o InstanceInfoOutput) Public_ips() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v InstanceInfo) []string { return v.Public_ips }).(pulumi.StringArrayOutput)
+}
+
+// The status of the instance
+func (o InstanceInfoOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v InstanceInfo) string { return v.Status }).(pulumi.StringOutput)
+}
+
+type InstanceInfoArrayOutput struct{ *pulumi.OutputState }
+
+func (InstanceInfoArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]InstanceInfo)(nil)).Elem()
+}
+
+func (o InstanceInfoArrayOutput) ToInstanceInfoArrayOutput() InstanceInfoArrayOutput {
+	return o
+}
+
+func (o InstanceInfoArrayOutput) ToInstanceInfoArrayOutputWithContext(ctx context.Context) InstanceInfoArrayOutput {
+	return o
+}
+
+func (o InstanceInfoArrayOutput) Index(i pulumi.IntInput) InstanceInfoOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) InstanceInfo {
+		return vs[0].([]InstanceInfo)[vs[1].(int)]
+	}).(InstanceInfoOutput)
+}
+
+// Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+type InstanceReadiness struct {
+	// The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+	HttpPath *string `pulumi:"httpPath"`
+	// The time between readiness checks (e.g. '2s')
+	PollInterval *string `pulumi:"pollInterval"`
+	// The TCP port that must accept connections before the instance is considered ready
+	Port *int `pulumi:"port"`
+	// The maximum time to wait for the instance to become ready (e.g. '5m')
+	Timeout *string `pulumi:"timeout"`
+}
+
+// Defaults sets the appropriate defaults for InstanceReadiness
+func (val *InstanceReadiness) Defaults() *InstanceReadiness {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.PollInterval == nil {
+		pollInterval_ := "2s"
+		tmp.PollInterval = &pollInterval_
+	}
+	if tmp.Timeout == nil {
+		timeout_ := "5m"
+		tmp.Timeout = &timeout_
+	}
+	return &tmp
+}
+
+// InstanceReadinessInput is an input type that accepts InstanceReadinessArgs and InstanceReadinessOutput values.
+// You can construct a concrete instance of `InstanceReadinessInput` via:
+//
+//	InstanceReadinessArgs{...}
+type InstanceReadinessInput interface {
+	pulumi.Input
+
+	ToInstanceReadinessOutput() InstanceReadinessOutput
+	ToInstanceReadinessOutputWithContext(context.Context) InstanceReadinessOutput
+}
+
+// Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+type InstanceReadinessArgs struct {
+	// The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+	HttpPath pulumi.StringPtrInput `pulumi:"httpPath"`
+	// The time between readiness checks (e.g. '2s')
+	PollInterval pulumi.StringPtrInput `pulumi:"pollInterval"`
+	// The TCP port that must accept connections before the instance is considered ready
+	Port pulumi.IntPtrInput `pulumi:"port"`
+	// The maximum time to wait for the instance to become ready (e.g. '5m')
+	Timeout pulumi.StringPtrInput `pulumi:"timeout"`
+}
+
+// Defaults sets the appropriate defaults for InstanceReadinessArgs
+func (val *InstanceReadinessArgs) Defaults() *InstanceReadinessArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.PollInterval == nil {
+		tmp.PollInterval = pulumi.StringPtr("2s")
+	}
+	if tmp.Timeout == nil {
+		tmp.Timeout = pulumi.StringPtr("5m")
+	}
+	return &tmp
+}
+func (InstanceReadinessArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceReadiness)(nil)).Elem()
+}
+
+func (i InstanceReadinessArgs) ToInstanceReadinessOutput() InstanceReadinessOutput {
+	return i.ToInstanceReadinessOutputWithContext(context.Background())
+}
+
+func (i InstanceReadinessArgs) ToInstanceReadinessOutputWithContext(ctx context.Context) InstanceReadinessOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceReadinessOutput)
+}
+
+func (i InstanceReadinessArgs) ToInstanceReadinessPtrOutput() InstanceReadinessPtrOutput {
+	return i.ToInstanceReadinessPtrOutputWithContext(context.Background())
+}
+
+func (i InstanceReadinessArgs) ToInstanceReadinessPtrOutputWithContext(ctx context.Context) InstanceReadinessPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceReadinessOutput).ToInstanceReadinessPtrOutputWithContext(ctx)
+}
+
+// InstanceReadinessPtrInput is an input type that accepts InstanceReadinessArgs, InstanceReadinessPtr and InstanceReadinessPtrOutput values.
+// You can construct a concrete instance of `InstanceReadinessPtrInput` via:
+//
+//	        InstanceReadinessArgs{...}
+//
+//	or:
+//
+//	        nil
+type InstanceReadinessPtrInput interface {
+	pulumi.Input
+
+	ToInstanceReadinessPtrOutput() InstanceReadinessPtrOutput
+	ToInstanceReadinessPtrOutputWithContext(context.Context) InstanceReadinessPtrOutput
+}
+
+type instanceReadinessPtrType InstanceReadinessArgs
+
+func InstanceReadinessPtr(v *InstanceReadinessArgs) InstanceReadinessPtrInput {
+	return (*instanceReadinessPtrType)(v)
+}
+
+func (*instanceReadinessPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceReadiness)(nil)).Elem()
+}
+
+func (i *instanceReadinessPtrType) ToInstanceReadinessPtrOutput() InstanceReadinessPtrOutput {
+	return i.ToInstanceReadinessPtrOutputWithContext(context.Background())
+}
+
+func (i *instanceReadinessPtrType) ToInstanceReadinessPtrOutputWithContext(ctx context.Context) InstanceReadinessPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(InstanceReadinessPtrOutput)
+}
+
+// Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+type InstanceReadinessOutput struct{ *pulumi.OutputState }
+
+func (InstanceReadinessOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*InstanceReadiness)(nil)).Elem()
+}
+
+func (o InstanceReadinessOutput) ToInstanceReadinessOutput() InstanceReadinessOutput {
+	return o
+}
+
+func (o InstanceReadinessOutput) ToInstanceReadinessOutputWithContext(ctx context.Context) InstanceReadinessOutput {
+	return o
+}
+
+func (o InstanceReadinessOutput) ToInstanceReadinessPtrOutput() InstanceReadinessPtrOutput {
+	return o.ToInstanceReadinessPtrOutputWithContext(context.Background())
+}
+
+func (o InstanceReadinessOutput) ToInstanceReadinessPtrOutputWithContext(ctx context.Context) InstanceReadinessPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v InstanceReadiness) *InstanceReadiness {
+		return &v
+	}).(InstanceReadinessPtrOutput)
+}
+
+// The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+func (o InstanceReadinessOutput) HttpPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceReadiness) *string { return v.HttpPath }).(pulumi.StringPtrOutput)
+}
+
+// The time between readiness checks (e.g. '2s')
+func (o InstanceReadinessOutput) PollInterval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceReadiness) *string { return v.PollInterval }).(pulumi.StringPtrOutput)
+}
+
+// The TCP port that must accept connections before the instance is considered ready
+func (o InstanceReadinessOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v InstanceReadiness) *int { return v.Port }).(pulumi.IntPtrOutput)
+}
+
+// The maximum time to wait for the instance to become ready (e.g. '5m')
+func (o InstanceReadinessOutput) Timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v InstanceReadiness) *string { return v.Timeout }).(pulumi.StringPtrOutput)
+}
+
+type InstanceReadinessPtrOutput struct{ *pulumi.OutputState }
+
+func (InstanceReadinessPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**InstanceReadiness)(nil)).Elem()
+}
+
+func (o InstanceReadinessPtrOutput) ToInstanceReadinessPtrOutput() InstanceReadinessPtrOutput {
+	return o
+}
+
+func (o InstanceReadinessPtrOutput) ToInstanceReadinessPtrOutputWithContext(ctx context.Context) InstanceReadinessPtrOutput {
+	return o
+}
+
+func (o InstanceReadinessPtrOutput) Elem() InstanceReadinessOutput {
+	return o.ApplyT(func(v *InstanceReadiness) InstanceReadiness {
+		if v != nil {
+			return *v
+		}
+		var ret InstanceReadiness
+		return ret
+	}).(InstanceReadinessOutput)
+}
+
+// The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+func (o InstanceReadinessPtrOutput) HttpPath() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceReadiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.HttpPath
+	}).(pulumi.StringPtrOutput)
+}
+
+// The time between readiness checks (e.g. '2s')
+func (o InstanceReadinessPtrOutput) PollInterval() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceReadiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.PollInterval
+	}).(pulumi.StringPtrOutput)
+}
+
+// The TCP port that must accept connections before the instance is considered ready
+func (o InstanceReadinessPtrOutput) Port() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *InstanceReadiness) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Port
+	}).(pulumi.IntPtrOutput)
+}
+
+// The maximum time to wait for the instance to become ready (e.g. '5m')
+func (o InstanceReadinessPtrOutput) Timeout() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *InstanceReadiness) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Timeout
+	}).(pulumi.StringPtrOutput)
+}
+
+// The cloud provider specific configuration
+type OpsCloudConfig struct {
+	// The bucket to store the image artifacts in
+	BucketName *string `pulumi:"bucketName"`
+	// The bucket namespace, required for oci
+	BucketNamespace *string `pulumi:"bucketNamespace"`
+	// If confidential computing should be enabled
+	ConfidentialVM *bool `pulumi:"confidentialVM"`
+	// The ID of the dedicated host to run on
+	DedicatedHostID *string `pulumi:"dedicatedHostID"`
+	// The domain name to create a DNS record for
+	DomainName *string `pulumi:"domainName"`
+	// If IPv6 should be enabled when creating a VPC
+	EnableIPv6 *bool `pulumi:"enableIPv6"`
+	// The instance flavor or machine type
+	Flavor *string `pulumi:"flavor"`
+	// The image type
+	ImageType *string `pulumi:"imageType"`
+	// The IAM instance profile (aws)
+	InstanceProfile *string `pulumi:"instanceProfile"`
+	// The KMS key to encrypt images with, 'default' or an arn (aws)
+	Kms *string `pulumi:"kms"`
+	// The cloud platform
+	Platform *string `pulumi:"platform"`
+	// The project ID (gcp)
+	ProjectID *string `pulumi:"projectID"`
+	// Settings for the root volume
+	RootVolume *OpsCloudVolume `pulumi:"rootVolume"`
+	// The security group
+	SecurityGroup *string `pulumi:"securityGroup"`
+	// Skip verifying that a vm importer role exists (aws)
+	SkipImportVerify *bool `pulumi:"skipImportVerify"`
+	// If spot provisioning should be used
+	Spot *bool `pulumi:"spot"`
+	// The static public IP to assign
+	StaticIP *string `pulumi:"staticIP"`
+	// The subnet
+	Subnet *string `pulumi:"subnet"`
+	// Tags (labels) for images and instances
+	Tags []OpsTag `pulumi:"tags"`
+	// User data passed to the instance
+	UserData *string `pulumi:"userData"`
+	// The VPC
+	Vpc *string `pulumi:"vpc"`
+	// The zone or region
+	Zone *string `pulumi:"zone"`
+}
+
+// OpsCloudConfigInput is an input type that accepts OpsCloudConfigArgs and OpsCloudConfigOutput values.
+// You can construct a concrete instance of `OpsCloudConfigInput` via:
+//
+//	OpsCloudConfigArgs{...}
+type OpsCloudConfigInput interface {
+	pulumi.Input
+
+	ToOpsCloudConfigOutput() OpsCloudConfigOutput
+	ToOpsCloudConfigOutputWithContext(context.Context) OpsCloudConfigOutput
+}
+
+// The cloud provider specific configuration
+type OpsCloudConfigArgs struct {
+	// The bucket to store the image artifacts in
+	BucketName pulumi.StringPtrInput `pulumi:"bucketName"`
+	// The bucket namespace, required for oci
+	BucketNamespace pulumi.StringPtrInput `pulumi:"bucketNamespace"`
+	// If confidential computing should be enabled
+	ConfidentialVM pulumi.BoolPtrInput `pulumi:"confidentialVM"`
+	// The ID of the dedicated host to run on
+	DedicatedHostID pulumi.StringPtrInput `pulumi:"dedicatedHostID"`
+	// The domain name to create a DNS record for
+	DomainName pulumi.StringPtrInput `pulumi:"domainName"`
+	// If IPv6 should be enabled when creating a VPC
+	EnableIPv6 pulumi.BoolPtrInput `pulumi:"enableIPv6"`
+	// The instance flavor or machine type
+	Flavor pulumi.StringPtrInput `pulumi:"flavor"`
+	// The image type
+	ImageType pulumi.StringPtrInput `pulumi:"imageType"`
+	// The IAM instance profile (aws)
+	InstanceProfile pulumi.StringPtrInput `pulumi:"instanceProfile"`
+	// The KMS key to encrypt images with, 'default' or an arn (aws)
+	Kms pulumi.StringPtrInput `pulumi:"kms"`
+	// The cloud platform
+	Platform pulumi.StringPtrInput `pulumi:"platform"`
+	// The project ID (gcp)
+	ProjectID pulumi.StringPtrInput `pulumi:"projectID"`
+	// Settings for the root volume
+	RootVolume OpsCloudVolumePtrInput `pulumi:"rootVolume"`
+	// The security group
+	SecurityGroup pulumi.StringPtrInput `pulumi:"securityGroup"`
+	// Skip verifying that a vm importer role exists (aws)
+	SkipImportVerify pulumi.BoolPtrInput `pulumi:"skipImportVerify"`
+	// If spot provisioning should be used
+	Spot pulumi.BoolPtrInput `pulumi:"spot"`
+	// The static public IP to assign
+	StaticIP pulumi.StringPtrInput `pulumi:"staticIP"`
+	// The subnet
+	Subnet pulumi.StringPtrInput `pulumi:"subnet"`
+	// Tags (labels) for images and instances
+	Tags OpsTagArrayInput `pulumi:"tags"`
+	// User data passed to the instance
+	UserData pulumi.StringPtrInput `pulumi:"userData"`
+	// The VPC
+	Vpc pulumi.StringPtrInput `pulumi:"vpc"`
+	// The zone or region
+	Zone pulumi.StringPtrInput `pulumi:"zone"`
+}
+
+func (OpsCloudConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsCloudConfig)(nil)).Elem()
+}
+
+func (i OpsCloudConfigArgs) ToOpsCloudConfigOutput() OpsCloudConfigOutput {
+	return i.ToOpsCloudConfigOutputWithContext(context.Background())
+}
+
+func (i OpsCloudConfigArgs) ToOpsCloudConfigOutputWithContext(ctx context.Context) OpsCloudConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudConfigOutput)
+}
+
+func (i OpsCloudConfigArgs) ToOpsCloudConfigPtrOutput() OpsCloudConfigPtrOutput {
+	return i.ToOpsCloudConfigPtrOutputWithContext(context.Background())
+}
+
+func (i OpsCloudConfigArgs) ToOpsCloudConfigPtrOutputWithContext(ctx context.Context) OpsCloudConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudConfigOutput).ToOpsCloudConfigPtrOutputWithContext(ctx)
+}
+
+// OpsCloudConfigPtrInput is an input type that accepts OpsCloudConfigArgs, OpsCloudConfigPtr and OpsCloudConfigPtrOutput values.
+// You can construct a concrete instance of `OpsCloudConfigPtrInput` via:
+//
+//	        OpsCloudConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type OpsCloudConfigPtrInput interface {
+	pulumi.Input
+
+	ToOpsCloudConfigPtrOutput() OpsCloudConfigPtrOutput
+	ToOpsCloudConfigPtrOutputWithContext(context.Context) OpsCloudConfigPtrOutput
+}
+
+type opsCloudConfigPtrType OpsCloudConfigArgs
+
+func OpsCloudConfigPtr(v *OpsCloudConfigArgs) OpsCloudConfigPtrInput {
+	return (*opsCloudConfigPtrType)(v)
+}
+
+func (*opsCloudConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsCloudConfig)(nil)).Elem()
+}
+
+func (i *opsCloudConfigPtrType) ToOpsCloudConfigPtrOutput() OpsCloudConfigPtrOutput {
+	return i.ToOpsCloudConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *opsCloudConfigPtrType) ToOpsCloudConfigPtrOutputWithContext(ctx context.Context) OpsCloudConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudConfigPtrOutput)
+}
+
+// The cloud provider specific configuration
+type OpsCloudConfigOutput struct{ *pulumi.OutputState }
+
+func (OpsCloudConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsCloudConfig)(nil)).Elem()
+}
+
+func (o OpsCloudConfigOutput) ToOpsCloudConfigOutput() OpsCloudConfigOutput {
+	return o
+}
+
+func (o OpsCloudConfigOutput) ToOpsCloudConfigOutputWithContext(ctx context.Context) OpsCloudConfigOutput {
+	return o
+}
+
+func (o OpsCloudConfigOutput) ToOpsCloudConfigPtrOutput() OpsCloudConfigPtrOutput {
+	return o.ToOpsCloudConfigPtrOutputWithContext(context.Background())
+}
+
+func (o OpsCloudConfigOutput) ToOpsCloudConfigPtrOutputWithContext(ctx context.Context) OpsCloudConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v OpsCloudConfig) *OpsCloudConfig {
+		return &v
+	}).(OpsCloudConfigPtrOutput)
+}
+
+// The bucket to store the image artifacts in
+func (o OpsCloudConfigOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.BucketName }).(pulumi.StringPtrOutput)
+}
+
+// The bucket namespace, required for oci
+func (o OpsCloudConfigOutput) BucketNamespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.BucketNamespace }).(pulumi.StringPtrOutput)
+}
+
+// If confidential computing should be enabled
+func (o OpsCloudConfigOutput) ConfidentialVM() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *bool { return v.ConfidentialVM }).(pulumi.BoolPtrOutput)
+}
+
+// The ID of the dedicated host to run on
+func (o OpsCloudConfigOutput) DedicatedHostID() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.DedicatedHostID }).(pulumi.StringPtrOutput)
+}
+
+// The domain name to create a DNS record for
+func (o OpsCloudConfigOutput) DomainName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.DomainName }).(pulumi.StringPtrOutput)
+}
+
+// If IPv6 should be enabled when creating a VPC
+func (o OpsCloudConfigOutput) EnableIPv6() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *bool { return v.EnableIPv6 }).(pulumi.BoolPtrOutput)
+}
+
+// The instance flavor or machine type
+func (o OpsCloudConfigOutput) Flavor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Flavor }).(pulumi.StringPtrOutput)
+}
+
+// The image type
+func (o OpsCloudConfigOutput) ImageType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.ImageType }).(pulumi.StringPtrOutput)
+}
+
+// The IAM instance profile (aws)
+func (o OpsCloudConfigOutput) InstanceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.InstanceProfile }).(pulumi.StringPtrOutput)
+}
+
+// The KMS key to encrypt images with, 'default' or an arn (aws)
+func (o OpsCloudConfigOutput) Kms() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Kms }).(pulumi.StringPtrOutput)
+}
+
+// The cloud platform
+func (o OpsCloudConfigOutput) Platform() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Platform }).(pulumi.StringPtrOutput)
+}
+
+// The project ID (gcp)
+func (o OpsCloudConfigOutput) ProjectID() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.ProjectID }).(pulumi.StringPtrOutput)
+}
+
+// Settings for the root volume
+func (o OpsCloudConfigOutput) RootVolume() OpsCloudVolumePtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *OpsCloudVolume { return v.RootVolume }).(OpsCloudVolumePtrOutput)
+}
+
+// The security group
+func (o OpsCloudConfigOutput) SecurityGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.SecurityGroup }).(pulumi.StringPtrOutput)
+}
+
+// Skip verifying that a vm importer role exists (aws)
+func (o OpsCloudConfigOutput) SkipImportVerify() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *bool { return v.SkipImportVerify }).(pulumi.BoolPtrOutput)
+}
+
+// If spot provisioning should be used
+func (o OpsCloudConfigOutput) Spot() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *bool { return v.Spot }).(pulumi.BoolPtrOutput)
+}
+
+// The static public IP to assign
+func (o OpsCloudConfigOutput) StaticIP() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.StaticIP }).(pulumi.StringPtrOutput)
+}
+
+// The subnet
+func (o OpsCloudConfigOutput) Subnet() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Subnet }).(pulumi.StringPtrOutput)
+}
+
+// Tags (labels) for images and instances
+func (o OpsCloudConfigOutput) Tags() OpsTagArrayOutput {
+	return o.ApplyT(func(v OpsCloudConfig) []OpsTag { return v.Tags }).(OpsTagArrayOutput)
+}
+
+// User data passed to the instance
+func (o OpsCloudConfigOutput) UserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.UserData }).(pulumi.StringPtrOutput)
+}
+
+// The VPC
+func (o OpsCloudConfigOutput) Vpc() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Vpc }).(pulumi.StringPtrOutput)
+}
+
+// The zone or region
+func (o OpsCloudConfigOutput) Zone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudConfig) *string { return v.Zone }).(pulumi.StringPtrOutput)
+}
+
+type OpsCloudConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (OpsCloudConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsCloudConfig)(nil)).Elem()
+}
+
+func (o OpsCloudConfigPtrOutput) ToOpsCloudConfigPtrOutput() OpsCloudConfigPtrOutput {
+	return o
+}
+
+func (o OpsCloudConfigPtrOutput) ToOpsCloudConfigPtrOutputWithContext(ctx context.Context) OpsCloudConfigPtrOutput {
+	return o
+}
+
+func (o OpsCloudConfigPtrOutput) Elem() OpsCloudConfigOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) OpsCloudConfig {
+		if v != nil {
+			return *v
+		}
+		var ret OpsCloudConfig
+		return ret
+	}).(OpsCloudConfigOutput)
+}
+
+// The bucket to store the image artifacts in
+func (o OpsCloudConfigPtrOutput) BucketName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BucketName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The bucket namespace, required for oci
+func (o OpsCloudConfigPtrOutput) BucketNamespace() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BucketNamespace
+	}).(pulumi.StringPtrOutput)
+}
+
+// If confidential computing should be enabled
+func (o OpsCloudConfigPtrOutput) ConfidentialVM() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ConfidentialVM
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The ID of the dedicated host to run on
+func (o OpsCloudConfigPtrOutput) DedicatedHostID() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DedicatedHostID
+	}).(pulumi.StringPtrOutput)
+}
+
+// The domain name to create a DNS record for
+func (o OpsCloudConfigPtrOutput) DomainName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.DomainName
+	}).(pulumi.StringPtrOutput)
+}
+
+// If IPv6 should be enabled when creating a VPC
+func (o OpsCloudConfigPtrOutput) EnableIPv6() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.EnableIPv6
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The instance flavor or machine type
+func (o OpsCloudConfigPtrOutput) Flavor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Flavor
+	}).(pulumi.StringPtrOutput)
+}
+
+// The image type
+func (o OpsCloudConfigPtrOutput) ImageType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ImageType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The IAM instance profile (aws)
+func (o OpsCloudConfigPtrOutput) InstanceProfile() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceProfile
+	}).(pulumi.StringPtrOutput)
+}
+
+// The KMS key to encrypt images with, 'default' or an arn (aws)
+func (o OpsCloudConfigPtrOutput) Kms() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Kms
+	}).(pulumi.StringPtrOutput)
+}
+
+// The cloud platform
+func (o OpsCloudConfigPtrOutput) Platform() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Platform
+	}).(pulumi.StringPtrOutput)
+}
+
+// The project ID (gcp)
+func (o OpsCloudConfigPtrOutput) ProjectID() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.ProjectID
+	}).(pulumi.StringPtrOutput)
+}
+
+// Settings for the root volume
+func (o OpsCloudConfigPtrOutput) RootVolume() OpsCloudVolumePtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *OpsCloudVolume {
+		if v == nil {
+			return nil
+		}
+		return v.RootVolume
+	}).(OpsCloudVolumePtrOutput)
+}
+
+// The security group
+func (o OpsCloudConfigPtrOutput) SecurityGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.SecurityGroup
+	}).(pulumi.StringPtrOutput)
+}
+
+// Skip verifying that a vm importer role exists (aws)
+func (o OpsCloudConfigPtrOutput) SkipImportVerify() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.SkipImportVerify
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If spot provisioning should be used
+func (o OpsCloudConfigPtrOutput) Spot() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Spot
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The static public IP to assign
+func (o OpsCloudConfigPtrOutput) StaticIP() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.StaticIP
+	}).(pulumi.StringPtrOutput)
+}
+
+// The subnet
+func (o OpsCloudConfigPtrOutput) Subnet() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Subnet
+	}).(pulumi.StringPtrOutput)
+}
+
+// Tags (labels) for images and instances
+func (o OpsCloudConfigPtrOutput) Tags() OpsTagArrayOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) []OpsTag {
+		if v == nil {
+			return nil
+		}
+		return v.Tags
+	}).(OpsTagArrayOutput)
+}
+
+// User data passed to the instance
+func (o OpsCloudConfigPtrOutput) UserData() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.UserData
+	}).(pulumi.StringPtrOutput)
+}
+
+// The VPC
+func (o OpsCloudConfigPtrOutput) Vpc() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Vpc
+	}).(pulumi.StringPtrOutput)
+}
+
+// The zone or region
+func (o OpsCloudConfigPtrOutput) Zone() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Zone
+	}).(pulumi.StringPtrOutput)
+}
+
+// Cloud volume settings
+type OpsCloudVolume struct {
+	// The provisioned IOPS
+	Iops *int `pulumi:"iops"`
+	// The name of the volume
+	Name *string `pulumi:"name"`
+	// The size of the volume in GB
+	Size *int `pulumi:"size"`
+	// The provisioned throughput
+	Throughput *int `pulumi:"throughput"`
+	// The volume type
+	Typeof *string `pulumi:"typeof"`
+}
+
+// OpsCloudVolumeInput is an input type that accepts OpsCloudVolumeArgs and OpsCloudVolumeOutput values.
+// You can construct a concrete instance of `OpsCloudVolumeInput` via:
+//
+//	OpsCloudVolumeArgs{...}
+type OpsCloudVolumeInput interface {
+	pulumi.Input
+
+	ToOpsCloudVolumeOutput() OpsCloudVolumeOutput
+	ToOpsCloudVolumeOutputWithContext(context.Context) OpsCloudVolumeOutput
+}
+
+// Cloud volume settings
+type OpsCloudVolumeArgs struct {
+	// The provisioned IOPS
+	Iops pulumi.IntPtrInput `pulumi:"iops"`
+	// The name of the volume
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The size of the volume in GB
+	Size pulumi.IntPtrInput `pulumi:"size"`
+	// The provisioned throughput
+	Throughput pulumi.IntPtrInput `pulumi:"throughput"`
+	// The volume type
+	Typeof pulumi.StringPtrInput `pulumi:"typeof"`
+}
+
+func (OpsCloudVolumeArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsCloudVolume)(nil)).Elem()
+}
+
+func (i OpsCloudVolumeArgs) ToOpsCloudVolumeOutput() OpsCloudVolumeOutput {
+	return i.ToOpsCloudVolumeOutputWithContext(context.Background())
+}
+
+func (i OpsCloudVolumeArgs) ToOpsCloudVolumeOutputWithContext(ctx context.Context) OpsCloudVolumeOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudVolumeOutput)
+}
+
+func (i OpsCloudVolumeArgs) ToOpsCloudVolumePtrOutput() OpsCloudVolumePtrOutput {
+	return i.ToOpsCloudVolumePtrOutputWithContext(context.Background())
+}
+
+func (i OpsCloudVolumeArgs) ToOpsCloudVolumePtrOutputWithContext(ctx context.Context) OpsCloudVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudVolumeOutput).ToOpsCloudVolumePtrOutputWithContext(ctx)
+}
+
+// OpsCloudVolumePtrInput is an input type that accepts OpsCloudVolumeArgs, OpsCloudVolumePtr and OpsCloudVolumePtrOutput values.
+// You can construct a concrete instance of `OpsCloudVolumePtrInput` via:
+//
+//	        OpsCloudVolumeArgs{...}
+//
+//	or:
+//
+//	        nil
+type OpsCloudVolumePtrInput interface {
+	pulumi.Input
+
+	ToOpsCloudVolumePtrOutput() OpsCloudVolumePtrOutput
+	ToOpsCloudVolumePtrOutputWithContext(context.Context) OpsCloudVolumePtrOutput
+}
+
+type opsCloudVolumePtrType OpsCloudVolumeArgs
+
+func OpsCloudVolumePtr(v *OpsCloudVolumeArgs) OpsCloudVolumePtrInput {
+	return (*opsCloudVolumePtrType)(v)
+}
+
+func (*opsCloudVolumePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsCloudVolume)(nil)).Elem()
+}
+
+func (i *opsCloudVolumePtrType) ToOpsCloudVolumePtrOutput() OpsCloudVolumePtrOutput {
+	return i.ToOpsCloudVolumePtrOutputWithContext(context.Background())
+}
+
+func (i *opsCloudVolumePtrType) ToOpsCloudVolumePtrOutputWithContext(ctx context.Context) OpsCloudVolumePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsCloudVolumePtrOutput)
+}
+
+// Cloud volume settings
+type OpsCloudVolumeOutput struct{ *pulumi.OutputState }
+
+func (OpsCloudVolumeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsCloudVolume)(nil)).Elem()
+}
+
+func (o OpsCloudVolumeOutput) ToOpsCloudVolumeOutput() OpsCloudVolumeOutput {
+	return o
+}
+
+func (o OpsCloudVolumeOutput) ToOpsCloudVolumeOutputWithContext(ctx context.Context) OpsCloudVolumeOutput {
+	return o
+}
+
+func (o OpsCloudVolumeOutput) ToOpsCloudVolumePtrOutput() OpsCloudVolumePtrOutput {
+	return o.ToOpsCloudVolumePtrOutputWithContext(context.Background())
+}
+
+func (o OpsCloudVolumeOutput) ToOpsCloudVolumePtrOutputWithContext(ctx context.Context) OpsCloudVolumePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v OpsCloudVolume) *OpsCloudVolume {
+		return &v
+	}).(OpsCloudVolumePtrOutput)
+}
+
+// The provisioned IOPS
+func (o OpsCloudVolumeOutput) Iops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsCloudVolume) *int { return v.Iops }).(pulumi.IntPtrOutput)
+}
+
+// The name of the volume
+func (o OpsCloudVolumeOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudVolume) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The size of the volume in GB
+func (o OpsCloudVolumeOutput) Size() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsCloudVolume) *int { return v.Size }).(pulumi.IntPtrOutput)
+}
+
+// The provisioned throughput
+func (o OpsCloudVolumeOutput) Throughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsCloudVolume) *int { return v.Throughput }).(pulumi.IntPtrOutput)
+}
+
+// The volume type
+func (o OpsCloudVolumeOutput) Typeof() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsCloudVolume) *string { return v.Typeof }).(pulumi.StringPtrOutput)
+}
+
+type OpsCloudVolumePtrOutput struct{ *pulumi.OutputState }
+
+func (OpsCloudVolumePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsCloudVolume)(nil)).Elem()
+}
+
+func (o OpsCloudVolumePtrOutput) ToOpsCloudVolumePtrOutput() OpsCloudVolumePtrOutput {
+	return o
+}
+
+func (o OpsCloudVolumePtrOutput) ToOpsCloudVolumePtrOutputWithContext(ctx context.Context) OpsCloudVolumePtrOutput {
+	return o
+}
+
+func (o OpsCloudVolumePtrOutput) Elem() OpsCloudVolumeOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) OpsCloudVolume {
+		if v != nil {
+			return *v
+		}
+		var ret OpsCloudVolume
+		return ret
+	}).(OpsCloudVolumeOutput)
+}
+
+// The provisioned IOPS
+func (o OpsCloudVolumePtrOutput) Iops() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Iops
+	}).(pulumi.IntPtrOutput)
+}
+
+// The name of the volume
+func (o OpsCloudVolumePtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+// The size of the volume in GB
+func (o OpsCloudVolumePtrOutput) Size() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Size
+	}).(pulumi.IntPtrOutput)
+}
+
+// The provisioned throughput
+func (o OpsCloudVolumePtrOutput) Throughput() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Throughput
+	}).(pulumi.IntPtrOutput)
+}
+
+// The volume type
+func (o OpsCloudVolumePtrOutput) Typeof() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsCloudVolume) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Typeof
+	}).(pulumi.StringPtrOutput)
+}
+
+// The nanovms ops configuration, mirrors the ops JSON configuration file
+type OpsConfig struct {
+	// The arguments passed to the program when the image is launched
+	Args []string `pulumi:"args"`
+	// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+	BaseVolumeSz *string `pulumi:"baseVolumeSz"`
+	// The path to the boot image
+	Boot *string `pulumi:"boot"`
+	// The cloud provider specific configuration
+	CloudConfig *OpsCloudConfig `pulumi:"cloudConfig"`
+	// The kernel debug flags
+	Debugflags []string `pulumi:"debugflags"`
+	// The description of the image
+	Description *string `pulumi:"description"`
+	// Local directories to include into the image
+	Dirs []string `pulumi:"dirs"`
+	// Environment variables for the image runtime
+	Env map[string]string `pulumi:"env"`
+	// Local files to include into the image
+	Files []string `pulumi:"files"`
+	// The path to the kernel image
+	Kernel *string `pulumi:"kernel"`
+	// The host location of the klibs
+	KlibDir *string `pulumi:"klibDir"`
+	// The klibs to include into the image (e.g. 'tls', 'ntp')
+	Klibs []string `pulumi:"klibs"`
+	// The language of the program
+	Language *string `pulumi:"language"`
+	// The parent directory of the files and directories specified in files and dirs
+	LocalFilesParentDirectory *string `pulumi:"localFilesParentDirectory"`
+	// Options passed straight through to the image manifest
+	ManifestPassthrough map[string]interface{} `pulumi:"manifestPassthrough"`
+	// Local directories (keys) to map to a path in the image (values)
+	MapDirs map[string]string `pulumi:"mapDirs"`
+	// Volumes (keys) to mount at a path in the image (values)
+	Mounts map[string]string `pulumi:"mounts"`
+	// The DNS servers to use, defaults to '8.8.8.8'
+	NameServers []string `pulumi:"nameServers"`
+	// The nanos kernel version
+	NanosVersion *string `pulumi:"nanosVersion"`
+	// If the nightly kernel build should be used
+	NightlyBuild *bool `pulumi:"nightlyBuild"`
+	// Syscalls to exclude from tracing
+	NoTrace []string `pulumi:"noTrace"`
+	// If the image should reboot automatically when an error occurs
+	RebootOnExit *bool `pulumi:"rebootOnExit"`
+	// The runtime configuration
+	RunConfig *OpsRunConfig `pulumi:"runConfig"`
+	// Configuration specific to the target provider
+	TargetConfig map[string]string `pulumi:"targetConfig"`
+	// The target root filesystem
+	TargetRoot *string `pulumi:"targetRoot"`
+	// If the deprecated TFS version 4 encoding should be used
+	Tfsv4 *bool `pulumi:"tfsv4"`
+	// If the image should support booting via UEFI
+	Uefi *bool `pulumi:"uefi"`
+	// The version of the image
+	Version *string `pulumi:"version"`
+}
+
+// OpsConfigInput is an input type that accepts OpsConfigArgs and OpsConfigOutput values.
+// You can construct a concrete instance of `OpsConfigInput` via:
+//
+//	OpsConfigArgs{...}
+type OpsConfigInput interface {
+	pulumi.Input
+
+	ToOpsConfigOutput() OpsConfigOutput
+	ToOpsConfigOutputWithContext(context.Context) OpsConfigOutput
+}
+
+// The nanovms ops configuration, mirrors the ops JSON configuration file
+type OpsConfigArgs struct {
+	// The arguments passed to the program when the image is launched
+	Args pulumi.StringArrayInput `pulumi:"args"`
+	// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+	BaseVolumeSz pulumi.StringPtrInput `pulumi:"baseVolumeSz"`
+	// The path to the boot image
+	Boot pulumi.StringPtrInput `pulumi:"boot"`
+	// The cloud provider specific configuration
+	CloudConfig OpsCloudConfigPtrInput `pulumi:"cloudConfig"`
+	// The kernel debug flags
+	Debugflags pulumi.StringArrayInput `pulumi:"debugflags"`
+	// The description of the image
+	Description pulumi.StringPtrInput `pulumi:"description"`
+	// Local directories to include into the image
+	Dirs pulumi.StringArrayInput `pulumi:"dirs"`
+	// Environment variables for the image runtime
+	Env pulumi.StringMapInput `pulumi:"env"`
+	// Local files to include into the image
+	Files pulumi.StringArrayInput `pulumi:"files"`
+	// The path to the kernel image
+	Kernel pulumi.StringPtrInput `pulumi:"kernel"`
+	// The host location of the klibs
+	KlibDir pulumi.StringPtrInput `pulumi:"klibDir"`
+	// The klibs to include into the image (e.g. 'tls', 'ntp')
+	Klibs pulumi.StringArrayInput `pulumi:"klibs"`
+	// The language of the program
+	Language pulumi.StringPtrInput `pulumi:"language"`
+	// The parent directory of the files and directories specified in files and dirs
+	LocalFilesParentDirectory pulumi.StringPtrInput `pulumi:"localFilesParentDirectory"`
+	// Options passed straight through to the image manifest
+	ManifestPassthrough pulumi.MapInput `pulumi:"manifestPassthrough"`
+	// Local directories (keys) to map to a path in the image (values)
+	MapDirs pulumi.StringMapInput `pulumi:"mapDirs"`
+	// Volumes (keys) to mount at a path in the image (values)
+	Mounts pulumi.StringMapInput `pulumi:"mounts"`
+	// The DNS servers to use, defaults to '8.8.8.8'
+	NameServers pulumi.StringArrayInput `pulumi:"nameServers"`
+	// The nanos kernel version
+	NanosVersion pulumi.StringPtrInput `pulumi:"nanosVersion"`
+	// If the nightly kernel build should be used
+	NightlyBuild pulumi.BoolPtrInput `pulumi:"nightlyBuild"`
+	// Syscalls to exclude from tracing
+	NoTrace pulumi.StringArrayInput `pulumi:"noTrace"`
+	// If the image should reboot automatically when an error occurs
+	RebootOnExit pulumi.BoolPtrInput `pulumi:"rebootOnExit"`
+	// The runtime configuration
+	RunConfig OpsRunConfigPtrInput `pulumi:"runConfig"`
+	// Configuration specific to the target provider
+	TargetConfig pulumi.StringMapInput `pulumi:"targetConfig"`
+	// The target root filesystem
+	TargetRoot pulumi.StringPtrInput `pulumi:"targetRoot"`
+	// If the deprecated TFS version 4 encoding should be used
+	Tfsv4 pulumi.BoolPtrInput `pulumi:"tfsv4"`
+	// If the image should support booting via UEFI
+	Uefi pulumi.BoolPtrInput `pulumi:"uefi"`
+	// The version of the image
+	Version pulumi.StringPtrInput `pulumi:"version"`
+}
+
+func (OpsConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsConfig)(nil)).Elem()
+}
+
+func (i OpsConfigArgs) ToOpsConfigOutput() OpsConfigOutput {
+	return i.ToOpsConfigOutputWithContext(context.Background())
+}
+
+func (i OpsConfigArgs) ToOpsConfigOutputWithContext(ctx context.Context) OpsConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsConfigOutput)
+}
+
+func (i OpsConfigArgs) ToOpsConfigPtrOutput() OpsConfigPtrOutput {
+	return i.ToOpsConfigPtrOutputWithContext(context.Background())
+}
+
+func (i OpsConfigArgs) ToOpsConfigPtrOutputWithContext(ctx context.Context) OpsConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsConfigOutput).ToOpsConfigPtrOutputWithContext(ctx)
+}
+
+// OpsConfigPtrInput is an input type that accepts OpsConfigArgs, OpsConfigPtr and OpsConfigPtrOutput values.
+// You can construct a concrete instance of `OpsConfigPtrInput` via:
+//
+//	        OpsConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type OpsConfigPtrInput interface {
+	pulumi.Input
+
+	ToOpsConfigPtrOutput() OpsConfigPtrOutput
+	ToOpsConfigPtrOutputWithContext(context.Context) OpsConfigPtrOutput
+}
+
+type opsConfigPtrType OpsConfigArgs
+
+func OpsConfigPtr(v *OpsConfigArgs) OpsConfigPtrInput {
+	return (*opsConfigPtrType)(v)
+}
+
+func (*opsConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsConfig)(nil)).Elem()
+}
+
+func (i *opsConfigPtrType) ToOpsConfigPtrOutput() OpsConfigPtrOutput {
+	return i.ToOpsConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *opsConfigPtrType) ToOpsConfigPtrOutputWithContext(ctx context.Context) OpsConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsConfigPtrOutput)
+}
+
+// The nanovms ops configuration, mirrors the ops JSON configuration file
+type OpsConfigOutput struct{ *pulumi.OutputState }
+
+func (OpsConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsConfig)(nil)).Elem()
+}
+
+func (o OpsConfigOutput) ToOpsConfigOutput() OpsConfigOutput {
+	return o
+}
+
+func (o OpsConfigOutput) ToOpsConfigOutputWithContext(ctx context.Context) OpsConfigOutput {
+	return o
+}
+
+func (o OpsConfigOutput) ToOpsConfigPtrOutput() OpsConfigPtrOutput {
+	return o.ToOpsConfigPtrOutputWithContext(context.Background())
+}
+
+func (o OpsConfigOutput) ToOpsConfigPtrOutputWithContext(ctx context.Context) OpsConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v OpsConfig) *OpsConfig {
+		return &v
+	}).(OpsConfigPtrOutput)
+}
+
+// The arguments passed to the program when the image is launched
+func (o OpsConfigOutput) Args() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.Args }).(pulumi.StringArrayOutput)
+}
+
+// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+func (o OpsConfigOutput) BaseVolumeSz() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.BaseVolumeSz }).(pulumi.StringPtrOutput)
+}
+
+// The path to the boot image
+func (o OpsConfigOutput) Boot() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.Boot }).(pulumi.StringPtrOutput)
+}
+
+// The cloud provider specific configuration
+func (o OpsConfigOutput) CloudConfig() OpsCloudConfigPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *OpsCloudConfig { return v.CloudConfig }).(OpsCloudConfigPtrOutput)
+}
+
+// The kernel debug flags
+func (o OpsConfigOutput) Debugflags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.Debugflags }).(pulumi.StringArrayOutput)
+}
+
+// The description of the image
+func (o OpsConfigOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.Description }).(pulumi.StringPtrOutput)
+}
+
+// Local directories to include into the image
+func (o OpsConfigOutput) Dirs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.Dirs }).(pulumi.StringArrayOutput)
+}
+
+// Environment variables for the image runtime
+func (o OpsConfigOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v OpsConfig) map[string]string { return v.Env }).(pulumi.StringMapOutput)
+}
+
+// Local files to include into the image
+func (o OpsConfigOutput) Files() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.Files }).(pulumi.StringArrayOutput)
+}
+
+// The path to the kernel image
+func (o OpsConfigOutput) Kernel() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.Kernel }).(pulumi.StringPtrOutput)
+}
+
+// The host location of the klibs
+func (o OpsConfigOutput) KlibDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.KlibDir }).(pulumi.StringPtrOutput)
+}
+
+// The klibs to include into the image (e.g. 'tls', 'ntp')
+func (o OpsConfigOutput) Klibs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.Klibs }).(pulumi.StringArrayOutput)
+}
+
+// The language of the program
+func (o OpsConfigOutput) Language() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.Language }).(pulumi.StringPtrOutput)
+}
+
+// The parent directory of the files and directories specified in files and dirs
+func (o OpsConfigOutput) LocalFilesParentDirectory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.LocalFilesParentDirectory }).(pulumi.StringPtrOutput)
+}
+
+// Options passed straight through to the image manifest
+func (o OpsConfigOutput) ManifestPassthrough() pulumi.MapOutput {
+	return o.ApplyT(func(v OpsConfig) map[string]interface{} { return v.ManifestPassthrough }).(pulumi.MapOutput)
+}
+
+// Local directories (keys) to map to a path in the image (values)
+func (o OpsConfigOutput) MapDirs() pulumi.StringMapOutput {
+	return o.ApplyT(func(v OpsConfig) map[string]string { return v.MapDirs }).(pulumi.StringMapOutput)
+}
+
+// Volumes (keys) to mount at a path in the image (values)
+func (o OpsConfigOutput) Mounts() pulumi.StringMapOutput {
+	return o.ApplyT(func(v OpsConfig) map[string]string { return v.Mounts }).(pulumi.StringMapOutput)
+}
+
+// The DNS servers to use, defaults to '8.8.8.8'
+func (o OpsConfigOutput) NameServers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.NameServers }).(pulumi.StringArrayOutput)
+}
+
+// The nanos kernel version
+func (o OpsConfigOutput) NanosVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.NanosVersion }).(pulumi.StringPtrOutput)
+}
+
+// If the nightly kernel build should be used
+func (o OpsConfigOutput) NightlyBuild() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *bool { return v.NightlyBuild }).(pulumi.BoolPtrOutput)
+}
+
+// Syscalls to exclude from tracing
+func (o OpsConfigOutput) NoTrace() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsConfig) []string { return v.NoTrace }).(pulumi.StringArrayOutput)
+}
+
+// If the image should reboot automatically when an error occurs
+func (o OpsConfigOutput) RebootOnExit() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *bool { return v.RebootOnExit }).(pulumi.BoolPtrOutput)
+}
+
+// The runtime configuration
+func (o OpsConfigOutput) RunConfig() OpsRunConfigPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *OpsRunConfig { return v.RunConfig }).(OpsRunConfigPtrOutput)
+}
+
+// Configuration specific to the target provider
+func (o OpsConfigOutput) TargetConfig() pulumi.StringMapOutput {
+	return o.ApplyT(func(v OpsConfig) map[string]string { return v.TargetConfig }).(pulumi.StringMapOutput)
+}
+
+// The target root filesystem
+func (o OpsConfigOutput) TargetRoot() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.TargetRoot }).(pulumi.StringPtrOutput)
+}
+
+// If the deprecated TFS version 4 encoding should be used
+func (o OpsConfigOutput) Tfsv4() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *bool { return v.Tfsv4 }).(pulumi.BoolPtrOutput)
+}
+
+// If the image should support booting via UEFI
+func (o OpsConfigOutput) Uefi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *bool { return v.Uefi }).(pulumi.BoolPtrOutput)
+}
+
+// The version of the image
+func (o OpsConfigOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsConfig) *string { return v.Version }).(pulumi.StringPtrOutput)
+}
+
+type OpsConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (OpsConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsConfig)(nil)).Elem()
+}
+
+func (o OpsConfigPtrOutput) ToOpsConfigPtrOutput() OpsConfigPtrOutput {
+	return o
+}
+
+func (o OpsConfigPtrOutput) ToOpsConfigPtrOutputWithContext(ctx context.Context) OpsConfigPtrOutput {
+	return o
+}
+
+func (o OpsConfigPtrOutput) Elem() OpsConfigOutput {
+	return o.ApplyT(func(v *OpsConfig) OpsConfig {
+		if v != nil {
+			return *v
+		}
+		var ret OpsConfig
+		return ret
+	}).(OpsConfigOutput)
+}
+
+// The arguments passed to the program when the image is launched
+func (o OpsConfigPtrOutput) Args() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Args
+	}).(pulumi.StringArrayOutput)
+}
+
+// The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+func (o OpsConfigPtrOutput) BaseVolumeSz() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BaseVolumeSz
+	}).(pulumi.StringPtrOutput)
+}
+
+// The path to the boot image
+func (o OpsConfigPtrOutput) Boot() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Boot
+	}).(pulumi.StringPtrOutput)
+}
+
+// The cloud provider specific configuration
+func (o OpsConfigPtrOutput) CloudConfig() OpsCloudConfigPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *OpsCloudConfig {
+		if v == nil {
+			return nil
+		}
+		return v.CloudConfig
+	}).(OpsCloudConfigPtrOutput)
+}
+
+// The kernel debug flags
+func (o OpsConfigPtrOutput) Debugflags() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Debugflags
+	}).(pulumi.StringArrayOutput)
+}
+
+// The description of the image
+func (o OpsConfigPtrOutput) Description() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Description
+	}).(pulumi.StringPtrOutput)
+}
+
+// Local directories to include into the image
+func (o OpsConfigPtrOutput) Dirs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Dirs
+	}).(pulumi.StringArrayOutput)
+}
+
+// Environment variables for the image runtime
+func (o OpsConfigPtrOutput) Env() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *OpsConfig) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Env
+	}).(pulumi.StringMapOutput)
+}
+
+// Local files to include into the image
+func (o OpsConfigPtrOutput) Files() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Files
+	}).(pulumi.StringArrayOutput)
+}
+
+// The path to the kernel image
+func (o OpsConfigPtrOutput) Kernel() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Kernel
+	}).(pulumi.StringPtrOutput)
+}
+
+// The host location of the klibs
+func (o OpsConfigPtrOutput) KlibDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.KlibDir
+	}).(pulumi.StringPtrOutput)
+}
+
+// The klibs to include into the image (e.g. 'tls', 'ntp')
+func (o OpsConfigPtrOutput) Klibs() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Klibs
+	}).(pulumi.StringArrayOutput)
+}
+
+// The language of the program
+func (o OpsConfigPtrOutput) Language() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Language
+	}).(pulumi.StringPtrOutput)
+}
+
+// The parent directory of the files and directories specified in files and dirs
+func (o OpsConfigPtrOutput) LocalFilesParentDirectory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.LocalFilesParentDirectory
+	}).(pulumi.StringPtrOutput)
+}
+
+// Options passed straight through to the image manifest
+func (o OpsConfigPtrOutput) ManifestPassthrough() pulumi.MapOutput {
+	return o.ApplyT(func(v *OpsConfig) map[string]interface{} {
+		if v == nil {
+			return nil
+		}
+		return v.ManifestPassthrough
+	}).(pulumi.MapOutput)
+}
+
+// Local directories (keys) to map to a path in the image (values)
+func (o OpsConfigPtrOutput) MapDirs() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *OpsConfig) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.MapDirs
+	}).(pulumi.StringMapOutput)
+}
+
+// Volumes (keys) to mount at a path in the image (values)
+func (o OpsConfigPtrOutput) Mounts() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *OpsConfig) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.Mounts
+	}).(pulumi.StringMapOutput)
+}
+
+// The DNS servers to use, defaults to '8.8.8.8'
+func (o OpsConfigPtrOutput) NameServers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.NameServers
+	}).(pulumi.StringArrayOutput)
+}
+
+// The nanos kernel version
+func (o OpsConfigPtrOutput) NanosVersion() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NanosVersion
+	}).(pulumi.StringPtrOutput)
+}
+
+// If the nightly kernel build should be used
+func (o OpsConfigPtrOutput) NightlyBuild() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.NightlyBuild
+	}).(pulumi.BoolPtrOutput)
+}
+
+// Syscalls to exclude from tracing
+func (o OpsConfigPtrOutput) NoTrace() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.NoTrace
+	}).(pulumi.StringArrayOutput)
+}
+
+// If the image should reboot automatically when an error occurs
+func (o OpsConfigPtrOutput) RebootOnExit() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.RebootOnExit
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The runtime configuration
+func (o OpsConfigPtrOutput) RunConfig() OpsRunConfigPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *OpsRunConfig {
+		if v == nil {
+			return nil
+		}
+		return v.RunConfig
+	}).(OpsRunConfigPtrOutput)
+}
+
+// Configuration specific to the target provider
+func (o OpsConfigPtrOutput) TargetConfig() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *OpsConfig) map[string]string {
+		if v == nil {
+			return nil
+		}
+		return v.TargetConfig
+	}).(pulumi.StringMapOutput)
+}
+
+// The target root filesystem
+func (o OpsConfigPtrOutput) TargetRoot() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TargetRoot
+	}).(pulumi.StringPtrOutput)
+}
+
+// If the deprecated TFS version 4 encoding should be used
+func (o OpsConfigPtrOutput) Tfsv4() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Tfsv4
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If the image should support booting via UEFI
+func (o OpsConfigPtrOutput) Uefi() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Uefi
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The version of the image
+func (o OpsConfigPtrOutput) Version() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Version
+	}).(pulumi.StringPtrOutput)
+}
+
+// A network card configuration
+type OpsNic struct {
+	// The name of the bridge
+	BridgeName *string `pulumi:"bridgeName"`
+	// The gateway IP address
+	Gateway *string `pulumi:"gateway"`
+	// The IP address
+	IpAddress *string `pulumi:"ipAddress"`
+	// The IPv6 address
+	Ipv6Address *string `pulumi:"ipv6Address"`
+	// The network mask
+	NetMask *string `pulumi:"netMask"`
+}
+
+// OpsNicInput is an input type that accepts OpsNicArgs and OpsNicOutput values.
+// You can construct a concrete instance of `OpsNicInput` via:
+//
+//	OpsNicArgs{...}
+type OpsNicInput interface {
+	pulumi.Input
+
+	ToOpsNicOutput() OpsNicOutput
+	ToOpsNicOutputWithContext(context.Context) OpsNicOutput
+}
+
+// A network card configuration
+type OpsNicArgs struct {
+	// The name of the bridge
+	BridgeName pulumi.StringPtrInput `pulumi:"bridgeName"`
+	// The gateway IP address
+	Gateway pulumi.StringPtrInput `pulumi:"gateway"`
+	// The IP address
+	IpAddress pulumi.StringPtrInput `pulumi:"ipAddress"`
+	// The IPv6 address
+	Ipv6Address pulumi.StringPtrInput `pulumi:"ipv6Address"`
+	// The network mask
+	NetMask pulumi.StringPtrInput `pulumi:"netMask"`
+}
+
+func (OpsNicArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsNic)(nil)).Elem()
+}
+
+func (i OpsNicArgs) ToOpsNicOutput() OpsNicOutput {
+	return i.ToOpsNicOutputWithContext(context.Background())
+}
+
+func (i OpsNicArgs) ToOpsNicOutputWithContext(ctx context.Context) OpsNicOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsNicOutput)
+}
+
+// OpsNicArrayInput is an input type that accepts OpsNicArray and OpsNicArrayOutput values.
+// You can construct a concrete instance of `OpsNicArrayInput` via:
+//
+//	OpsNicArray{ OpsNicArgs{...} }
+type OpsNicArrayInput interface {
+	pulumi.Input
+
+	ToOpsNicArrayOutput() OpsNicArrayOutput
+	ToOpsNicArrayOutputWithContext(context.Context) OpsNicArrayOutput
+}
+
+type OpsNicArray []OpsNicInput
+
+func (OpsNicArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OpsNic)(nil)).Elem()
+}
+
+func (i OpsNicArray) ToOpsNicArrayOutput() OpsNicArrayOutput {
+	return i.ToOpsNicArrayOutputWithContext(context.Background())
+}
+
+func (i OpsNicArray) ToOpsNicArrayOutputWithContext(ctx context.Context) OpsNicArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsNicArrayOutput)
+}
+
+// A network card configuration
+type OpsNicOutput struct{ *pulumi.OutputState }
+
+func (OpsNicOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsNic)(nil)).Elem()
+}
+
+func (o OpsNicOutput) ToOpsNicOutput() OpsNicOutput {
+	return o
+}
+
+func (o OpsNicOutput) ToOpsNicOutputWithContext(ctx context.Context) OpsNicOutput {
+	return o
+}
+
+// The name of the bridge
+func (o OpsNicOutput) BridgeName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsNic) *string { return v.BridgeName }).(pulumi.StringPtrOutput)
+}
+
+// The gateway IP address
+func (o OpsNicOutput) Gateway() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsNic) *string { return v.Gateway }).(pulumi.StringPtrOutput)
+}
+
+// The IP address
+func (o OpsNicOutput) IpAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsNic) *string { return v.IpAddress }).(pulumi.StringPtrOutput)
+}
+
+// The IPv6 address
+func (o OpsNicOutput) Ipv6Address() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsNic) *string { return v.Ipv6Address }).(pulumi.StringPtrOutput)
+}
+
+// The network mask
+func (o OpsNicOutput) NetMask() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsNic) *string { return v.NetMask }).(pulumi.StringPtrOutput)
+}
+
+type OpsNicArrayOutput struct{ *pulumi.OutputState }
+
+func (OpsNicArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OpsNic)(nil)).Elem()
+}
+
+func (o OpsNicArrayOutput) ToOpsNicArrayOutput() OpsNicArrayOutput {
+	return o
+}
+
+func (o OpsNicArrayOutput) ToOpsNicArrayOutputWithContext(ctx context.Context) OpsNicArrayOutput {
+	return o
+}
+
+func (o OpsNicArrayOutput) Index(i pulumi.IntInput) OpsNicOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OpsNic {
+		return vs[0].([]OpsNic)[vs[1].(int)]
+	}).(OpsNicOutput)
+}
+
+// The runtime configuration
+type OpsRunConfig struct {
+	// If hardware acceleration should be enabled
+	Accel *bool `pulumi:"accel"`
+	// A hook to run after the instance stops
+	AtExit *string `pulumi:"atExit"`
+	// If the volumes in mounts should be attached when the instance is created
+	AttachVolumeOnInstanceCreate *bool `pulumi:"attachVolumeOnInstanceCreate"`
+	// The IP address of the bridge
+	BridgeIPAddress *string `pulumi:"bridgeIPAddress"`
+	// The name of the bridge
+	BridgeName *string `pulumi:"bridgeName"`
+	// If bridged networking should be used
+	Bridged *bool `pulumi:"bridged"`
+	// If IP forwarding should be enabled (gcp)
+	CanIPForward *bool `pulumi:"canIPForward"`
+	// The number of CPU cores
+	Cpus *int `pulumi:"cpus"`
+	// If debugging should be enabled
+	Debug *bool `pulumi:"debug"`
+	// The gateway IP address
+	Gateway *string `pulumi:"gateway"`
+	// The port for the gdb server
+	GdbPort *int `pulumi:"gdbPort"`
+	// The GPU type
+	GpuType *string `pulumi:"gpuType"`
+	// The number of GPUs
+	Gpus *int `pulumi:"gpus"`
+	// The instance group
+	InstanceGroup *string `pulumi:"instanceGroup"`
+	// The name of the instance
+	InstanceName *string `pulumi:"instanceName"`
+	// The static IP address
+	IpAddress *string `pulumi:"ipAddress"`
+	// The static IPv6 address
+	Ipv6Address *string `pulumi:"ipv6Address"`
+	// The amount of memory, optionally suffixed with 'M' or 'G'
+	Memory *string `pulumi:"memory"`
+	// The management port for QMP access (onprem)
+	Mgmt *string `pulumi:"mgmt"`
+	// Volumes to mount, in the form '<volume>:<path>'
+	Mounts []string `pulumi:"mounts"`
+	// The network mask
+	NetMask *string `pulumi:"netMask"`
+	// Pre-configured network cards (proxmox)
+	Nics []OpsNic `pulumi:"nics"`
+	// The TCP ports to expose
+	Ports []string `pulumi:"ports"`
+	// If the QMP interface should be enabled (onprem)
+	Qmp *bool `pulumi:"qmp"`
+	// If debug messages should be shown
+	ShowDebug *bool `pulumi:"showDebug"`
+	// If errors should be shown
+	ShowErrors *bool `pulumi:"showErrors"`
+	// If warnings should be shown
+	ShowWarnings *bool `pulumi:"showWarnings"`
+	// The name of the tap device
+	TapName *string `pulumi:"tapName"`
+	// The number of threads per physical core
+	ThreadsPerCore *int `pulumi:"threadsPerCore"`
+	// The UDP ports to expose
+	UdpPorts []string `pulumi:"udpPorts"`
+	// If verbose logging should be enabled
+	Verbose *bool `pulumi:"verbose"`
+	// If a VGA output device should be emulated
+	Vga *bool `pulumi:"vga"`
+	// The volume size in GB (openstack)
+	VolumeSizeInGb *int `pulumi:"volumeSizeInGb"`
+}
+
+// OpsRunConfigInput is an input type that accepts OpsRunConfigArgs and OpsRunConfigOutput values.
+// You can construct a concrete instance of `OpsRunConfigInput` via:
+//
+//	OpsRunConfigArgs{...}
+type OpsRunConfigInput interface {
+	pulumi.Input
+
+	ToOpsRunConfigOutput() OpsRunConfigOutput
+	ToOpsRunConfigOutputWithContext(context.Context) OpsRunConfigOutput
+}
+
+// The runtime configuration
+type OpsRunConfigArgs struct {
+	// If hardware acceleration should be enabled
+	Accel pulumi.BoolPtrInput `pulumi:"accel"`
+	// A hook to run after the instance stops
+	AtExit pulumi.StringPtrInput `pulumi:"atExit"`
+	// If the volumes in mounts should be attached when the instance is created
+	AttachVolumeOnInstanceCreate pulumi.BoolPtrInput `pulumi:"attachVolumeOnInstanceCreate"`
+	// The IP address of the bridge
+	BridgeIPAddress pulumi.StringPtrInput `pulumi:"bridgeIPAddress"`
+	// The name of the bridge
+	BridgeName pulumi.StringPtrInput `pulumi:"bridgeName"`
+	// If bridged networking should be used
+	Bridged pulumi.BoolPtrInput `pulumi:"bridged"`
+	// If IP forwarding should be enabled (gcp)
+	CanIPForward pulumi.BoolPtrInput `pulumi:"canIPForward"`
+	// The number of CPU cores
+	Cpus pulumi.IntPtrInput `pulumi:"cpus"`
+	// If debugging should be enabled
+	Debug pulumi.BoolPtrInput `pulumi:"debug"`
+	// The gateway IP address
+	Gateway pulumi.StringPtrInput `pulumi:"gateway"`
+	// The port for the gdb server
+	GdbPort pulumi.IntPtrInput `pulumi:"gdbPort"`
+	// The GPU type
+	GpuType pulumi.StringPtrInput `pulumi:"gpuType"`
+	// The number of GPUs
+	Gpus pulumi.IntPtrInput `pulumi:"gpus"`
+	// The instance group
+	InstanceGroup pulumi.StringPtrInput `pulumi:"instanceGroup"`
+	// The name of the instance
+	InstanceName pulumi.StringPtrInput `pulumi:"instanceName"`
+	// The static IP address
+	IpAddress pulumi.StringPtrInput `pulumi:"ipAddress"`
+	// The static IPv6 address
+	Ipv6Address pulumi.StringPtrInput `pulumi:"ipv6Address"`
+	// The amount of memory, optionally suffixed with 'M' or 'G'
+	Memory pulumi.StringPtrInput `pulumi:"memory"`
+	// The management port for QMP access (onprem)
+	Mgmt pulumi.StringPtrInput `pulumi:"mgmt"`
+	// Volumes to mount, in the form '<volume>:<path>'
+	Mounts pulumi.StringArrayInput `pulumi:"mounts"`
+	// The network mask
+	NetMask pulumi.StringPtrInput `pulumi:"netMask"`
+	// Pre-configured network cards (proxmox)
+	Nics OpsNicArrayInput `pulumi:"nics"`
+	// The TCP ports to expose
+	Ports pulumi.StringArrayInput `pulumi:"ports"`
+	// If the QMP interface should be enabled (onprem)
+	Qmp pulumi.BoolPtrInput `pulumi:"qmp"`
+	// If debug messages should be shown
+	ShowDebug pulumi.BoolPtrInput `pulumi:"showDebug"`
+	// If errors should be shown
+	ShowErrors pulumi.BoolPtrInput `pulumi:"showErrors"`
+	// If warnings should be shown
+	ShowWarnings pulumi.BoolPtrInput `pulumi:"showWarnings"`
+	// The name of the tap device
+	TapName pulumi.StringPtrInput `pulumi:"tapName"`
+	// The number of threads per physical core
+	ThreadsPerCore pulumi.IntPtrInput `pulumi:"threadsPerCore"`
+	// The UDP ports to expose
+	UdpPorts pulumi.StringArrayInput `pulumi:"udpPorts"`
+	// If verbose logging should be enabled
+	Verbose pulumi.BoolPtrInput `pulumi:"verbose"`
+	// If a VGA output device should be emulated
+	Vga pulumi.BoolPtrInput `pulumi:"vga"`
+	// The volume size in GB (openstack)
+	VolumeSizeInGb pulumi.IntPtrInput `pulumi:"volumeSizeInGb"`
+}
+
+func (OpsRunConfigArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsRunConfig)(nil)).Elem()
+}
+
+func (i OpsRunConfigArgs) ToOpsRunConfigOutput() OpsRunConfigOutput {
+	return i.ToOpsRunConfigOutputWithContext(context.Background())
+}
+
+func (i OpsRunConfigArgs) ToOpsRunConfigOutputWithContext(ctx context.Context) OpsRunConfigOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsRunConfigOutput)
+}
+
+func (i OpsRunConfigArgs) ToOpsRunConfigPtrOutput() OpsRunConfigPtrOutput {
+	return i.ToOpsRunConfigPtrOutputWithContext(context.Background())
+}
+
+func (i OpsRunConfigArgs) ToOpsRunConfigPtrOutputWithContext(ctx context.Context) OpsRunConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsRunConfigOutput).ToOpsRunConfigPtrOutputWithContext(ctx)
+}
+
+// OpsRunConfigPtrInput is an input type that accepts OpsRunConfigArgs, OpsRunConfigPtr and OpsRunConfigPtrOutput values.
+// You can construct a concrete instance of `OpsRunConfigPtrInput` via:
+//
+//	        OpsRunConfigArgs{...}
+//
+//	or:
+//
+//	        nil
+type OpsRunConfigPtrInput interface {
+	pulumi.Input
+
+	ToOpsRunConfigPtrOutput() OpsRunConfigPtrOutput
+	ToOpsRunConfigPtrOutputWithContext(context.Context) OpsRunConfigPtrOutput
+}
+
+type opsRunConfigPtrType OpsRunConfigArgs
+
+func OpsRunConfigPtr(v *OpsRunConfigArgs) OpsRunConfigPtrInput {
+	return (*opsRunConfigPtrType)(v)
+}
+
+func (*opsRunConfigPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsRunConfig)(nil)).Elem()
+}
+
+func (i *opsRunConfigPtrType) ToOpsRunConfigPtrOutput() OpsRunConfigPtrOutput {
+	return i.ToOpsRunConfigPtrOutputWithContext(context.Background())
+}
+
+func (i *opsRunConfigPtrType) ToOpsRunConfigPtrOutputWithContext(ctx context.Context) OpsRunConfigPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsRunConfigPtrOutput)
+}
+
+// The runtime configuration
+type OpsRunConfigOutput struct{ *pulumi.OutputState }
+
+func (OpsRunConfigOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsRunConfig)(nil)).Elem()
+}
+
+func (o OpsRunConfigOutput) ToOpsRunConfigOutput() OpsRunConfigOutput {
+	return o
+}
+
+func (o OpsRunConfigOutput) ToOpsRunConfigOutputWithContext(ctx context.Context) OpsRunConfigOutput {
+	return o
+}
+
+func (o OpsRunConfigOutput) ToOpsRunConfigPtrOutput() OpsRunConfigPtrOutput {
+	return o.ToOpsRunConfigPtrOutputWithContext(context.Background())
+}
+
+func (o OpsRunConfigOutput) ToOpsRunConfigPtrOutputWithContext(ctx context.Context) OpsRunConfigPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v OpsRunConfig) *OpsRunConfig {
+		return &v
+	}).(OpsRunConfigPtrOutput)
+}
+
+// If hardware acceleration should be enabled
+func (o OpsRunConfigOutput) Accel() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Accel }).(pulumi.BoolPtrOutput)
+}
+
+// A hook to run after the instance stops
+func (o OpsRunConfigOutput) AtExit() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.AtExit }).(pulumi.StringPtrOutput)
+}
+
+// If the volumes in mounts should be attached when the instance is created
+func (o OpsRunConfigOutput) AttachVolumeOnInstanceCreate() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.AttachVolumeOnInstanceCreate }).(pulumi.BoolPtrOutput)
+}
+
+// The IP address of the bridge
+func (o OpsRunConfigOutput) BridgeIPAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.BridgeIPAddress }).(pulumi.StringPtrOutput)
+}
+
+// The name of the bridge
+func (o OpsRunConfigOutput) BridgeName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.BridgeName }).(pulumi.StringPtrOutput)
+}
+
+// If bridged networking should be used
+func (o OpsRunConfigOutput) Bridged() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Bridged }).(pulumi.BoolPtrOutput)
+}
+
+// If IP forwarding should be enabled (gcp)
+func (o OpsRunConfigOutput) CanIPForward() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.CanIPForward }).(pulumi.BoolPtrOutput)
+}
+
+// The number of CPU cores
+func (o OpsRunConfigOutput) Cpus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *int { return v.Cpus }).(pulumi.IntPtrOutput)
+}
+
+// If debugging should be enabled
+func (o OpsRunConfigOutput) Debug() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Debug }).(pulumi.BoolPtrOutput)
+}
+
+// The gateway IP address
+func (o OpsRunConfigOutput) Gateway() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.Gateway }).(pulumi.StringPtrOutput)
+}
+
+// The port for the gdb server
+func (o OpsRunConfigOutput) GdbPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *int { return v.GdbPort }).(pulumi.IntPtrOutput)
+}
+
+// The GPU type
+func (o OpsRunConfigOutput) GpuType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.GpuType }).(pulumi.StringPtrOutput)
+}
+
+// The number of GPUs
+func (o OpsRunConfigOutput) Gpus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *int { return v.Gpus }).(pulumi.IntPtrOutput)
+}
+
+// The instance group
+func (o OpsRunConfigOutput) InstanceGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.InstanceGroup }).(pulumi.StringPtrOutput)
+}
+
+// The name of the instance
+func (o OpsRunConfigOutput) InstanceName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.InstanceName }).(pulumi.StringPtrOutput)
+}
+
+// The static IP address
+func (o OpsRunConfigOutput) IpAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.IpAddress }).(pulumi.StringPtrOutput)
+}
+
+// The static IPv6 address
+func (o OpsRunConfigOutput) Ipv6Address() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.Ipv6Address }).(pulumi.StringPtrOutput)
+}
+
+// The amount of memory, optionally suffixed with 'M' or 'G'
+func (o OpsRunConfigOutput) Memory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.Memory }).(pulumi.StringPtrOutput)
+}
+
+// The management port for QMP access (onprem)
+func (o OpsRunConfigOutput) Mgmt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.Mgmt }).(pulumi.StringPtrOutput)
+}
+
+// Volumes to mount, in the form '<volume>:<path>'
+func (o OpsRunConfigOutput) Mounts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsRunConfig) []string { return v.Mounts }).(pulumi.StringArrayOutput)
+}
+
+// The network mask
+func (o OpsRunConfigOutput) NetMask() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.NetMask }).(pulumi.StringPtrOutput)
+}
+
+// Pre-configured network cards (proxmox)
+func (o OpsRunConfigOutput) Nics() OpsNicArrayOutput {
+	return o.ApplyT(func(v OpsRunConfig) []OpsNic { return v.Nics }).(OpsNicArrayOutput)
+}
+
+// The TCP ports to expose
+func (o OpsRunConfigOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsRunConfig) []string { return v.Ports }).(pulumi.StringArrayOutput)
+}
+
+// If the QMP interface should be enabled (onprem)
+func (o OpsRunConfigOutput) Qmp() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Qmp }).(pulumi.BoolPtrOutput)
+}
+
+// If debug messages should be shown
+func (o OpsRunConfigOutput) ShowDebug() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.ShowDebug }).(pulumi.BoolPtrOutput)
+}
+
+// If errors should be shown
+func (o OpsRunConfigOutput) ShowErrors() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.ShowErrors }).(pulumi.BoolPtrOutput)
+}
+
+// If warnings should be shown
+func (o OpsRunConfigOutput) ShowWarnings() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.ShowWarnings }).(pulumi.BoolPtrOutput)
+}
+
+// The name of the tap device
+func (o OpsRunConfigOutput) TapName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *string { return v.TapName }).(pulumi.StringPtrOutput)
+}
+
+// The number of threads per physical core
+func (o OpsRunConfigOutput) ThreadsPerCore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *int { return v.ThreadsPerCore }).(pulumi.IntPtrOutput)
+}
+
+// The UDP ports to expose
+func (o OpsRunConfigOutput) UdpPorts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v OpsRunConfig) []string { return v.UdpPorts }).(pulumi.StringArrayOutput)
+}
+
+// If verbose logging should be enabled
+func (o OpsRunConfigOutput) Verbose() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Verbose }).(pulumi.BoolPtrOutput)
+}
+
+// If a VGA output device should be emulated
+func (o OpsRunConfigOutput) Vga() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *bool { return v.Vga }).(pulumi.BoolPtrOutput)
+}
+
+// The volume size in GB (openstack)
+func (o OpsRunConfigOutput) VolumeSizeInGb() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v OpsRunConfig) *int { return v.VolumeSizeInGb }).(pulumi.IntPtrOutput)
+}
+
+type OpsRunConfigPtrOutput struct{ *pulumi.OutputState }
+
+func (OpsRunConfigPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**OpsRunConfig)(nil)).Elem()
+}
+
+func (o OpsRunConfigPtrOutput) ToOpsRunConfigPtrOutput() OpsRunConfigPtrOutput {
+	return o
+}
+
+func (o OpsRunConfigPtrOutput) ToOpsRunConfigPtrOutputWithContext(ctx context.Context) OpsRunConfigPtrOutput {
+	return o
+}
+
+func (o OpsRunConfigPtrOutput) Elem() OpsRunConfigOutput {
+	return o.ApplyT(func(v *OpsRunConfig) OpsRunConfig {
+		if v != nil {
+			return *v
+		}
+		var ret OpsRunConfig
+		return ret
+	}).(OpsRunConfigOutput)
+}
+
+// If hardware acceleration should be enabled
+func (o OpsRunConfigPtrOutput) Accel() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Accel
+	}).(pulumi.BoolPtrOutput)
+}
+
+// A hook to run after the instance stops
+func (o OpsRunConfigPtrOutput) AtExit() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.AtExit
+	}).(pulumi.StringPtrOutput)
+}
+
+// If the volumes in mounts should be attached when the instance is created
+func (o OpsRunConfigPtrOutput) AttachVolumeOnInstanceCreate() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.AttachVolumeOnInstanceCreate
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The IP address of the bridge
+func (o OpsRunConfigPtrOutput) BridgeIPAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BridgeIPAddress
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name of the bridge
+func (o OpsRunConfigPtrOutput) BridgeName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.BridgeName
+	}).(pulumi.StringPtrOutput)
+}
+
+// If bridged networking should be used
+func (o OpsRunConfigPtrOutput) Bridged() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Bridged
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If IP forwarding should be enabled (gcp)
+func (o OpsRunConfigPtrOutput) CanIPForward() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.CanIPForward
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The number of CPU cores
+func (o OpsRunConfigPtrOutput) Cpus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Cpus
+	}).(pulumi.IntPtrOutput)
+}
+
+// If debugging should be enabled
+func (o OpsRunConfigPtrOutput) Debug() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Debug
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The gateway IP address
+func (o OpsRunConfigPtrOutput) Gateway() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Gateway
+	}).(pulumi.StringPtrOutput)
+}
+
+// The port for the gdb server
+func (o OpsRunConfigPtrOutput) GdbPort() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.GdbPort
+	}).(pulumi.IntPtrOutput)
+}
+
+// The GPU type
+func (o OpsRunConfigPtrOutput) GpuType() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.GpuType
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of GPUs
+func (o OpsRunConfigPtrOutput) Gpus() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Gpus
+	}).(pulumi.IntPtrOutput)
+}
+
+// The instance group
+func (o OpsRunConfigPtrOutput) InstanceGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceGroup
+	}).(pulumi.StringPtrOutput)
+}
+
+// The name of the instance
+func (o OpsRunConfigPtrOutput) InstanceName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.InstanceName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The static IP address
+func (o OpsRunConfigPtrOutput) IpAddress() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.IpAddress
+	}).(pulumi.StringPtrOutput)
+}
+
+// The static IPv6 address
+func (o OpsRunConfigPtrOutput) Ipv6Address() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Ipv6Address
+	}).(pulumi.StringPtrOutput)
+}
+
+// The amount of memory, optionally suffixed with 'M' or 'G'
+func (o OpsRunConfigPtrOutput) Memory() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Memory
+	}).(pulumi.StringPtrOutput)
+}
+
+// The management port for QMP access (onprem)
+func (o OpsRunConfigPtrOutput) Mgmt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Mgmt
+	}).(pulumi.StringPtrOutput)
+}
+
+// Volumes to mount, in the form '<volume>:<path>'
+func (o OpsRunConfigPtrOutput) Mounts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsRunConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Mounts
+	}).(pulumi.StringArrayOutput)
+}
+
+// The network mask
+func (o OpsRunConfigPtrOutput) NetMask() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.NetMask
+	}).(pulumi.StringPtrOutput)
+}
+
+// Pre-configured network cards (proxmox)
+func (o OpsRunConfigPtrOutput) Nics() OpsNicArrayOutput {
+	return o.ApplyT(func(v *OpsRunConfig) []OpsNic {
+		if v == nil {
+			return nil
+		}
+		return v.Nics
+	}).(OpsNicArrayOutput)
+}
+
+// The TCP ports to expose
+func (o OpsRunConfigPtrOutput) Ports() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsRunConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Ports
+	}).(pulumi.StringArrayOutput)
+}
+
+// If the QMP interface should be enabled (onprem)
+func (o OpsRunConfigPtrOutput) Qmp() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Qmp
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If debug messages should be shown
+func (o OpsRunConfigPtrOutput) ShowDebug() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ShowDebug
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If errors should be shown
+func (o OpsRunConfigPtrOutput) ShowErrors() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ShowErrors
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If warnings should be shown
+func (o OpsRunConfigPtrOutput) ShowWarnings() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.ShowWarnings
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The name of the tap device
+func (o OpsRunConfigPtrOutput) TapName() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *string {
+		if v == nil {
+			return nil
+		}
+		return v.TapName
+	}).(pulumi.StringPtrOutput)
+}
+
+// The number of threads per physical core
+func (o OpsRunConfigPtrOutput) ThreadsPerCore() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.ThreadsPerCore
+	}).(pulumi.IntPtrOutput)
+}
+
+// The UDP ports to expose
+func (o OpsRunConfigPtrOutput) UdpPorts() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *OpsRunConfig) []string {
+		if v == nil {
+			return nil
+		}
+		return v.UdpPorts
+	}).(pulumi.StringArrayOutput)
+}
+
+// If verbose logging should be enabled
+func (o OpsRunConfigPtrOutput) Verbose() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Verbose
+	}).(pulumi.BoolPtrOutput)
+}
+
+// If a VGA output device should be emulated
+func (o OpsRunConfigPtrOutput) Vga() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *bool {
+		if v == nil {
+			return nil
+		}
+		return v.Vga
+	}).(pulumi.BoolPtrOutput)
+}
+
+// The volume size in GB (openstack)
+func (o OpsRunConfigPtrOutput) VolumeSizeInGb() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *OpsRunConfig) *int {
+		if v == nil {
+			return nil
+		}
+		return v.VolumeSizeInGb
+	}).(pulumi.IntPtrOutput)
+}
+
+// A tag (label) for images and instances
+type OpsTag struct {
+	// If the tag should be used as an image label
+	ImageLabel *bool `pulumi:"imageLabel"`
+	// If the tag should be used as an instance label
+	InstanceLabel *bool `pulumi:"instanceLabel"`
+	// If the tag should be used as instance metadata
+	InstanceMetadata *bool `pulumi:"instanceMetadata"`
+	// If the tag value should be used as an instance network tag
+	InstanceNetwork *bool `pulumi:"instanceNetwork"`
+	// The tag key
+	Key string `pulumi:"key"`
+	// The tag value
+	Value string `pulumi:"value"`
+}
+
+// OpsTagInput is an input type that accepts OpsTagArgs and OpsTagOutput values.
+// You can construct a concrete instance of `OpsTagInput` via:
+//
+//	OpsTagArgs{...}
+type OpsTagInput interface {
+	pulumi.Input
+
+	ToOpsTagOutput() OpsTagOutput
+	ToOpsTagOutputWithContext(context.Context) OpsTagOutput
+}
+
+// A tag (label) for images and instances
+type OpsTagArgs struct {
+	// If the tag should be used as an image label
+	ImageLabel pulumi.BoolPtrInput `pulumi:"imageLabel"`
+	// If the tag should be used as an instance label
+	InstanceLabel pulumi.BoolPtrInput `pulumi:"instanceLabel"`
+	// If the tag should be used as instance metadata
+	InstanceMetadata pulumi.BoolPtrInput `pulumi:"instanceMetadata"`
+	// If the tag value should be used as an instance network tag
+	InstanceNetwork pulumi.BoolPtrInput `pulumi:"instanceNetwork"`
+	// The tag key
+	Key pulumi.StringInput `pulumi:"key"`
+	// The tag value
+	Value pulumi.StringInput `pulumi:"value"`
+}
+
+func (OpsTagArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsTag)(nil)).Elem()
+}
+
+func (i OpsTagArgs) ToOpsTagOutput() OpsTagOutput {
+	return i.ToOpsTagOutputWithContext(context.Background())
+}
+
+func (i OpsTagArgs) ToOpsTagOutputWithContext(ctx context.Context) OpsTagOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsTagOutput)
+}
+
+// OpsTagArrayInput is an input type that accepts OpsTagArray and OpsTagArrayOutput values.
+// You can construct a concrete instance of `OpsTagArrayInput` via:
+//
+//	OpsTagArray{ OpsTagArgs{...} }
+type OpsTagArrayInput interface {
+	pulumi.Input
+
+	ToOpsTagArrayOutput() OpsTagArrayOutput
+	ToOpsTagArrayOutputWithContext(context.Context) OpsTagArrayOutput
+}
+
+type OpsTagArray []OpsTagInput
+
+func (OpsTagArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OpsTag)(nil)).Elem()
+}
+
+func (i OpsTagArray) ToOpsTagArrayOutput() OpsTagArrayOutput {
+	return i.ToOpsTagArrayOutputWithContext(context.Background())
+}
+
+func (i OpsTagArray) ToOpsTagArrayOutputWithContext(ctx context.Context) OpsTagArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(OpsTagArrayOutput)
+}
+
+// A tag (label) for images and instances
+type OpsTagOutput struct{ *pulumi.OutputState }
+
+func (OpsTagOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*OpsTag)(nil)).Elem()
+}
+
+func (o OpsTagOutput) ToOpsTagOutput() OpsTagOutput {
+	return o
+}
+
+func (o OpsTagOutput) ToOpsTagOutputWithContext(ctx context.Context) OpsTagOutput {
+	return o
+}
+
+// If the tag should be used as an image label
+func (o OpsTagOutput) ImageLabel() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsTag) *bool { return v.ImageLabel }).(pulumi.BoolPtrOutput)
+}
+
+// If the tag should be used as an instance label
+func (o OpsTagOutput) InstanceLabel() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsTag) *bool { return v.InstanceLabel }).(pulumi.BoolPtrOutput)
+}
+
+// If the tag should be used as instance metadata
+func (o OpsTagOutput) InstanceMetadata() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsTag) *bool { return v.InstanceMetadata }).(pulumi.BoolPtrOutput)
+}
+
+// If the tag value should be used as an instance network tag
+func (o OpsTagOutput) InstanceNetwork() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v OpsTag) *bool { return v.InstanceNetwork }).(pulumi.BoolPtrOutput)
+}
+
+// The tag key
+func (o OpsTagOutput) Key() pulumi.StringOutput {
+	return o.ApplyT(func(v OpsTag) string { return v.Key }).(pulumi.StringOutput)
+}
+
+// The tag value
+func (o OpsTagOutput) Value() pulumi.StringOutput {
+	return o.ApplyT(func(v OpsTag) string { return v.Value }).(pulumi.StringOutput)
+}
+
+type OpsTagArrayOutput struct{ *pulumi.OutputState }
+
+func (OpsTagArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]OpsTag)(nil)).Elem()
+}
+
+func (o OpsTagArrayOutput) ToOpsTagArrayOutput() OpsTagArrayOutput {
+	return o
+}
+
+func (o OpsTagArrayOutput) ToOpsTagArrayOutputWithContext(ctx context.Context) OpsTagArrayOutput {
+	return o
+}
+
+func (o OpsTagArrayOutput) Index(i pulumi.IntInput) OpsTagOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) OpsTag {
+		return vs[0].([]OpsTag)[vs[1].(int)]
+	}).(OpsTagOutput)
+}
+
+type VolumeInfo struct {
+	// The instance the volume is attached to
+	AttachedTo string `pulumi:"attachedTo"`
+	// The creation time of the volume as reported by the cloud provider
+	Created string `pulumi:"created"`
+	// The name of the volume
+	Name string `pulumi:"name"`
+	// The (local) path of the volume
+	Path string `pulumi:"path"`
+	// The size of the volume as reported by the cloud provider
+	Size string `pulumi:"size"`
+	// The status of the volume
+	Status string `pulumi:"status"`
+	// The provider ID of the volume
+	VolumeID string `pulumi:"volumeID"`
+}
+
+type VolumeInfoOutput struct{ *pulumi.OutputState }
+
+func (VolumeInfoOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*VolumeInfo)(nil)).Elem()
+}
+
+func (o VolumeInfoOutput) ToVolumeInfoOutput() VolumeInfoOutput {
+	return o
+}
+
+func (o VolumeInfoOutput) ToVolumeInfoOutputWithContext(ctx context.Context) VolumeInfoOutput {
+	return o
+}
+
+// The instance the volume is attached to
+func (o VolumeInfoOutput) AttachedTo() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.AttachedTo }).(pulumi.StringOutput)
+}
+
+// The creation time of the volume as reported by the cloud provider
+func (o VolumeInfoOutput) Created() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.Created }).(pulumi.StringOutput)
+}
+
+// The name of the volume
+func (o VolumeInfoOutput) Name() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.Name }).(pulumi.StringOutput)
+}
+
+// The (local) path of the volume
+func (o VolumeInfoOutput) Path() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.Path }).(pulumi.StringOutput)
+}
+
+// The size of the volume as reported by the cloud provider
+func (o VolumeInfoOutput) Size() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.Size }).(pulumi.StringOutput)
+}
+
+// The status of the volume
+func (o VolumeInfoOutput) Status() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.Status }).(pulumi.StringOutput)
+}
+
+// The provider ID of the volume
+func (o VolumeInfoOutput) VolumeID() pulumi.StringOutput {
+	return o.ApplyT(func(v VolumeInfo) string { return v.VolumeID }).(pulumi.StringOutput)
+}
+
+type VolumeInfoArrayOutput struct{ *pulumi.OutputState }
+
+func (VolumeInfoArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]VolumeInfo)(nil)).Elem()
+}
+
+func (o VolumeInfoArrayOutput) ToVolumeInfoArrayOutput() VolumeInfoArrayOutput {
+	return o
+}
+
+func (o VolumeInfoArrayOutput) ToVolumeInfoArrayOutputWithContext(ctx context.Context) VolumeInfoArrayOutput {
+	return o
+}
+
+func (o VolumeInfoArrayOutput) Index(i pulumi.IntInput) VolumeInfoOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) VolumeInfo {
+		return vs[0].([]VolumeInfo)[vs[1].(int)]
+	}).(VolumeInfoOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceReadinessInput)(nil)).Elem(), InstanceReadinessArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*InstanceReadinessPtrInput)(nil)).Elem(), InstanceReadinessArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsCloudConfigInput)(nil)).Elem(), OpsCloudConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsCloudConfigPtrInput)(nil)).Elem(), OpsCloudConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsCloudVolumeInput)(nil)).Elem(), OpsCloudVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsCloudVolumePtrInput)(nil)).Elem(), OpsCloudVolumeArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsConfigInput)(nil)).Elem(), OpsConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsConfigPtrInput)(nil)).Elem(), OpsConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsNicInput)(nil)).Elem(), OpsNicArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsNicArrayInput)(nil)).Elem(), OpsNicArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsRunConfigInput)(nil)).Elem(), OpsRunConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsRunConfigPtrInput)(nil)).Elem(), OpsRunConfigArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsTagInput)(nil)).Elem(), OpsTagArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*OpsTagArrayInput)(nil)).Elem(), OpsTagArray{})
+	pulumi.RegisterOutputType(ImageInfoOutput{})
+	pulumi.RegisterOutputType(ImageInfoArrayOutput{})
+	pulumi.RegisterOutputType(InstanceInfoOutput{})
+	pulumi.RegisterOutputType(InstanceInfoArrayOutput{})
+	pulumi.RegisterOutputType(InstanceReadinessOutput{})
+	pulumi.RegisterOutputType(InstanceReadinessPtrOutput{})
+	pulumi.RegisterOutputType(OpsCloudConfigOutput{})
+	pulumi.RegisterOutputType(OpsCloudConfigPtrOutput{})
+	pulumi.RegisterOutputType(OpsCloudVolumeOutput{})
+	pulumi.RegisterOutputType(OpsCloudVolumePtrOutput{})
+	pulumi.RegisterOutputType(OpsConfigOutput{})
+	pulumi.RegisterOutputType(OpsConfigPtrOutput{})
+	pulumi.RegisterOutputType(OpsNicOutput{})
+	pulumi.RegisterOutputType(OpsNicArrayOutput{})
+	pulumi.RegisterOutputType(OpsRunConfigOutput{})
+	pulumi.RegisterOutputType(OpsRunConfigPtrOutput{})
+	pulumi.RegisterOutputType(OpsTagOutput{})
+	pulumi.RegisterOutputType(OpsTagArrayOutput{})
+	pulumi.RegisterOutputType(VolumeInfoOutput{})
+	pulumi.RegisterOutputType(VolumeInfoArrayOutput{})
+}
@@ -0,0 +1,284 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package puluminanovms
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/tpjg/pulumi-nanovms/sdk/go/pulumi-nanovms/internal"
+)
+
+// Attaches a NanoVMs volume to a running instance
+type VolumeAttachment struct {
+	pulumi.CustomResourceState
+
+	// The persistent disk ID the volume is attached as
+	AttachID pulumi.IntPtrOutput `pulumi:"attachID"`
+	// The configuration used to attach the volume as a JSON encoded string
+	Config pulumi.StringOutput `pulumi:"config"`
+	// The ID (name) of the instance the volume is attached to
+	Instance pulumi.StringOutput `pulumi:"instance"`
+	// The path the volume is mounted at in the instance
+	MountPath pulumi.StringOutput `pulumi:"mountPath"`
+	// The cloud provider of the instance and volume
+	Provider pulumi.StringOutput `pulumi:"provider"`
+	// The name of the attached volume
+	Volume pulumi.StringOutput `pulumi:"volume"`
+}
+
+// NewVolumeAttachment registers a new resource with the given unique name, arguments, and options.
+func NewVolumeAttachment(ctx *pulumi.Context,
+	name string, args *VolumeAttachmentArgs, opts ...pulumi.ResourceOption) (*VolumeAttachment, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Instance == nil {
+		return nil, errors.New("invalid value for required argument 'Instance'")
+	}
+	if args.MountPath == nil {
+		return nil, errors.New("invalid value for required argument 'MountPath'")
+	}
+	if args.Volume == nil {
+		return nil, errors.New("invalid value for required argument 'Volume'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource VolumeAttachment
+	err := ctx.RegisterResource("nanovms:index:VolumeAttachment", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetVolumeAttachment gets an existing VolumeAttachment resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetVolumeAttachment(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *VolumeAttachmentState, opts ...pulumi.ResourceOption) (*VolumeAttachment, error) {
+	var resource VolumeAttachment
+	err := ctx.ReadResource("nanovms:index:VolumeAttachment", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering VolumeAttachment resources.
+type volumeAttachmentState struct {
+}
+
+type VolumeAttachmentState struct {
+}
+
+func (VolumeAttachmentState) ElementType() reflect.Type {
+	return reflect.TypeOf((*volumeAttachmentState)(nil)).Elem()
+}
+
+type volumeAttachmentArgs struct {
+	// The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+	AttachID *int `pulumi:"attachID"`
+	// The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+	Config *string `pulumi:"config"`
+	// The ID (name) of the instance to attach the volume to
+	Instance string `pulumi:"instance"`
+	// The path the volume is mounted at in the instance, must match the mounts of the image
+	MountPath string `pulumi:"mountPath"`
+	// The configuration, used for the cloud provider settings such as zone
+	OpsConfig *OpsConfig `pulumi:"opsConfig"`
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome *string `pulumi:"opsHome"`
+	// The cloud provider of the instance and volume
+	Provider *string `pulumi:"provider"`
+	// The name of the volume to attach
+	Volume string `pulumi:"volume"`
+}
+
+// The set of arguments for constructing a VolumeAttachment resource.
+type VolumeAttachmentArgs struct {
+	// The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+	AttachID pulumi.IntPtrInput
+	// The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+	Config pulumi.StringPtrInput
+	// The ID (name) of the instance to attach the volume to
+	Instance pulumi.StringInput
+	// The path the volume is mounted at in the instance, must match the mounts of the image
+	MountPath pulumi.StringInput
+	// The configuration, used for the cloud provider settings such as zone
+	OpsConfig OpsConfigPtrInput
+	// The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+	OpsHome pulumi.StringPtrInput
+	// The cloud provider of the instance and volume
+	Provider pulumi.StringPtrInput
+	// The name of the volume to attach
+	Volume pulumi.StringInput
+}
+
+func (VolumeAttachmentArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*volumeAttachmentArgs)(nil)).Elem()
+}
+
+type VolumeAttachmentInput interface {
+	pulumi.Input
+
+	ToVolumeAttachmentOutput() VolumeAttachmentOutput
+	ToVolumeAttachmentOutputWithContext(ctx context.Context) VolumeAttachmentOutput
+}
+
+func (*VolumeAttachment) ElementType() reflect.Type {
+	return reflect.TypeOf((**VolumeAttachment)(nil)).Elem()
+}
+
+func (i *VolumeAttachment) ToVolumeAttachmentOutput() VolumeAttachmentOutput {
+	return i.ToVolumeAttachmentOutputWithContext(context.Background())
+}
+
+func (i *VolumeAttachment) ToVolumeAttachmentOutputWithContext(ctx context.Context) VolumeAttachmentOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeAttachmentOutput)
+}
+
+// VolumeAttachmentArrayInput is an input type that accepts VolumeAttachmentArray and VolumeAttachmentArrayOutput values.
+// You can construct a concrete instance of `VolumeAttachmentArrayInput` via:
+//
+//	VolumeAttachmentArray{ VolumeAttachmentArgs{...} }
+type VolumeAttachmentArrayInput interface {
+	pulumi.Input
+
+	ToVolumeAttachmentArrayOutput() VolumeAttachmentArrayOutput
+	ToVolumeAttachmentArrayOutputWithContext(context.Context) VolumeAttachmentArrayOutput
+}
+
+type VolumeAttachmentArray []VolumeAttachmentInput
+
+func (VolumeAttachmentArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*VolumeAttachment)(nil)).Elem()
+}
+
+func (i VolumeAttachmentArray) ToVolumeAttachmentArrayOutput() VolumeAttachmentArrayOutput {
+	return i.ToVolumeAttachmentArrayOutputWithContext(context.Background())
+}
+
+func (i VolumeAttachmentArray) ToVolumeAttachmentArrayOutputWithContext(ctx context.Context) VolumeAttachmentArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeAttachmentArrayOutput)
+}
+
+// VolumeAttachmentMapInput is an input type that accepts VolumeAttachmentMap and VolumeAttachmentMapOutput values.
+// You can construct a concrete instance of `VolumeAttachmentMapInput` via:
+//
+//	VolumeAttachmentMap{ "key": VolumeAttachmentArgs{...} }
+type VolumeAttachmentMapInput interface {
+	pulumi.Input
+
+	ToVolumeAttachmentMapOutput() VolumeAttachmentMapOutput
+	ToVolumeAttachmentMapOutputWithContext(context.Context) VolumeAttachmentMapOutput
+}
+
+type VolumeAttachmentMap map[string]VolumeAttachmentInput
+
+func (VolumeAttachmentMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*VolumeAttachment)(nil)).Elem()
+}
+
+func (i VolumeAttachmentMap) ToVolumeAttachmentMapOutput() VolumeAttachmentMapOutput {
+	return i.ToVolumeAttachmentMapOutputWithContext(context.Background())
+}
+
+func (i VolumeAttachmentMap) ToVolumeAttachmentMapOutputWithContext(ctx context.Context) VolumeAttachmentMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(VolumeAttachmentMapOutput)
+}
+
+type VolumeAttachmentOutput struct{ *pulumi.OutputState }
+
+func (VolumeAttachmentOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**VolumeAttachment)(nil)).Elem()
+}
+
+func (o VolumeAttachmentOutput) ToVolumeAttachmentOutput() VolumeAttachmentOutput {
+	return o
+}
+
+func (o VolumeAttachmentOutput) ToVolumeAttachmentOutputWithContext(ctx context.Context) VolumeAttachmentOutput {
+	return o
+}
+
+// The persistent disk ID the volume is attached as
+func (o VolumeAttachmentOutput) AttachID() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.IntPtrOutput { return v.AttachID }).(pulumi.IntPtrOutput)
+}
+
+// The configuration used to attach the volume as a JSON encoded string
+func (o VolumeAttachmentOutput) Config() pulumi.StringOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.StringOutput { return v.Config }).(pulumi.StringOutput)
+}
+
+// The ID (name) of the instance the volume is attached to
+func (o VolumeAttachmentOutput) Instance() pulumi.StringOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.StringOutput { return v.Instance }).(pulumi.StringOutput)
+}
+
+// The path the volume is mounted at in the instance
+func (o VolumeAttachmentOutput) MountPath() pulumi.StringOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.StringOutput { return v.MountPath }).(pulumi.StringOutput)
+}
+
+// The cloud provider of the instance and volume
+func (o VolumeAttachmentOutput) Provider() pulumi.StringOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.StringOutput { return v.Provider }).(pulumi.StringOutput)
+}
+
+// The name of the attached volume
+func (o VolumeAttachmentOutput) Volume() pulumi.StringOutput {
+	return o.ApplyT(func(v *VolumeAttachment) pulumi.StringOutput { return v.Volume }).(pulumi.StringOutput)
+}
+
+type VolumeAttachmentArrayOutput struct{ *pulumi.OutputState }
+
+func (VolumeAttachmentArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]*VolumeAttachment)(nil)).Elem()
+}
+
+func (o VolumeAttachmentArrayOutput) ToVolumeAttachmentArrayOutput() VolumeAttachmentArrayOutput {
+	return o
+}
+
+func (o VolumeAttachmentArrayOutput) ToVolumeAttachmentArrayOutputWithContext(ctx context.Context) VolumeAttachmentArrayOutput {
+	return o
+}
+
+func (o VolumeAttachmentArrayOutput) Index(i pulumi.IntInput) VolumeAttachmentOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) *VolumeAttachment {
+		return vs[0].([]*VolumeAttachment)[vs[1].(int)]
+	}).(VolumeAttachmentOutput)
+}
+
+type VolumeAttachmentMapOutput struct{ *pulumi.OutputState }
+
+func (VolumeAttachmentMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]*VolumeAttachment)(nil)).Elem()
+}
+
+func (o VolumeAttachmentMapOutput) ToVolumeAttachmentMapOutput() VolumeAttachmentMapOutput {
+	return o
+}
+
+func (o VolumeAttachmentMapOutput) ToVolumeAttachmentMapOutputWithContext(ctx context.Context) VolumeAttachmentMapOutput {
+	return o
+}
+
+func (o VolumeAttachmentMapOutput) MapIndex(k pulumi.StringInput) VolumeAttachmentOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) *VolumeAttachment {
+		return vs[0].(map[string]*VolumeAttachment)[vs[1].(string)]
+	}).(VolumeAttachmentOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeAttachmentInput)(nil)).Elem(), &VolumeAttachment{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeAttachmentArrayInput)(nil)).Elem(), VolumeAttachmentArray{})
+	pulumi.RegisterInputType(reflect.TypeOf((*VolumeAttachmentMapInput)(nil)).Elem(), VolumeAttachmentMap{})
+	pulumi.RegisterOutputType(VolumeAttachmentOutput{})
+	pulumi.RegisterOutputType(VolumeAttachmentArrayOutput{})
+	pulumi.RegisterOutputType(VolumeAttachmentMapOutput{})
+}
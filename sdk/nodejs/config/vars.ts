@@ -0,0 +1,88 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+declare var exports: any;
+const __config = new pulumi.Config("nanovms");
+
+/**
+ * The default target architecture of package images (amd64 or arm64)
+ */
+export declare const architecture: string | undefined;
+Object.defineProperty(exports, "architecture", {
+    get() {
+        return __config.get("architecture");
+    },
+    enumerable: true,
+});
+
+/**
+ * The default cloud provider settings, merged under the configuration of each resource
+ */
+export declare const cloudConfig: outputs.OpsCloudConfig | undefined;
+Object.defineProperty(exports, "cloudConfig", {
+    get() {
+        return __config.getObject<outputs.OpsCloudConfig>("cloudConfig");
+    },
+    enumerable: true,
+});
+
+/**
+ * The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+ */
+export declare const defaultProvider: string | undefined;
+Object.defineProperty(exports, "defaultProvider", {
+    get() {
+        return __config.get("defaultProvider");
+    },
+    enumerable: true,
+});
+
+/**
+ * The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+ */
+export declare const kernelVersion: string | undefined;
+Object.defineProperty(exports, "kernelVersion", {
+    get() {
+        return __config.get("kernelVersion");
+    },
+    enumerable: true,
+});
+
+/**
+ * A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+ */
+export declare const mirrorDir: string;
+Object.defineProperty(exports, "mirrorDir", {
+    get() {
+        return __config.get("mirrorDir") ?? (utilities.getEnv("NANOVMS_MIRROR_DIR") || "");
+    },
+    enumerable: true,
+});
+
+/**
+ * If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+ */
+export declare const offline: boolean;
+Object.defineProperty(exports, "offline", {
+    get() {
+        return __config.getObject<boolean>("offline") ?? (utilities.getEnvBoolean("NANOVMS_OFFLINE") || false);
+    },
+    enumerable: true,
+});
+
+/**
+ * The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+ */
+export declare const opsHome: string | undefined;
+Object.defineProperty(exports, "opsHome", {
+    get() {
+        return __config.get("opsHome");
+    },
+    enumerable: true,
+});
+
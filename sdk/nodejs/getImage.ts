@@ -0,0 +1,101 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Looks up an image of a cloud provider by name
+ */
+export function getImage(args: GetImageArgs, opts?: pulumi.InvokeOptions): Promise<GetImageResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getImage", {
+        "config": args.config,
+        "name": args.name,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetImageArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: string;
+    /**
+     * The name of the image
+     */
+    name: string;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The cloud provider of the image
+     */
+    provider?: string;
+}
+
+export interface GetImageResult {
+    /**
+     * The creation time of the image (RFC 3339)
+     */
+    readonly created: string;
+    /**
+     * The provider ID of the image
+     */
+    readonly imageID: string;
+    /**
+     * The labels of the image
+     */
+    readonly labels: string[];
+    /**
+     * The name of the image
+     */
+    readonly name: string;
+    /**
+     * The (local) path of the image
+     */
+    readonly path: string;
+    /**
+     * The size of the image in bytes
+     */
+    readonly size: number;
+    /**
+     * The status of the image
+     */
+    readonly status: string;
+}
+/**
+ * Looks up an image of a cloud provider by name
+ */
+export function getImageOutput(args: GetImageOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetImageResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getImage", {
+        "config": args.config,
+        "name": args.name,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetImageOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The name of the image
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The cloud provider of the image
+     */
+    provider?: pulumi.Input<string>;
+}
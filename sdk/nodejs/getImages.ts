@@ -0,0 +1,79 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the images of a cloud provider
+ */
+export function getImages(args?: GetImagesArgs, opts?: pulumi.InvokeOptions): Promise<GetImagesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getImages", {
+        "config": args.config,
+        "filter": args.filter,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetImagesArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: string;
+    /**
+     * Only return images matching the filter, the format depends on the cloud provider
+     */
+    filter?: string;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The cloud provider to list the images of
+     */
+    provider?: string;
+}
+
+export interface GetImagesResult {
+    /**
+     * The images
+     */
+    readonly images: outputs.ImageInfo[];
+}
+/**
+ * Lists the images of a cloud provider
+ */
+export function getImagesOutput(args?: GetImagesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetImagesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getImages", {
+        "config": args.config,
+        "filter": args.filter,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetImagesOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * Only return images matching the filter, the format depends on the cloud provider
+     */
+    filter?: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The cloud provider to list the images of
+     */
+    provider?: pulumi.Input<string>;
+}
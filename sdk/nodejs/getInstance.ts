@@ -0,0 +1,105 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Looks up an instance of a cloud provider by name
+ */
+export function getInstance(args: GetInstanceArgs, opts?: pulumi.InvokeOptions): Promise<GetInstanceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getInstance", {
+        "config": args.config,
+        "name": args.name,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstanceArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: string;
+    /**
+     * The name of the instance
+     */
+    name: string;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The cloud provider of the instance
+     */
+    provider?: string;
+}
+
+export interface GetInstanceResult {
+    /**
+     * The creation time of the instance as reported by the cloud provider
+     */
+    readonly created: string;
+    /**
+     * The image the instance runs
+     */
+    readonly image: string;
+    /**
+     * The name of the instance
+     */
+    readonly name: string;
+    /**
+     * The provider instance ID
+     */
+    readonly pid: string;
+    /**
+     * The ports of the instance
+     */
+    readonly ports: string[];
+    /**
+     * The private IP addresses of the instance
+     */
+    readonly private_ips: string[];
+    /**
+     * The public IP addresses of the instance
+     */
+    readonly public_ips: string[];
+    /**
+     * The status of the instance
+     */
+    readonly status: string;
+}
+/**
+ * Looks up an instance of a cloud provider by name
+ */
+export function getInstanceOutput(args: GetInstanceOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetInstanceResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getInstance", {
+        "config": args.config,
+        "name": args.name,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstanceOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The name of the instance
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The cloud provider of the instance
+     */
+    provider?: pulumi.Input<string>;
+}
@@ -0,0 +1,87 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Retrieves the console output of a NanoVMs instance
+ */
+export function getInstanceLogs(args: GetInstanceLogsArgs, opts?: pulumi.InvokeOptions): Promise<GetInstanceLogsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getInstanceLogs", {
+        "config": args.config,
+        "instanceID": args.instanceID,
+        "lines": args.lines,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstanceLogsArgs {
+    /**
+     * The configuration as a JSON encoded string, e.g. the config output of the instance
+     */
+    config?: string;
+    /**
+     * The ID (name) of the instance
+     */
+    instanceID: string;
+    /**
+     * Only return the last number of lines, all output is returned if not set
+     */
+    lines?: number;
+    /**
+     * The configuration, used for the cloud provider settings such as zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The provider (type) of the instance
+     */
+    provider?: string;
+}
+
+export interface GetInstanceLogsResult {
+    /**
+     * The console output of the instance
+     */
+    readonly logs: string;
+}
+/**
+ * Retrieves the console output of a NanoVMs instance
+ */
+export function getInstanceLogsOutput(args: GetInstanceLogsOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetInstanceLogsResult> {
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getInstanceLogs", {
+        "config": args.config,
+        "instanceID": args.instanceID,
+        "lines": args.lines,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstanceLogsOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, e.g. the config output of the instance
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The ID (name) of the instance
+     */
+    instanceID: pulumi.Input<string>;
+    /**
+     * Only return the last number of lines, all output is returned if not set
+     */
+    lines?: pulumi.Input<number>;
+    /**
+     * The configuration, used for the cloud provider settings such as zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The provider (type) of the instance
+     */
+    provider?: pulumi.Input<string>;
+}
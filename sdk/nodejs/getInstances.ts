@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the instances of a cloud provider
+ */
+export function getInstances(args?: GetInstancesArgs, opts?: pulumi.InvokeOptions): Promise<GetInstancesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getInstances", {
+        "config": args.config,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstancesArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: string;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The cloud provider to list the instances of
+     */
+    provider?: string;
+}
+
+export interface GetInstancesResult {
+    /**
+     * The instances
+     */
+    readonly instances: outputs.InstanceInfo[];
+}
+/**
+ * Lists the instances of a cloud provider
+ */
+export function getInstancesOutput(args?: GetInstancesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetInstancesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getInstances", {
+        "config": args.config,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetInstancesOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The cloud provider to list the instances of
+     */
+    provider?: pulumi.Input<string>;
+}
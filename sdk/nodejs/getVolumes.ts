@@ -0,0 +1,69 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Lists the volumes of a cloud provider
+ */
+export function getVolumes(args?: GetVolumesArgs, opts?: pulumi.InvokeOptions): Promise<GetVolumesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("nanovms:index:getVolumes", {
+        "config": args.config,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetVolumesArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: string;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: inputs.OpsConfig;
+    /**
+     * The cloud provider to list the volumes of
+     */
+    provider?: string;
+}
+
+export interface GetVolumesResult {
+    /**
+     * The volumes
+     */
+    readonly volumes: outputs.VolumeInfo[];
+}
+/**
+ * Lists the volumes of a cloud provider
+ */
+export function getVolumesOutput(args?: GetVolumesOutputArgs, opts?: pulumi.InvokeOutputOptions): pulumi.Output<GetVolumesResult> {
+    args = args || {};
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invokeOutput("nanovms:index:getVolumes", {
+        "config": args.config,
+        "opsConfig": args.opsConfig,
+        "provider": args.provider,
+    }, opts);
+}
+
+export interface GetVolumesOutputArgs {
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as project and zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The cloud provider to list the volumes of
+     */
+    provider?: pulumi.Input<string>;
+}
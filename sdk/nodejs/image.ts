@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 /**
@@ -34,10 +36,18 @@ export class Image extends pulumi.CustomResource {
         return obj['__pulumiType'] === Image.__pulumiType;
     }
 
+    /**
+     * The command line of the program in the image, the program name followed by its arguments
+     */
+    declare public readonly args: pulumi.Output<string[] | undefined>;
     /**
      * The configuration of the built image as a JSON encoded string
      */
     declare public readonly config: pulumi.Output<string>;
+    /**
+     * The digest of the elf and all files and directories included into the image
+     */
+    declare public /*out*/ readonly contentHash: pulumi.Output<string | undefined>;
     /**
      * The name of the built image
      */
@@ -46,10 +56,18 @@ export class Image extends pulumi.CustomResource {
      * The path to the built image
      */
     declare public /*out*/ readonly imagePath: pulumi.Output<string>;
+    /**
+     * The nanos kernel version the image is built with
+     */
+    declare public readonly kernelVersion: pulumi.Output<string | undefined>;
     /**
      * The cloud provider of the built image
      */
     declare public readonly provider: pulumi.Output<string>;
+    /**
+     * The paths of the dynamic loader and shared libraries added to the image because of includeLdd
+     */
+    declare public /*out*/ readonly sharedLibraries: pulumi.Output<string[] | undefined>;
     /**
      * If the latest kernel should be used, download it if necessary
      */
@@ -72,22 +90,31 @@ export class Image extends pulumi.CustomResource {
             if (args?.name === undefined && !opts.urn) {
                 throw new Error("Missing required property 'name'");
             }
-            if (args?.provider === undefined && !opts.urn) {
-                throw new Error("Missing required property 'provider'");
-            }
+            resourceInputs["args"] = args?.args;
             resourceInputs["config"] = args?.config;
             resourceInputs["elf"] = args?.elf;
             resourceInputs["force"] = args?.force;
+            resourceInputs["includeLdd"] = args?.includeLdd;
+            resourceInputs["kernelVersion"] = args?.kernelVersion;
             resourceInputs["name"] = args?.name;
+            resourceInputs["opsConfig"] = args?.opsConfig;
+            resourceInputs["opsHome"] = args?.opsHome;
             resourceInputs["provider"] = args?.provider;
+            resourceInputs["sysroot"] = args?.sysroot;
             resourceInputs["useLatestKernel"] = args?.useLatestKernel;
+            resourceInputs["contentHash"] = undefined /*out*/;
             resourceInputs["imageName"] = undefined /*out*/;
             resourceInputs["imagePath"] = undefined /*out*/;
+            resourceInputs["sharedLibraries"] = undefined /*out*/;
         } else {
+            resourceInputs["args"] = undefined /*out*/;
             resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["contentHash"] = undefined /*out*/;
             resourceInputs["imageName"] = undefined /*out*/;
             resourceInputs["imagePath"] = undefined /*out*/;
+            resourceInputs["kernelVersion"] = undefined /*out*/;
             resourceInputs["provider"] = undefined /*out*/;
+            resourceInputs["sharedLibraries"] = undefined /*out*/;
             resourceInputs["useLatestKernel"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
@@ -100,7 +127,13 @@ export class Image extends pulumi.CustomResource {
  */
 export interface ImageArgs {
     /**
-     * The configuration as a JSON encoded string
+     * The arguments the program is started with, appended to the args of the configuration
+     */
+    args?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     *
+     * @deprecated use opsConfig instead
      */
     config?: pulumi.Input<string>;
     /**
@@ -111,14 +144,34 @@ export interface ImageArgs {
      * If an already existing image should be deleted if it exists
      */
     force?: pulumi.Input<boolean>;
+    /**
+     * If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+     */
+    includeLdd?: pulumi.Input<boolean>;
+    /**
+     * The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+     */
+    kernelVersion?: pulumi.Input<string>;
     /**
      * The name of the image
      */
     name: pulumi.Input<string>;
+    /**
+     * The configuration of the image
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
     /**
      * The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
      */
-    provider: pulumi.Input<string>;
+    provider?: pulumi.Input<string>;
+    /**
+     * The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+     */
+    sysroot?: pulumi.Input<string>;
     /**
      * If the latest kernel should be used, download it if necessary
      */
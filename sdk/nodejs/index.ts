@@ -5,6 +5,36 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export { GetImageArgs, GetImageResult, GetImageOutputArgs } from "./getImage";
+export const getImage: typeof import("./getImage").getImage = null as any;
+export const getImageOutput: typeof import("./getImage").getImageOutput = null as any;
+utilities.lazyLoad(exports, ["getImage","getImageOutput"], () => require("./getImage"));
+
+export { GetImagesArgs, GetImagesResult, GetImagesOutputArgs } from "./getImages";
+export const getImages: typeof import("./getImages").getImages = null as any;
+export const getImagesOutput: typeof import("./getImages").getImagesOutput = null as any;
+utilities.lazyLoad(exports, ["getImages","getImagesOutput"], () => require("./getImages"));
+
+export { GetInstanceArgs, GetInstanceResult, GetInstanceOutputArgs } from "./getInstance";
+export const getInstance: typeof import("./getInstance").getInstance = null as any;
+export const getInstanceOutput: typeof import("./getInstance").getInstanceOutput = null as any;
+utilities.lazyLoad(exports, ["getInstance","getInstanceOutput"], () => require("./getInstance"));
+
+export { GetInstanceLogsArgs, GetInstanceLogsResult, GetInstanceLogsOutputArgs } from "./getInstanceLogs";
+export const getInstanceLogs: typeof import("./getInstanceLogs").getInstanceLogs = null as any;
+export const getInstanceLogsOutput: typeof import("./getInstanceLogs").getInstanceLogsOutput = null as any;
+utilities.lazyLoad(exports, ["getInstanceLogs","getInstanceLogsOutput"], () => require("./getInstanceLogs"));
+
+export { GetInstancesArgs, GetInstancesResult, GetInstancesOutputArgs } from "./getInstances";
+export const getInstances: typeof import("./getInstances").getInstances = null as any;
+export const getInstancesOutput: typeof import("./getInstances").getInstancesOutput = null as any;
+utilities.lazyLoad(exports, ["getInstances","getInstancesOutput"], () => require("./getInstances"));
+
+export { GetVolumesArgs, GetVolumesResult, GetVolumesOutputArgs } from "./getVolumes";
+export const getVolumes: typeof import("./getVolumes").getVolumes = null as any;
+export const getVolumesOutput: typeof import("./getVolumes").getVolumesOutput = null as any;
+utilities.lazyLoad(exports, ["getVolumes","getVolumesOutput"], () => require("./getVolumes"));
+
 export { ImageArgs } from "./image";
 export type Image = import("./image").Image;
 export const Image: typeof import("./image").Image = null as any;
@@ -15,6 +45,16 @@ export type Instance = import("./instance").Instance;
 export const Instance: typeof import("./instance").Instance = null as any;
 utilities.lazyLoad(exports, ["Instance"], () => require("./instance"));
 
+export { NanosKernelArgs } from "./nanosKernel";
+export type NanosKernel = import("./nanosKernel").NanosKernel;
+export const NanosKernel: typeof import("./nanosKernel").NanosKernel = null as any;
+utilities.lazyLoad(exports, ["NanosKernel"], () => require("./nanosKernel"));
+
+export { PackageArgs } from "./package";
+export type Package = import("./package").Package;
+export const Package: typeof import("./package").Package = null as any;
+utilities.lazyLoad(exports, ["Package"], () => require("./package"));
+
 export { PackageImageArgs } from "./packageImage";
 export type PackageImage = import("./packageImage").PackageImage;
 export const PackageImage: typeof import("./packageImage").PackageImage = null as any;
@@ -25,6 +65,25 @@ export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
 utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
+export { VolumeArgs } from "./volume";
+export type Volume = import("./volume").Volume;
+export const Volume: typeof import("./volume").Volume = null as any;
+utilities.lazyLoad(exports, ["Volume"], () => require("./volume"));
+
+export { VolumeAttachmentArgs } from "./volumeAttachment";
+export type VolumeAttachment = import("./volumeAttachment").VolumeAttachment;
+export const VolumeAttachment: typeof import("./volumeAttachment").VolumeAttachment = null as any;
+utilities.lazyLoad(exports, ["VolumeAttachment"], () => require("./volumeAttachment"));
+
+
+// Export sub-modules:
+import * as config from "./config";
+import * as types from "./types";
+
+export {
+    config,
+    types,
+};
 
 const _module = {
     version: utilities.getVersion(),
@@ -34,8 +93,16 @@ const _module = {
                 return new Image(name, <any>undefined, { urn })
             case "nanovms:index:Instance":
                 return new Instance(name, <any>undefined, { urn })
+            case "nanovms:index:NanosKernel":
+                return new NanosKernel(name, <any>undefined, { urn })
+            case "nanovms:index:Package":
+                return new Package(name, <any>undefined, { urn })
             case "nanovms:index:PackageImage":
                 return new PackageImage(name, <any>undefined, { urn })
+            case "nanovms:index:Volume":
+                return new Volume(name, <any>undefined, { urn })
+            case "nanovms:index:VolumeAttachment":
+                return new VolumeAttachment(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 /**
@@ -46,6 +48,14 @@ export class Instance extends pulumi.CustomResource {
      * The unique identifier for the instance
      */
     declare public /*out*/ readonly instanceID: pulumi.Output<string>;
+    /**
+     * The name the instance was given, through the name input or the configuration
+     */
+    declare public readonly name: pulumi.Output<string | undefined>;
+    /**
+     * The prefix the name of the instance was generated from
+     */
+    declare public readonly namePrefix: pulumi.Output<string | undefined>;
     /**
      * The provider instance ID
      */
@@ -74,19 +84,19 @@ export class Instance extends pulumi.CustomResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: InstanceArgs, opts?: pulumi.CustomResourceOptions) {
+    constructor(name: string, args?: InstanceArgs, opts?: pulumi.CustomResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if (args?.config === undefined && !opts.urn) {
-                throw new Error("Missing required property 'config'");
-            }
-            if (args?.provider === undefined && !opts.urn) {
-                throw new Error("Missing required property 'provider'");
-            }
+            resourceInputs["captureLogsOnFailure"] = args?.captureLogsOnFailure;
             resourceInputs["config"] = args?.config;
             resourceInputs["image"] = args?.image;
+            resourceInputs["name"] = args?.name;
+            resourceInputs["namePrefix"] = args?.namePrefix;
+            resourceInputs["opsConfig"] = args?.opsConfig;
+            resourceInputs["opsHome"] = args?.opsHome;
             resourceInputs["provider"] = args?.provider;
+            resourceInputs["readiness"] = args ? (args.readiness ? pulumi.output(args.readiness).apply(inputs.instanceReadinessArgsProvideDefaults) : undefined) : undefined;
             resourceInputs["instanceID"] = undefined /*out*/;
             resourceInputs["pid"] = undefined /*out*/;
             resourceInputs["private_ips"] = undefined /*out*/;
@@ -96,6 +106,8 @@ export class Instance extends pulumi.CustomResource {
             resourceInputs["config"] = undefined /*out*/;
             resourceInputs["image"] = undefined /*out*/;
             resourceInputs["instanceID"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["namePrefix"] = undefined /*out*/;
             resourceInputs["pid"] = undefined /*out*/;
             resourceInputs["private_ips"] = undefined /*out*/;
             resourceInputs["provider"] = undefined /*out*/;
@@ -112,15 +124,41 @@ export class Instance extends pulumi.CustomResource {
  */
 export interface InstanceArgs {
     /**
-     * The configuration for the instance
+     * The number of console log lines to include in the error when the instance does not become ready
      */
-    config: pulumi.Input<string>;
+    captureLogsOnFailure?: pulumi.Input<number>;
+    /**
+     * The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+     *
+     * @deprecated use opsConfig instead
+     */
+    config?: pulumi.Input<string>;
     /**
      * The name of the image to deploy
      */
     image?: pulumi.Input<string>;
+    /**
+     * The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+     */
+    namePrefix?: pulumi.Input<string>;
+    /**
+     * The configuration for the instance
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
     /**
      * The provider for the instance
      */
-    provider: pulumi.Input<string>;
+    provider?: pulumi.Input<string>;
+    /**
+     * Wait for the instance to become ready before completing, so its status and IP addresses are known
+     */
+    readiness?: pulumi.Input<inputs.InstanceReadinessArgs>;
 }
@@ -0,0 +1,128 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with
+ */
+export class NanosKernel extends pulumi.CustomResource {
+    /**
+     * Get an existing NanosKernel resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): NanosKernel {
+        return new NanosKernel(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'nanovms:index:NanosKernel';
+
+    /**
+     * Returns true if the given object is an instance of NanosKernel.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is NanosKernel {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === NanosKernel.__pulumiType;
+    }
+
+    /**
+     * The architecture of the release
+     */
+    declare public readonly architecture: pulumi.Output<string>;
+    /**
+     * The path of boot.img, not part of arm64 releases
+     */
+    declare public /*out*/ readonly bootPath: pulumi.Output<string | undefined>;
+    /**
+     * The SHA-256 digest of boot.img
+     */
+    declare public /*out*/ readonly bootSha256: pulumi.Output<string | undefined>;
+    /**
+     * The path of kernel.img
+     */
+    declare public /*out*/ readonly kernelPath: pulumi.Output<string>;
+    /**
+     * The SHA-256 digest of kernel.img
+     */
+    declare public /*out*/ readonly kernelSha256: pulumi.Output<string>;
+    /**
+     * The directory containing the klibs of the release
+     */
+    declare public /*out*/ readonly klibsDir: pulumi.Output<string>;
+    /**
+     * The directory containing the ops home (.ops) the release is downloaded into
+     */
+    declare public readonly opsHome: pulumi.Output<string | undefined>;
+    /**
+     * The nanos release version
+     */
+    declare public readonly version: pulumi.Output<string>;
+
+    /**
+     * Create a NanosKernel resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: NanosKernelArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.version === undefined && !opts.urn) {
+                throw new Error("Missing required property 'version'");
+            }
+            resourceInputs["architecture"] = args?.architecture;
+            resourceInputs["expectedKernelSha256"] = args?.expectedKernelSha256;
+            resourceInputs["opsHome"] = args?.opsHome;
+            resourceInputs["version"] = args?.version;
+            resourceInputs["bootPath"] = undefined /*out*/;
+            resourceInputs["bootSha256"] = undefined /*out*/;
+            resourceInputs["kernelPath"] = undefined /*out*/;
+            resourceInputs["kernelSha256"] = undefined /*out*/;
+            resourceInputs["klibsDir"] = undefined /*out*/;
+        } else {
+            resourceInputs["architecture"] = undefined /*out*/;
+            resourceInputs["bootPath"] = undefined /*out*/;
+            resourceInputs["bootSha256"] = undefined /*out*/;
+            resourceInputs["kernelPath"] = undefined /*out*/;
+            resourceInputs["kernelSha256"] = undefined /*out*/;
+            resourceInputs["klibsDir"] = undefined /*out*/;
+            resourceInputs["opsHome"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(NanosKernel.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a NanosKernel resource.
+ */
+export interface NanosKernelArgs {
+    /**
+     * The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+     */
+    architecture?: pulumi.Input<string>;
+    /**
+     * The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+     */
+    expectedKernelSha256?: pulumi.Input<string>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
+    /**
+     * The nanos release version (e.g. '0.1.54')
+     */
+    version: pulumi.Input<string>;
+}
@@ -0,0 +1,196 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A local ops package built from a sysroot directory, to be used by a PackageImage
+ */
+export class Package extends pulumi.CustomResource {
+    /**
+     * Get an existing Package resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Package {
+        return new Package(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'nanovms:index:Package';
+
+    /**
+     * Returns true if the given object is an instance of Package.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Package {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Package.__pulumiType;
+    }
+
+    /**
+     * The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+     */
+    declare public readonly architecture: pulumi.Output<string | undefined>;
+    /**
+     * The path of the .tar.gz archive of the package
+     */
+    declare public /*out*/ readonly archivePath: pulumi.Output<string>;
+    /**
+     * The arguments to start the program with, after the program name
+     */
+    declare public readonly args: pulumi.Output<string[] | undefined>;
+    /**
+     * The digest of the manifest and all files in the sysroot of the package
+     */
+    declare public /*out*/ readonly contentHash: pulumi.Output<string>;
+    /**
+     * A description of the package
+     */
+    declare public readonly description: pulumi.Output<string | undefined>;
+    /**
+     * The environment variables of the program
+     */
+    declare public readonly env: pulumi.Output<{[key: string]: string} | undefined>;
+    /**
+     * The language of the program
+     */
+    declare public readonly language: pulumi.Output<string | undefined>;
+    /**
+     * The name of the package, without version
+     */
+    declare public readonly name: pulumi.Output<string>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    declare public readonly opsHome: pulumi.Output<string | undefined>;
+    /**
+     * The qualified name of the package (name_version), to use as packageName of a PackageImage with localPackage set
+     */
+    declare public /*out*/ readonly packageName: pulumi.Output<string>;
+    /**
+     * The directory of the package in the local packages of the ops home
+     */
+    declare public /*out*/ readonly packagePath: pulumi.Output<string>;
+    /**
+     * The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+     */
+    declare public readonly program: pulumi.Output<string>;
+    /**
+     * The directory with the files of the package, copied as its sysroot
+     */
+    declare public readonly sysroot: pulumi.Output<string>;
+    /**
+     * The version of the package
+     */
+    declare public readonly version: pulumi.Output<string>;
+
+    /**
+     * Create a Package resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: PackageArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.name === undefined && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            if (args?.program === undefined && !opts.urn) {
+                throw new Error("Missing required property 'program'");
+            }
+            if (args?.sysroot === undefined && !opts.urn) {
+                throw new Error("Missing required property 'sysroot'");
+            }
+            if (args?.version === undefined && !opts.urn) {
+                throw new Error("Missing required property 'version'");
+            }
+            resourceInputs["architecture"] = args?.architecture;
+            resourceInputs["args"] = args?.args;
+            resourceInputs["description"] = args?.description;
+            resourceInputs["env"] = args?.env;
+            resourceInputs["language"] = args?.language;
+            resourceInputs["name"] = args?.name;
+            resourceInputs["opsHome"] = args?.opsHome;
+            resourceInputs["program"] = args?.program;
+            resourceInputs["sysroot"] = args?.sysroot;
+            resourceInputs["version"] = args?.version;
+            resourceInputs["archivePath"] = undefined /*out*/;
+            resourceInputs["contentHash"] = undefined /*out*/;
+            resourceInputs["packageName"] = undefined /*out*/;
+            resourceInputs["packagePath"] = undefined /*out*/;
+        } else {
+            resourceInputs["architecture"] = undefined /*out*/;
+            resourceInputs["archivePath"] = undefined /*out*/;
+            resourceInputs["args"] = undefined /*out*/;
+            resourceInputs["contentHash"] = undefined /*out*/;
+            resourceInputs["description"] = undefined /*out*/;
+            resourceInputs["env"] = undefined /*out*/;
+            resourceInputs["language"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["opsHome"] = undefined /*out*/;
+            resourceInputs["packageName"] = undefined /*out*/;
+            resourceInputs["packagePath"] = undefined /*out*/;
+            resourceInputs["program"] = undefined /*out*/;
+            resourceInputs["sysroot"] = undefined /*out*/;
+            resourceInputs["version"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Package.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Package resource.
+ */
+export interface PackageArgs {
+    /**
+     * The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+     */
+    architecture?: pulumi.Input<string>;
+    /**
+     * The arguments to start the program with, after the program name
+     */
+    args?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * A description of the package
+     */
+    description?: pulumi.Input<string>;
+    /**
+     * The environment variables of the program
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The language of the program
+     */
+    language?: pulumi.Input<string>;
+    /**
+     * The name of the package, without version
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
+    /**
+     * The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+     */
+    program: pulumi.Input<string>;
+    /**
+     * The directory with the files of the package, copied as its sysroot
+     */
+    sysroot: pulumi.Input<string>;
+    /**
+     * The version of the package
+     */
+    version: pulumi.Input<string>;
+}
@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 /**
@@ -42,6 +44,14 @@ export class PackageImage extends pulumi.CustomResource {
      * The configuration of the built image as a JSON encoded string
      */
     declare public readonly config: pulumi.Output<string>;
+    /**
+     * The digest of the configuration of the container image used
+     */
+    declare public /*out*/ readonly containerDigest: pulumi.Output<string | undefined>;
+    /**
+     * The path to the container image used
+     */
+    declare public readonly containerImage: pulumi.Output<string | undefined>;
     /**
      * The name of the built image
      */
@@ -50,10 +60,26 @@ export class PackageImage extends pulumi.CustomResource {
      * The path to the built image
      */
     declare public /*out*/ readonly imagePath: pulumi.Output<string>;
+    /**
+     * The nanos kernel version the image is built with
+     */
+    declare public readonly kernelVersion: pulumi.Output<string | undefined>;
+    /**
+     * If the package is a local package
+     */
+    declare public readonly localPackage: pulumi.Output<boolean | undefined>;
+    /**
+     * The digest of the package.manifest of the package
+     */
+    declare public /*out*/ readonly manifestDigest: pulumi.Output<string | undefined>;
     /**
      * The name of the package used
      */
     declare public readonly packageName: pulumi.Output<string>;
+    /**
+     * The path to the package directory used
+     */
+    declare public readonly packagePath: pulumi.Output<string | undefined>;
     /**
      * The cloud provider of the built image
      */
@@ -77,27 +103,35 @@ export class PackageImage extends pulumi.CustomResource {
             if (args?.name === undefined && !opts.urn) {
                 throw new Error("Missing required property 'name'");
             }
-            if (args?.packageName === undefined && !opts.urn) {
-                throw new Error("Missing required property 'packageName'");
-            }
-            if (args?.provider === undefined && !opts.urn) {
-                throw new Error("Missing required property 'provider'");
-            }
             resourceInputs["architecture"] = args?.architecture;
             resourceInputs["config"] = args?.config;
+            resourceInputs["containerImage"] = args?.containerImage;
             resourceInputs["force"] = args?.force;
+            resourceInputs["kernelVersion"] = args?.kernelVersion;
+            resourceInputs["localPackage"] = args?.localPackage;
             resourceInputs["name"] = args?.name;
+            resourceInputs["opsConfig"] = args?.opsConfig;
+            resourceInputs["opsHome"] = args?.opsHome;
             resourceInputs["packageName"] = args?.packageName;
+            resourceInputs["packagePath"] = args?.packagePath;
             resourceInputs["provider"] = args?.provider;
             resourceInputs["useLatestKernel"] = args?.useLatestKernel;
+            resourceInputs["containerDigest"] = undefined /*out*/;
             resourceInputs["imageName"] = undefined /*out*/;
             resourceInputs["imagePath"] = undefined /*out*/;
+            resourceInputs["manifestDigest"] = undefined /*out*/;
         } else {
             resourceInputs["architecture"] = undefined /*out*/;
             resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["containerDigest"] = undefined /*out*/;
+            resourceInputs["containerImage"] = undefined /*out*/;
             resourceInputs["imageName"] = undefined /*out*/;
             resourceInputs["imagePath"] = undefined /*out*/;
+            resourceInputs["kernelVersion"] = undefined /*out*/;
+            resourceInputs["localPackage"] = undefined /*out*/;
+            resourceInputs["manifestDigest"] = undefined /*out*/;
             resourceInputs["packageName"] = undefined /*out*/;
+            resourceInputs["packagePath"] = undefined /*out*/;
             resourceInputs["provider"] = undefined /*out*/;
             resourceInputs["useLatestKernel"] = undefined /*out*/;
         }
@@ -115,25 +149,51 @@ export interface PackageImageArgs {
      */
     architecture?: pulumi.Input<string>;
     /**
-     * The configuration as a JSON encoded string
+     * The configuration as a JSON encoded string, merged on top of opsConfig
+     *
+     * @deprecated use opsConfig instead
      */
     config?: pulumi.Input<string>;
+    /**
+     * The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+     */
+    containerImage?: pulumi.Input<string>;
     /**
      * If an already existing image should be deleted if it exists
      */
     force?: pulumi.Input<boolean>;
+    /**
+     * The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+     */
+    kernelVersion?: pulumi.Input<string>;
+    /**
+     * If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+     */
+    localPackage?: pulumi.Input<boolean>;
     /**
      * The name of the image
      */
     name: pulumi.Input<string>;
     /**
-     * The name of the package to use (e.g., 'node_v18.7.0')
+     * The configuration of the image
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
+    /**
+     * The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+     */
+    packageName?: pulumi.Input<string>;
+    /**
+     * The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
      */
-    packageName: pulumi.Input<string>;
+    packagePath?: pulumi.Input<string>;
     /**
      * The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
      */
-    provider: pulumi.Input<string>;
+    provider?: pulumi.Input<string>;
     /**
      * If the latest kernel should be used, download it if necessary
      */
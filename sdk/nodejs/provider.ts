@@ -2,6 +2,8 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
 import * as utilities from "./utilities";
 
 export class Provider extends pulumi.ProviderResource {
@@ -19,6 +21,26 @@ export class Provider extends pulumi.ProviderResource {
         return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
     }
 
+    /**
+     * The default target architecture of package images (amd64 or arm64)
+     */
+    declare public readonly architecture: pulumi.Output<string | undefined>;
+    /**
+     * The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+     */
+    declare public readonly defaultProvider: pulumi.Output<string | undefined>;
+    /**
+     * The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+     */
+    declare public readonly kernelVersion: pulumi.Output<string | undefined>;
+    /**
+     * A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+     */
+    declare public readonly mirrorDir: pulumi.Output<string | undefined>;
+    /**
+     * The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+     */
+    declare public readonly opsHome: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -31,6 +53,13 @@ export class Provider extends pulumi.ProviderResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            resourceInputs["architecture"] = args?.architecture;
+            resourceInputs["cloudConfig"] = pulumi.output(args?.cloudConfig).apply(JSON.stringify);
+            resourceInputs["defaultProvider"] = args?.defaultProvider;
+            resourceInputs["kernelVersion"] = args?.kernelVersion;
+            resourceInputs["mirrorDir"] = (args?.mirrorDir) ?? (utilities.getEnv("NANOVMS_MIRROR_DIR") || "");
+            resourceInputs["offline"] = pulumi.output((args?.offline) ?? (utilities.getEnvBoolean("NANOVMS_OFFLINE") || false)).apply(JSON.stringify);
+            resourceInputs["opsHome"] = args?.opsHome;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
@@ -41,4 +70,32 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * The default target architecture of package images (amd64 or arm64)
+     */
+    architecture?: pulumi.Input<string>;
+    /**
+     * The default cloud provider settings, merged under the configuration of each resource
+     */
+    cloudConfig?: pulumi.Input<inputs.OpsCloudConfigArgs>;
+    /**
+     * The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+     */
+    defaultProvider?: pulumi.Input<string>;
+    /**
+     * The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+     */
+    kernelVersion?: pulumi.Input<string>;
+    /**
+     * A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+     */
+    mirrorDir?: pulumi.Input<string>;
+    /**
+     * If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+     */
+    offline?: pulumi.Input<boolean>;
+    /**
+     * The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
 }
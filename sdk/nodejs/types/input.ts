@@ -0,0 +1,905 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+import * as utilities from "./utilities";
+
+/**
+ * Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+ */
+export interface InstanceReadinessArgs {
+    /**
+     * The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+     */
+    httpPath?: pulumi.Input<string>;
+    /**
+     * The time between readiness checks (e.g. '2s')
+     */
+    pollInterval?: pulumi.Input<string>;
+    /**
+     * The TCP port that must accept connections before the instance is considered ready
+     */
+    port?: pulumi.Input<number>;
+    /**
+     * The maximum time to wait for the instance to become ready (e.g. '5m')
+     */
+    timeout?: pulumi.Input<string>;
+}
+/**
+ * instanceReadinessArgsProvideDefaults sets the appropriate defaults for InstanceReadinessArgs
+ */
+export function instanceReadinessArgsProvideDefaults(val: InstanceReadinessArgs): InstanceReadinessArgs {
+    return {
+        ...val,
+        pollInterval: (val.pollInterval) ?? "2s",
+        timeout: (val.timeout) ?? "5m",
+    };
+}
+
+/**
+ * The cloud provider specific configuration
+ */
+export interface OpsCloudConfig {
+    /**
+     * The bucket to store the image artifacts in
+     */
+    bucketName?: string;
+    /**
+     * The bucket namespace, required for oci
+     */
+    bucketNamespace?: string;
+    /**
+     * If confidential computing should be enabled
+     */
+    confidentialVM?: boolean;
+    /**
+     * The ID of the dedicated host to run on
+     */
+    dedicatedHostID?: string;
+    /**
+     * The domain name to create a DNS record for
+     */
+    domainName?: string;
+    /**
+     * If IPv6 should be enabled when creating a VPC
+     */
+    enableIPv6?: boolean;
+    /**
+     * The instance flavor or machine type
+     */
+    flavor?: string;
+    /**
+     * The image type
+     */
+    imageType?: string;
+    /**
+     * The IAM instance profile (aws)
+     */
+    instanceProfile?: string;
+    /**
+     * The KMS key to encrypt images with, 'default' or an arn (aws)
+     */
+    kms?: string;
+    /**
+     * The cloud platform
+     */
+    platform?: string;
+    /**
+     * The project ID (gcp)
+     */
+    projectID?: string;
+    /**
+     * Settings for the root volume
+     */
+    rootVolume?: inputs.OpsCloudVolume;
+    /**
+     * The security group
+     */
+    securityGroup?: string;
+    /**
+     * Skip verifying that a vm importer role exists (aws)
+     */
+    skipImportVerify?: boolean;
+    /**
+     * If spot provisioning should be used
+     */
+    spot?: boolean;
+    /**
+     * The static public IP to assign
+     */
+    staticIP?: string;
+    /**
+     * The subnet
+     */
+    subnet?: string;
+    /**
+     * Tags (labels) for images and instances
+     */
+    tags?: inputs.OpsTag[];
+    /**
+     * User data passed to the instance
+     */
+    userData?: string;
+    /**
+     * The VPC
+     */
+    vpc?: string;
+    /**
+     * The zone or region
+     */
+    zone?: string;
+}
+
+/**
+ * The cloud provider specific configuration
+ */
+export interface OpsCloudConfigArgs {
+    /**
+     * The bucket to store the image artifacts in
+     */
+    bucketName?: pulumi.Input<string>;
+    /**
+     * The bucket namespace, required for oci
+     */
+    bucketNamespace?: pulumi.Input<string>;
+    /**
+     * If confidential computing should be enabled
+     */
+    confidentialVM?: pulumi.Input<boolean>;
+    /**
+     * The ID of the dedicated host to run on
+     */
+    dedicatedHostID?: pulumi.Input<string>;
+    /**
+     * The domain name to create a DNS record for
+     */
+    domainName?: pulumi.Input<string>;
+    /**
+     * If IPv6 should be enabled when creating a VPC
+     */
+    enableIPv6?: pulumi.Input<boolean>;
+    /**
+     * The instance flavor or machine type
+     */
+    flavor?: pulumi.Input<string>;
+    /**
+     * The image type
+     */
+    imageType?: pulumi.Input<string>;
+    /**
+     * The IAM instance profile (aws)
+     */
+    instanceProfile?: pulumi.Input<string>;
+    /**
+     * The KMS key to encrypt images with, 'default' or an arn (aws)
+     */
+    kms?: pulumi.Input<string>;
+    /**
+     * The cloud platform
+     */
+    platform?: pulumi.Input<string>;
+    /**
+     * The project ID (gcp)
+     */
+    projectID?: pulumi.Input<string>;
+    /**
+     * Settings for the root volume
+     */
+    rootVolume?: pulumi.Input<inputs.OpsCloudVolumeArgs>;
+    /**
+     * The security group
+     */
+    securityGroup?: pulumi.Input<string>;
+    /**
+     * Skip verifying that a vm importer role exists (aws)
+     */
+    skipImportVerify?: pulumi.Input<boolean>;
+    /**
+     * If spot provisioning should be used
+     */
+    spot?: pulumi.Input<boolean>;
+    /**
+     * The static public IP to assign
+     */
+    staticIP?: pulumi.Input<string>;
+    /**
+     * The subnet
+     */
+    subnet?: pulumi.Input<string>;
+    /**
+     * Tags (labels) for images and instances
+     */
+    tags?: pulumi.Input<pulumi.Input<inputs.OpsTagArgs>[]>;
+    /**
+     * User data passed to the instance
+     */
+    userData?: pulumi.Input<string>;
+    /**
+     * The VPC
+     */
+    vpc?: pulumi.Input<string>;
+    /**
+     * The zone or region
+     */
+    zone?: pulumi.Input<string>;
+}
+
+/**
+ * Cloud volume settings
+ */
+export interface OpsCloudVolume {
+    /**
+     * The provisioned IOPS
+     */
+    iops?: number;
+    /**
+     * The name of the volume
+     */
+    name?: string;
+    /**
+     * The size of the volume in GB
+     */
+    size?: number;
+    /**
+     * The provisioned throughput
+     */
+    throughput?: number;
+    /**
+     * The volume type
+     */
+    typeof?: string;
+}
+
+/**
+ * Cloud volume settings
+ */
+export interface OpsCloudVolumeArgs {
+    /**
+     * The provisioned IOPS
+     */
+    iops?: pulumi.Input<number>;
+    /**
+     * The name of the volume
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The size of the volume in GB
+     */
+    size?: pulumi.Input<number>;
+    /**
+     * The provisioned throughput
+     */
+    throughput?: pulumi.Input<number>;
+    /**
+     * The volume type
+     */
+    typeof?: pulumi.Input<string>;
+}
+
+/**
+ * The nanovms ops configuration, mirrors the ops JSON configuration file
+ */
+export interface OpsConfig {
+    /**
+     * The arguments passed to the program when the image is launched
+     */
+    args?: string[];
+    /**
+     * The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+     */
+    baseVolumeSz?: string;
+    /**
+     * The path to the boot image
+     */
+    boot?: string;
+    /**
+     * The cloud provider specific configuration
+     */
+    cloudConfig?: inputs.OpsCloudConfig;
+    /**
+     * The kernel debug flags
+     */
+    debugflags?: string[];
+    /**
+     * The description of the image
+     */
+    description?: string;
+    /**
+     * Local directories to include into the image
+     */
+    dirs?: string[];
+    /**
+     * Environment variables for the image runtime
+     */
+    env?: {[key: string]: string};
+    /**
+     * Local files to include into the image
+     */
+    files?: string[];
+    /**
+     * The path to the kernel image
+     */
+    kernel?: string;
+    /**
+     * The host location of the klibs
+     */
+    klibDir?: string;
+    /**
+     * The klibs to include into the image (e.g. 'tls', 'ntp')
+     */
+    klibs?: string[];
+    /**
+     * The language of the program
+     */
+    language?: string;
+    /**
+     * The parent directory of the files and directories specified in files and dirs
+     */
+    localFilesParentDirectory?: string;
+    /**
+     * Options passed straight through to the image manifest
+     */
+    manifestPassthrough?: {[key: string]: any};
+    /**
+     * Local directories (keys) to map to a path in the image (values)
+     */
+    mapDirs?: {[key: string]: string};
+    /**
+     * Volumes (keys) to mount at a path in the image (values)
+     */
+    mounts?: {[key: string]: string};
+    /**
+     * The DNS servers to use, defaults to '8.8.8.8'
+     */
+    nameServers?: string[];
+    /**
+     * The nanos kernel version
+     */
+    nanosVersion?: string;
+    /**
+     * If the nightly kernel build should be used
+     */
+    nightlyBuild?: boolean;
+    /**
+     * Syscalls to exclude from tracing
+     */
+    noTrace?: string[];
+    /**
+     * If the image should reboot automatically when an error occurs
+     */
+    rebootOnExit?: boolean;
+    /**
+     * The runtime configuration
+     */
+    runConfig?: inputs.OpsRunConfig;
+    /**
+     * Configuration specific to the target provider
+     */
+    targetConfig?: {[key: string]: string};
+    /**
+     * The target root filesystem
+     */
+    targetRoot?: string;
+    /**
+     * If the deprecated TFS version 4 encoding should be used
+     */
+    tfsv4?: boolean;
+    /**
+     * If the image should support booting via UEFI
+     */
+    uefi?: boolean;
+    /**
+     * The version of the image
+     */
+    version?: string;
+}
+
+/**
+ * The nanovms ops configuration, mirrors the ops JSON configuration file
+ */
+export interface OpsConfigArgs {
+    /**
+     * The arguments passed to the program when the image is launched
+     */
+    args?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+     */
+    baseVolumeSz?: pulumi.Input<string>;
+    /**
+     * The path to the boot image
+     */
+    boot?: pulumi.Input<string>;
+    /**
+     * The cloud provider specific configuration
+     */
+    cloudConfig?: pulumi.Input<inputs.OpsCloudConfigArgs>;
+    /**
+     * The kernel debug flags
+     */
+    debugflags?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The description of the image
+     */
+    description?: pulumi.Input<string>;
+    /**
+     * Local directories to include into the image
+     */
+    dirs?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Environment variables for the image runtime
+     */
+    env?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Local files to include into the image
+     */
+    files?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The path to the kernel image
+     */
+    kernel?: pulumi.Input<string>;
+    /**
+     * The host location of the klibs
+     */
+    klibDir?: pulumi.Input<string>;
+    /**
+     * The klibs to include into the image (e.g. 'tls', 'ntp')
+     */
+    klibs?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The language of the program
+     */
+    language?: pulumi.Input<string>;
+    /**
+     * The parent directory of the files and directories specified in files and dirs
+     */
+    localFilesParentDirectory?: pulumi.Input<string>;
+    /**
+     * Options passed straight through to the image manifest
+     */
+    manifestPassthrough?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Local directories (keys) to map to a path in the image (values)
+     */
+    mapDirs?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * Volumes (keys) to mount at a path in the image (values)
+     */
+    mounts?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The DNS servers to use, defaults to '8.8.8.8'
+     */
+    nameServers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The nanos kernel version
+     */
+    nanosVersion?: pulumi.Input<string>;
+    /**
+     * If the nightly kernel build should be used
+     */
+    nightlyBuild?: pulumi.Input<boolean>;
+    /**
+     * Syscalls to exclude from tracing
+     */
+    noTrace?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * If the image should reboot automatically when an error occurs
+     */
+    rebootOnExit?: pulumi.Input<boolean>;
+    /**
+     * The runtime configuration
+     */
+    runConfig?: pulumi.Input<inputs.OpsRunConfigArgs>;
+    /**
+     * Configuration specific to the target provider
+     */
+    targetConfig?: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The target root filesystem
+     */
+    targetRoot?: pulumi.Input<string>;
+    /**
+     * If the deprecated TFS version 4 encoding should be used
+     */
+    tfsv4?: pulumi.Input<boolean>;
+    /**
+     * If the image should support booting via UEFI
+     */
+    uefi?: pulumi.Input<boolean>;
+    /**
+     * The version of the image
+     */
+    version?: pulumi.Input<string>;
+}
+
+/**
+ * A network card configuration
+ */
+export interface OpsNic {
+    /**
+     * The name of the bridge
+     */
+    bridgeName?: string;
+    /**
+     * The gateway IP address
+     */
+    gateway?: string;
+    /**
+     * The IP address
+     */
+    ipAddress?: string;
+    /**
+     * The IPv6 address
+     */
+    ipv6Address?: string;
+    /**
+     * The network mask
+     */
+    netMask?: string;
+}
+
+/**
+ * A network card configuration
+ */
+export interface OpsNicArgs {
+    /**
+     * The name of the bridge
+     */
+    bridgeName?: pulumi.Input<string>;
+    /**
+     * The gateway IP address
+     */
+    gateway?: pulumi.Input<string>;
+    /**
+     * The IP address
+     */
+    ipAddress?: pulumi.Input<string>;
+    /**
+     * The IPv6 address
+     */
+    ipv6Address?: pulumi.Input<string>;
+    /**
+     * The network mask
+     */
+    netMask?: pulumi.Input<string>;
+}
+
+/**
+ * The runtime configuration
+ */
+export interface OpsRunConfig {
+    /**
+     * If hardware acceleration should be enabled
+     */
+    accel?: boolean;
+    /**
+     * A hook to run after the instance stops
+     */
+    atExit?: string;
+    /**
+     * If the volumes in mounts should be attached when the instance is created
+     */
+    attachVolumeOnInstanceCreate?: boolean;
+    /**
+     * The IP address of the bridge
+     */
+    bridgeIPAddress?: string;
+    /**
+     * The name of the bridge
+     */
+    bridgeName?: string;
+    /**
+     * If bridged networking should be used
+     */
+    bridged?: boolean;
+    /**
+     * If IP forwarding should be enabled (gcp)
+     */
+    canIPForward?: boolean;
+    /**
+     * The number of CPU cores
+     */
+    cpus?: number;
+    /**
+     * If debugging should be enabled
+     */
+    debug?: boolean;
+    /**
+     * The gateway IP address
+     */
+    gateway?: string;
+    /**
+     * The port for the gdb server
+     */
+    gdbPort?: number;
+    /**
+     * The GPU type
+     */
+    gpuType?: string;
+    /**
+     * The number of GPUs
+     */
+    gpus?: number;
+    /**
+     * The instance group
+     */
+    instanceGroup?: string;
+    /**
+     * The name of the instance
+     */
+    instanceName?: string;
+    /**
+     * The static IP address
+     */
+    ipAddress?: string;
+    /**
+     * The static IPv6 address
+     */
+    ipv6Address?: string;
+    /**
+     * The amount of memory, optionally suffixed with 'M' or 'G'
+     */
+    memory?: string;
+    /**
+     * The management port for QMP access (onprem)
+     */
+    mgmt?: string;
+    /**
+     * Volumes to mount, in the form '<volume>:<path>'
+     */
+    mounts?: string[];
+    /**
+     * The network mask
+     */
+    netMask?: string;
+    /**
+     * Pre-configured network cards (proxmox)
+     */
+    nics?: inputs.OpsNic[];
+    /**
+     * The TCP ports to expose
+     */
+    ports?: string[];
+    /**
+     * If the QMP interface should be enabled (onprem)
+     */
+    qmp?: boolean;
+    /**
+     * If debug messages should be shown
+     */
+    showDebug?: boolean;
+    /**
+     * If errors should be shown
+     */
+    showErrors?: boolean;
+    /**
+     * If warnings should be shown
+     */
+    showWarnings?: boolean;
+    /**
+     * The name of the tap device
+     */
+    tapName?: string;
+    /**
+     * The number of threads per physical core
+     */
+    threadsPerCore?: number;
+    /**
+     * The UDP ports to expose
+     */
+    udpPorts?: string[];
+    /**
+     * If verbose logging should be enabled
+     */
+    verbose?: boolean;
+    /**
+     * If a VGA output device should be emulated
+     */
+    vga?: boolean;
+    /**
+     * The volume size in GB (openstack)
+     */
+    volumeSizeInGb?: number;
+}
+
+/**
+ * The runtime configuration
+ */
+export interface OpsRunConfigArgs {
+    /**
+     * If hardware acceleration should be enabled
+     */
+    accel?: pulumi.Input<boolean>;
+    /**
+     * A hook to run after the instance stops
+     */
+    atExit?: pulumi.Input<string>;
+    /**
+     * If the volumes in mounts should be attached when the instance is created
+     */
+    attachVolumeOnInstanceCreate?: pulumi.Input<boolean>;
+    /**
+     * The IP address of the bridge
+     */
+    bridgeIPAddress?: pulumi.Input<string>;
+    /**
+     * The name of the bridge
+     */
+    bridgeName?: pulumi.Input<string>;
+    /**
+     * If bridged networking should be used
+     */
+    bridged?: pulumi.Input<boolean>;
+    /**
+     * If IP forwarding should be enabled (gcp)
+     */
+    canIPForward?: pulumi.Input<boolean>;
+    /**
+     * The number of CPU cores
+     */
+    cpus?: pulumi.Input<number>;
+    /**
+     * If debugging should be enabled
+     */
+    debug?: pulumi.Input<boolean>;
+    /**
+     * The gateway IP address
+     */
+    gateway?: pulumi.Input<string>;
+    /**
+     * The port for the gdb server
+     */
+    gdbPort?: pulumi.Input<number>;
+    /**
+     * The GPU type
+     */
+    gpuType?: pulumi.Input<string>;
+    /**
+     * The number of GPUs
+     */
+    gpus?: pulumi.Input<number>;
+    /**
+     * The instance group
+     */
+    instanceGroup?: pulumi.Input<string>;
+    /**
+     * The name of the instance
+     */
+    instanceName?: pulumi.Input<string>;
+    /**
+     * The static IP address
+     */
+    ipAddress?: pulumi.Input<string>;
+    /**
+     * The static IPv6 address
+     */
+    ipv6Address?: pulumi.Input<string>;
+    /**
+     * The amount of memory, optionally suffixed with 'M' or 'G'
+     */
+    memory?: pulumi.Input<string>;
+    /**
+     * The management port for QMP access (onprem)
+     */
+    mgmt?: pulumi.Input<string>;
+    /**
+     * Volumes to mount, in the form '<volume>:<path>'
+     */
+    mounts?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The network mask
+     */
+    netMask?: pulumi.Input<string>;
+    /**
+     * Pre-configured network cards (proxmox)
+     */
+    nics?: pulumi.Input<pulumi.Input<inputs.OpsNicArgs>[]>;
+    /**
+     * The TCP ports to expose
+     */
+    ports?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * If the QMP interface should be enabled (onprem)
+     */
+    qmp?: pulumi.Input<boolean>;
+    /**
+     * If debug messages should be shown
+     */
+    showDebug?: pulumi.Input<boolean>;
+    /**
+     * If errors should be shown
+     */
+    showErrors?: pulumi.Input<boolean>;
+    /**
+     * If warnings should be shown
+     */
+    showWarnings?: pulumi.Input<boolean>;
+    /**
+     * The name of the tap device
+     */
+    tapName?: pulumi.Input<string>;
+    /**
+     * The number of threads per physical core
+     */
+    threadsPerCore?: pulumi.Input<number>;
+    /**
+     * The UDP ports to expose
+     */
+    udpPorts?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * If verbose logging should be enabled
+     */
+    verbose?: pulumi.Input<boolean>;
+    /**
+     * If a VGA output device should be emulated
+     */
+    vga?: pulumi.Input<boolean>;
+    /**
+     * The volume size in GB (openstack)
+     */
+    volumeSizeInGb?: pulumi.Input<number>;
+}
+
+/**
+ * A tag (label) for images and instances
+ */
+export interface OpsTag {
+    /**
+     * If the tag should be used as an image label
+     */
+    imageLabel?: boolean;
+    /**
+     * If the tag should be used as an instance label
+     */
+    instanceLabel?: boolean;
+    /**
+     * If the tag should be used as instance metadata
+     */
+    instanceMetadata?: boolean;
+    /**
+     * If the tag value should be used as an instance network tag
+     */
+    instanceNetwork?: boolean;
+    /**
+     * The tag key
+     */
+    key: string;
+    /**
+     * The tag value
+     */
+    value: string;
+}
+
+/**
+ * A tag (label) for images and instances
+ */
+export interface OpsTagArgs {
+    /**
+     * If the tag should be used as an image label
+     */
+    imageLabel?: pulumi.Input<boolean>;
+    /**
+     * If the tag should be used as an instance label
+     */
+    instanceLabel?: pulumi.Input<boolean>;
+    /**
+     * If the tag should be used as instance metadata
+     */
+    instanceMetadata?: pulumi.Input<boolean>;
+    /**
+     * If the tag value should be used as an instance network tag
+     */
+    instanceNetwork?: pulumi.Input<boolean>;
+    /**
+     * The tag key
+     */
+    key: pulumi.Input<string>;
+    /**
+     * The tag value
+     */
+    value: pulumi.Input<string>;
+}
+
@@ -0,0 +1,256 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+
+import * as utilities from "./utilities";
+
+export interface ImageInfo {
+    /**
+     * The creation time of the image (RFC 3339)
+     */
+    created: string;
+    /**
+     * The provider ID of the image
+     */
+    imageID: string;
+    /**
+     * The labels of the image
+     */
+    labels: string[];
+    /**
+     * The name of the image
+     */
+    name: string;
+    /**
+     * The (local) path of the image
+     */
+    path: string;
+    /**
+     * The size of the image in bytes
+     */
+    size: number;
+    /**
+     * The status of the image
+     */
+    status: string;
+}
+
+export interface InstanceInfo {
+    /**
+     * The creation time of the instance as reported by the cloud provider
+     */
+    created: string;
+    /**
+     * The image the instance runs
+     */
+    image: string;
+    /**
+     * The name of the instance
+     */
+    name: string;
+    /**
+     * The provider instance ID
+     */
+    pid: string;
+    /**
+     * The ports of the instance
+     */
+    ports: string[];
+    /**
+     * The private IP addresses of the instance
+     */
+    private_ips: string[];
+    /**
+     * The public IP addresses of the instance
+     */
+    public_ips: string[];
+    /**
+     * The status of the instance
+     */
+    status: string;
+}
+
+/**
+ * The cloud provider specific configuration
+ */
+export interface OpsCloudConfig {
+    /**
+     * The bucket to store the image artifacts in
+     */
+    bucketName?: string;
+    /**
+     * The bucket namespace, required for oci
+     */
+    bucketNamespace?: string;
+    /**
+     * If confidential computing should be enabled
+     */
+    confidentialVM?: boolean;
+    /**
+     * The ID of the dedicated host to run on
+     */
+    dedicatedHostID?: string;
+    /**
+     * The domain name to create a DNS record for
+     */
+    domainName?: string;
+    /**
+     * If IPv6 should be enabled when creating a VPC
+     */
+    enableIPv6?: boolean;
+    /**
+     * The instance flavor or machine type
+     */
+    flavor?: string;
+    /**
+     * The image type
+     */
+    imageType?: string;
+    /**
+     * The IAM instance profile (aws)
+     */
+    instanceProfile?: string;
+    /**
+     * The KMS key to encrypt images with, 'default' or an arn (aws)
+     */
+    kms?: string;
+    /**
+     * The cloud platform
+     */
+    platform?: string;
+    /**
+     * The project ID (gcp)
+     */
+    projectID?: string;
+    /**
+     * Settings for the root volume
+     */
+    rootVolume?: outputs.OpsCloudVolume;
+    /**
+     * The security group
+     */
+    securityGroup?: string;
+    /**
+     * Skip verifying that a vm importer role exists (aws)
+     */
+    skipImportVerify?: boolean;
+    /**
+     * If spot provisioning should be used
+     */
+    spot?: boolean;
+    /**
+     * The static public IP to assign
+     */
+    staticIP?: string;
+    /**
+     * The subnet
+     */
+    subnet?: string;
+    /**
+     * Tags (labels) for images and instances
+     */
+    tags?: outputs.OpsTag[];
+    /**
+     * User data passed to the instance
+     */
+    userData?: string;
+    /**
+     * The VPC
+     */
+    vpc?: string;
+    /**
+     * The zone or region
+     */
+    zone?: string;
+}
+
+/**
+ * Cloud volume settings
+ */
+export interface OpsCloudVolume {
+    /**
+     * The provisioned IOPS
+     */
+    iops?: number;
+    /**
+     * The name of the volume
+     */
+    name?: string;
+    /**
+     * The size of the volume in GB
+     */
+    size?: number;
+    /**
+     * The provisioned throughput
+     */
+    throughput?: number;
+    /**
+     * The volume type
+     */
+    typeof?: string;
+}
+
+/**
+ * A tag (label) for images and instances
+ */
+export interface OpsTag {
+    /**
+     * If the tag should be used as an image label
+     */
+    imageLabel?: boolean;
+    /**
+     * If the tag should be used as an instance label
+     */
+    instanceLabel?: boolean;
+    /**
+     * If the tag should be used as instance metadata
+     */
+    instanceMetadata?: boolean;
+    /**
+     * If the tag value should be used as an instance network tag
+     */
+    instanceNetwork?: boolean;
+    /**
+     * The tag key
+     */
+    key: string;
+    /**
+     * The tag value
+     */
+    value: string;
+}
+
+export interface VolumeInfo {
+    /**
+     * The instance the volume is attached to
+     */
+    attachedTo: string;
+    /**
+     * The creation time of the volume as reported by the cloud provider
+     */
+    created: string;
+    /**
+     * The name of the volume
+     */
+    name: string;
+    /**
+     * The (local) path of the volume
+     */
+    path: string;
+    /**
+     * The size of the volume as reported by the cloud provider
+     */
+    size: string;
+    /**
+     * The status of the volume
+     */
+    status: string;
+    /**
+     * The provider ID of the volume
+     */
+    volumeID: string;
+}
+
@@ -0,0 +1,175 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * A NanoVMs volume resource for persistent data disks
+ */
+export class Volume extends pulumi.CustomResource {
+    /**
+     * Get an existing Volume resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): Volume {
+        return new Volume(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'nanovms:index:Volume';
+
+    /**
+     * Returns true if the given object is an instance of Volume.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is Volume {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === Volume.__pulumiType;
+    }
+
+    /**
+     * The instance the volume is attached to
+     */
+    declare public /*out*/ readonly attachedTo: pulumi.Output<string>;
+    /**
+     * The configuration used to create the volume as a JSON encoded string
+     */
+    declare public /*out*/ readonly config: pulumi.Output<string>;
+    /**
+     * The path to the local directory the volume was filled with
+     */
+    declare public readonly data: pulumi.Output<string>;
+    /**
+     * The provisioned IOPS for the volume
+     */
+    declare public readonly iops: pulumi.Output<number>;
+    /**
+     * The name (label) of the volume
+     */
+    declare public readonly name: pulumi.Output<string>;
+    /**
+     * The local path of the volume (onprem)
+     */
+    declare public /*out*/ readonly path: pulumi.Output<string>;
+    /**
+     * The cloud provider of the volume
+     */
+    declare public readonly provider: pulumi.Output<string>;
+    /**
+     * The size of the volume
+     */
+    declare public readonly size: pulumi.Output<string>;
+    /**
+     * The status of the volume
+     */
+    declare public /*out*/ readonly status: pulumi.Output<string>;
+    /**
+     * The provisioned throughput for the volume
+     */
+    declare public readonly throughput: pulumi.Output<number>;
+    /**
+     * The provider specific volume type
+     */
+    declare public readonly typeof: pulumi.Output<string>;
+    /**
+     * The provider ID of the volume (the UUID for onprem volumes)
+     */
+    declare public /*out*/ readonly volumeID: pulumi.Output<string>;
+
+    /**
+     * Create a Volume resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: VolumeArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.name === undefined && !opts.urn) {
+                throw new Error("Missing required property 'name'");
+            }
+            resourceInputs["data"] = args?.data;
+            resourceInputs["iops"] = args?.iops;
+            resourceInputs["name"] = args?.name;
+            resourceInputs["opsConfig"] = args?.opsConfig;
+            resourceInputs["opsHome"] = args?.opsHome;
+            resourceInputs["provider"] = args?.provider;
+            resourceInputs["size"] = args?.size;
+            resourceInputs["throughput"] = args?.throughput;
+            resourceInputs["typeof"] = args?.typeof;
+            resourceInputs["attachedTo"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+            resourceInputs["volumeID"] = undefined /*out*/;
+        } else {
+            resourceInputs["attachedTo"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["data"] = undefined /*out*/;
+            resourceInputs["iops"] = undefined /*out*/;
+            resourceInputs["name"] = undefined /*out*/;
+            resourceInputs["path"] = undefined /*out*/;
+            resourceInputs["provider"] = undefined /*out*/;
+            resourceInputs["size"] = undefined /*out*/;
+            resourceInputs["status"] = undefined /*out*/;
+            resourceInputs["throughput"] = undefined /*out*/;
+            resourceInputs["typeof"] = undefined /*out*/;
+            resourceInputs["volumeID"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(Volume.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a Volume resource.
+ */
+export interface VolumeArgs {
+    /**
+     * The path to a local directory to fill the volume with, an empty volume is created if not set
+     */
+    data?: pulumi.Input<string>;
+    /**
+     * The provisioned IOPS for the volume
+     */
+    iops?: pulumi.Input<number>;
+    /**
+     * The name (label) of the volume
+     */
+    name: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as zone and bucket
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
+    /**
+     * The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+     */
+    provider?: pulumi.Input<string>;
+    /**
+     * The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it
+     */
+    size?: pulumi.Input<string>;
+    /**
+     * The provisioned throughput for the volume
+     */
+    throughput?: pulumi.Input<number>;
+    /**
+     * The provider specific volume type
+     */
+    typeof?: pulumi.Input<string>;
+}
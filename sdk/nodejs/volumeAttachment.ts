@@ -0,0 +1,141 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as utilities from "./utilities";
+
+/**
+ * Attaches a NanoVMs volume to a running instance
+ */
+export class VolumeAttachment extends pulumi.CustomResource {
+    /**
+     * Get an existing VolumeAttachment resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): VolumeAttachment {
+        return new VolumeAttachment(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'nanovms:index:VolumeAttachment';
+
+    /**
+     * Returns true if the given object is an instance of VolumeAttachment.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is VolumeAttachment {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === VolumeAttachment.__pulumiType;
+    }
+
+    /**
+     * The persistent disk ID the volume is attached as
+     */
+    declare public readonly attachID: pulumi.Output<number | undefined>;
+    /**
+     * The configuration used to attach the volume as a JSON encoded string
+     */
+    declare public readonly config: pulumi.Output<string>;
+    /**
+     * The ID (name) of the instance the volume is attached to
+     */
+    declare public readonly instance: pulumi.Output<string>;
+    /**
+     * The path the volume is mounted at in the instance
+     */
+    declare public readonly mountPath: pulumi.Output<string>;
+    /**
+     * The cloud provider of the instance and volume
+     */
+    declare public readonly provider: pulumi.Output<string>;
+    /**
+     * The name of the attached volume
+     */
+    declare public readonly volume: pulumi.Output<string>;
+
+    /**
+     * Create a VolumeAttachment resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: VolumeAttachmentArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if (args?.instance === undefined && !opts.urn) {
+                throw new Error("Missing required property 'instance'");
+            }
+            if (args?.mountPath === undefined && !opts.urn) {
+                throw new Error("Missing required property 'mountPath'");
+            }
+            if (args?.volume === undefined && !opts.urn) {
+                throw new Error("Missing required property 'volume'");
+            }
+            resourceInputs["attachID"] = args?.attachID;
+            resourceInputs["config"] = args?.config;
+            resourceInputs["instance"] = args?.instance;
+            resourceInputs["mountPath"] = args?.mountPath;
+            resourceInputs["opsConfig"] = args?.opsConfig;
+            resourceInputs["opsHome"] = args?.opsHome;
+            resourceInputs["provider"] = args?.provider;
+            resourceInputs["volume"] = args?.volume;
+        } else {
+            resourceInputs["attachID"] = undefined /*out*/;
+            resourceInputs["config"] = undefined /*out*/;
+            resourceInputs["instance"] = undefined /*out*/;
+            resourceInputs["mountPath"] = undefined /*out*/;
+            resourceInputs["provider"] = undefined /*out*/;
+            resourceInputs["volume"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(VolumeAttachment.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a VolumeAttachment resource.
+ */
+export interface VolumeAttachmentArgs {
+    /**
+     * The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+     */
+    attachID?: pulumi.Input<number>;
+    /**
+     * The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+     */
+    config?: pulumi.Input<string>;
+    /**
+     * The ID (name) of the instance to attach the volume to
+     */
+    instance: pulumi.Input<string>;
+    /**
+     * The path the volume is mounted at in the instance, must match the mounts of the image
+     */
+    mountPath: pulumi.Input<string>;
+    /**
+     * The configuration, used for the cloud provider settings such as zone
+     */
+    opsConfig?: pulumi.Input<inputs.OpsConfigArgs>;
+    /**
+     * The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+     */
+    opsHome?: pulumi.Input<string>;
+    /**
+     * The cloud provider of the instance and volume
+     */
+    provider?: pulumi.Input<string>;
+    /**
+     * The name of the volume to attach
+     */
+    volume: pulumi.Input<string>;
+}
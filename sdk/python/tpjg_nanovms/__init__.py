@@ -0,0 +1,62 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+from . import _utilities
+import typing
+# Export this package's modules as members:
+from .get_image import *
+from .get_images import *
+from .get_instance import *
+from .get_instance_logs import *
+from .get_instances import *
+from .get_volumes import *
+from .image import *
+from .instance import *
+from .nanos_kernel import *
+from .package import *
+from .package_image import *
+from .provider import *
+from .volume import *
+from .volume_attachment import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+if typing.TYPE_CHECKING:
+    import tpjg_nanovms.config as __config
+    config = __config
+else:
+    config = _utilities.lazy_import('tpjg_nanovms.config')
+
+_utilities.register(
+    resource_modules="""
+[
+ {
+  "pkg": "nanovms",
+  "mod": "index",
+  "fqn": "tpjg_nanovms",
+  "classes": {
+   "nanovms:index:Image": "Image",
+   "nanovms:index:Instance": "Instance",
+   "nanovms:index:NanosKernel": "NanosKernel",
+   "nanovms:index:Package": "Package",
+   "nanovms:index:PackageImage": "PackageImage",
+   "nanovms:index:Volume": "Volume",
+   "nanovms:index:VolumeAttachment": "VolumeAttachment"
+  }
+ }
+]
+""",
+    resource_packages="""
+[
+ {
+  "pkg": "nanovms",
+  "token": "pulumi:providers:nanovms",
+  "fqn": "tpjg_nanovms",
+  "class": "Provider"
+ }
+]
+"""
+)
@@ -0,0 +1,4295 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = [
+    'InstanceReadinessArgs',
+    'InstanceReadinessArgsDict',
+    'OpsCloudConfig',
+    'OpsCloudConfigDict',
+    'OpsCloudConfigArgs',
+    'OpsCloudConfigArgsDict',
+    'OpsCloudVolume',
+    'OpsCloudVolumeDict',
+    'OpsCloudVolumeArgs',
+    'OpsCloudVolumeArgsDict',
+    'OpsConfig',
+    'OpsConfigDict',
+    'OpsConfigArgs',
+    'OpsConfigArgsDict',
+    'OpsNic',
+    'OpsNicDict',
+    'OpsNicArgs',
+    'OpsNicArgsDict',
+    'OpsRunConfig',
+    'OpsRunConfigDict',
+    'OpsRunConfigArgs',
+    'OpsRunConfigArgsDict',
+    'OpsTag',
+    'OpsTagDict',
+    'OpsTagArgs',
+    'OpsTagArgsDict',
+]
+
+MYPY = False
+
+if not MYPY:
+    class InstanceReadinessArgsDict(TypedDict):
+        """
+        Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+        """
+        http_path: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+        """
+        poll_interval: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The time between readiness checks (e.g. '2s')
+        """
+        port: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The TCP port that must accept connections before the instance is considered ready
+        """
+        timeout: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The maximum time to wait for the instance to become ready (e.g. '5m')
+        """
+elif False:
+    InstanceReadinessArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class InstanceReadinessArgs:
+    def __init__(__self__, *,
+                 http_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 poll_interval: Optional[pulumi.Input[_builtins.str]] = None,
+                 port: Optional[pulumi.Input[_builtins.int]] = None,
+                 timeout: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        Waits until the instance reports a running status and optionally answers on a TCP port or HTTP path
+        :param pulumi.Input[_builtins.str] http_path: The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+        :param pulumi.Input[_builtins.str] poll_interval: The time between readiness checks (e.g. '2s')
+        :param pulumi.Input[_builtins.int] port: The TCP port that must accept connections before the instance is considered ready
+        :param pulumi.Input[_builtins.str] timeout: The maximum time to wait for the instance to become ready (e.g. '5m')
+        """
+        if http_path is not None:
+            pulumi.set(__self__, "http_path", http_path)
+        if poll_interval is None:
+            poll_interval = '2s'
+        if poll_interval is not None:
+            pulumi.set(__self__, "poll_interval", poll_interval)
+        if port is not None:
+            pulumi.set(__self__, "port", port)
+        if timeout is None:
+            timeout = '5m'
+        if timeout is not None:
+            pulumi.set(__self__, "timeout", timeout)
+
+    @_builtins.property
+    @pulumi.getter(name="httpPath")
+    def http_path(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The HTTP path (e.g. '/health') on port that must answer with a non-error status code
+        """
+        return pulumi.get(self, "http_path")
+
+    @http_path.setter
+    def http_path(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "http_path", value)
+
+    @_builtins.property
+    @pulumi.getter(name="pollInterval")
+    def poll_interval(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The time between readiness checks (e.g. '2s')
+        """
+        return pulumi.get(self, "poll_interval")
+
+    @poll_interval.setter
+    def poll_interval(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "poll_interval", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def port(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The TCP port that must accept connections before the instance is considered ready
+        """
+        return pulumi.get(self, "port")
+
+    @port.setter
+    def port(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "port", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def timeout(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The maximum time to wait for the instance to become ready (e.g. '5m')
+        """
+        return pulumi.get(self, "timeout")
+
+    @timeout.setter
+    def timeout(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "timeout", value)
+
+
+if not MYPY:
+    class OpsCloudConfigDict(TypedDict):
+        """
+        The cloud provider specific configuration
+        """
+        bucket_name: NotRequired[_builtins.str]
+        """
+        The bucket to store the image artifacts in
+        """
+        bucket_namespace: NotRequired[_builtins.str]
+        """
+        The bucket namespace, required for oci
+        """
+        confidential_vm: NotRequired[_builtins.bool]
+        """
+        If confidential computing should be enabled
+        """
+        dedicated_host_id: NotRequired[_builtins.str]
+        """
+        The ID of the dedicated host to run on
+        """
+        domain_name: NotRequired[_builtins.str]
+        """
+        The domain name to create a DNS record for
+        """
+        enable_i_pv6: NotRequired[_builtins.bool]
+        """
+        If IPv6 should be enabled when creating a VPC
+        """
+        flavor: NotRequired[_builtins.str]
+        """
+        The instance flavor or machine type
+        """
+        image_type: NotRequired[_builtins.str]
+        """
+        The image type
+        """
+        instance_profile: NotRequired[_builtins.str]
+        """
+        The IAM instance profile (aws)
+        """
+        kms: NotRequired[_builtins.str]
+        """
+        The KMS key to encrypt images with, 'default' or an arn (aws)
+        """
+        platform: NotRequired[_builtins.str]
+        """
+        The cloud platform
+        """
+        project_id: NotRequired[_builtins.str]
+        """
+        The project ID (gcp)
+        """
+        root_volume: NotRequired['OpsCloudVolumeDict']
+        """
+        Settings for the root volume
+        """
+        security_group: NotRequired[_builtins.str]
+        """
+        The security group
+        """
+        skip_import_verify: NotRequired[_builtins.bool]
+        """
+        Skip verifying that a vm importer role exists (aws)
+        """
+        spot: NotRequired[_builtins.bool]
+        """
+        If spot provisioning should be used
+        """
+        static_ip: NotRequired[_builtins.str]
+        """
+        The static public IP to assign
+        """
+        subnet: NotRequired[_builtins.str]
+        """
+        The subnet
+        """
+        tags: NotRequired[Sequence['OpsTagDict']]
+        """
+        Tags (labels) for images and instances
+        """
+        user_data: NotRequired[_builtins.str]
+        """
+        User data passed to the instance
+        """
+        vpc: NotRequired[_builtins.str]
+        """
+        The VPC
+        """
+        zone: NotRequired[_builtins.str]
+        """
+        The zone or region
+        """
+elif False:
+    OpsCloudConfigDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsCloudConfig:
+    def __init__(__self__, *,
+                 bucket_name: Optional[_builtins.str] = None,
+                 bucket_namespace: Optional[_builtins.str] = None,
+                 confidential_vm: Optional[_builtins.bool] = None,
+                 dedicated_host_id: Optional[_builtins.str] = None,
+                 domain_name: Optional[_builtins.str] = None,
+                 enable_i_pv6: Optional[_builtins.bool] = None,
+                 flavor: Optional[_builtins.str] = None,
+                 image_type: Optional[_builtins.str] = None,
+                 instance_profile: Optional[_builtins.str] = None,
+                 kms: Optional[_builtins.str] = None,
+                 platform: Optional[_builtins.str] = None,
+                 project_id: Optional[_builtins.str] = None,
+                 root_volume: Optional['OpsCloudVolume'] = None,
+                 security_group: Optional[_builtins.str] = None,
+                 skip_import_verify: Optional[_builtins.bool] = None,
+                 spot: Optional[_builtins.bool] = None,
+                 static_ip: Optional[_builtins.str] = None,
+                 subnet: Optional[_builtins.str] = None,
+                 tags: Optional[Sequence['OpsTag']] = None,
+                 user_data: Optional[_builtins.str] = None,
+                 vpc: Optional[_builtins.str] = None,
+                 zone: Optional[_builtins.str] = None):
+        """
+        The cloud provider specific configuration
+        :param _builtins.str bucket_name: The bucket to store the image artifacts in
+        :param _builtins.str bucket_namespace: The bucket namespace, required for oci
+        :param _builtins.bool confidential_vm: If confidential computing should be enabled
+        :param _builtins.str dedicated_host_id: The ID of the dedicated host to run on
+        :param _builtins.str domain_name: The domain name to create a DNS record for
+        :param _builtins.bool enable_i_pv6: If IPv6 should be enabled when creating a VPC
+        :param _builtins.str flavor: The instance flavor or machine type
+        :param _builtins.str image_type: The image type
+        :param _builtins.str instance_profile: The IAM instance profile (aws)
+        :param _builtins.str kms: The KMS key to encrypt images with, 'default' or an arn (aws)
+        :param _builtins.str platform: The cloud platform
+        :param _builtins.str project_id: The project ID (gcp)
+        :param 'OpsCloudVolume' root_volume: Settings for the root volume
+        :param _builtins.str security_group: The security group
+        :param _builtins.bool skip_import_verify: Skip verifying that a vm importer role exists (aws)
+        :param _builtins.bool spot: If spot provisioning should be used
+        :param _builtins.str static_ip: The static public IP to assign
+        :param _builtins.str subnet: The subnet
+        :param Sequence['OpsTag'] tags: Tags (labels) for images and instances
+        :param _builtins.str user_data: User data passed to the instance
+        :param _builtins.str vpc: The VPC
+        :param _builtins.str zone: The zone or region
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if bucket_namespace is not None:
+            pulumi.set(__self__, "bucket_namespace", bucket_namespace)
+        if confidential_vm is not None:
+            pulumi.set(__self__, "confidential_vm", confidential_vm)
+        if dedicated_host_id is not None:
+            pulumi.set(__self__, "dedicated_host_id", dedicated_host_id)
+        if domain_name is not None:
+            pulumi.set(__self__, "domain_name", domain_name)
+        if enable_i_pv6 is not None:
+            pulumi.set(__self__, "enable_i_pv6", enable_i_pv6)
+        if flavor is not None:
+            pulumi.set(__self__, "flavor", flavor)
+        if image_type is not None:
+            pulumi.set(__self__, "image_type", image_type)
+        if instance_profile is not None:
+            pulumi.set(__self__, "instance_profile", instance_profile)
+        if kms is not None:
+            pulumi.set(__self__, "kms", kms)
+        if platform is not None:
+            pulumi.set(__self__, "platform", platform)
+        if project_id is not None:
+            pulumi.set(__self__, "project_id", project_id)
+        if root_volume is not None:
+            pulumi.set(__self__, "root_volume", root_volume)
+        if security_group is not None:
+            pulumi.set(__self__, "security_group", security_group)
+        if skip_import_verify is not None:
+            pulumi.set(__self__, "skip_import_verify", skip_import_verify)
+        if spot is not None:
+            pulumi.set(__self__, "spot", spot)
+        if static_ip is not None:
+            pulumi.set(__self__, "static_ip", static_ip)
+        if subnet is not None:
+            pulumi.set(__self__, "subnet", subnet)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+        if vpc is not None:
+            pulumi.set(__self__, "vpc", vpc)
+        if zone is not None:
+            pulumi.set(__self__, "zone", zone)
+
+    @_builtins.property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[_builtins.str]:
+        """
+        The bucket to store the image artifacts in
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @bucket_name.setter
+    def bucket_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "bucket_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bucketNamespace")
+    def bucket_namespace(self) -> Optional[_builtins.str]:
+        """
+        The bucket namespace, required for oci
+        """
+        return pulumi.get(self, "bucket_namespace")
+
+    @bucket_namespace.setter
+    def bucket_namespace(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "bucket_namespace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="confidentialVM")
+    def confidential_vm(self) -> Optional[_builtins.bool]:
+        """
+        If confidential computing should be enabled
+        """
+        return pulumi.get(self, "confidential_vm")
+
+    @confidential_vm.setter
+    def confidential_vm(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "confidential_vm", value)
+
+    @_builtins.property
+    @pulumi.getter(name="dedicatedHostID")
+    def dedicated_host_id(self) -> Optional[_builtins.str]:
+        """
+        The ID of the dedicated host to run on
+        """
+        return pulumi.get(self, "dedicated_host_id")
+
+    @dedicated_host_id.setter
+    def dedicated_host_id(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "dedicated_host_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="domainName")
+    def domain_name(self) -> Optional[_builtins.str]:
+        """
+        The domain name to create a DNS record for
+        """
+        return pulumi.get(self, "domain_name")
+
+    @domain_name.setter
+    def domain_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "domain_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="enableIPv6")
+    def enable_i_pv6(self) -> Optional[_builtins.bool]:
+        """
+        If IPv6 should be enabled when creating a VPC
+        """
+        return pulumi.get(self, "enable_i_pv6")
+
+    @enable_i_pv6.setter
+    def enable_i_pv6(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "enable_i_pv6", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def flavor(self) -> Optional[_builtins.str]:
+        """
+        The instance flavor or machine type
+        """
+        return pulumi.get(self, "flavor")
+
+    @flavor.setter
+    def flavor(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "flavor", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageType")
+    def image_type(self) -> Optional[_builtins.str]:
+        """
+        The image type
+        """
+        return pulumi.get(self, "image_type")
+
+    @image_type.setter
+    def image_type(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "image_type", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> Optional[_builtins.str]:
+        """
+        The IAM instance profile (aws)
+        """
+        return pulumi.get(self, "instance_profile")
+
+    @instance_profile.setter
+    def instance_profile(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "instance_profile", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kms(self) -> Optional[_builtins.str]:
+        """
+        The KMS key to encrypt images with, 'default' or an arn (aws)
+        """
+        return pulumi.get(self, "kms")
+
+    @kms.setter
+    def kms(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "kms", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def platform(self) -> Optional[_builtins.str]:
+        """
+        The cloud platform
+        """
+        return pulumi.get(self, "platform")
+
+    @platform.setter
+    def platform(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "platform", value)
+
+    @_builtins.property
+    @pulumi.getter(name="projectID")
+    def project_id(self) -> Optional[_builtins.str]:
+        """
+        The project ID (gcp)
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "project_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rootVolume")
+    def root_volume(self) -> Optional['OpsCloudVolume']:
+        """
+        Settings for the root volume
+        """
+        return pulumi.get(self, "root_volume")
+
+    @root_volume.setter
+    def root_volume(self, value: Optional['OpsCloudVolume']):
+        pulumi.set(self, "root_volume", value)
+
+    @_builtins.property
+    @pulumi.getter(name="securityGroup")
+    def security_group(self) -> Optional[_builtins.str]:
+        """
+        The security group
+        """
+        return pulumi.get(self, "security_group")
+
+    @security_group.setter
+    def security_group(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "security_group", value)
+
+    @_builtins.property
+    @pulumi.getter(name="skipImportVerify")
+    def skip_import_verify(self) -> Optional[_builtins.bool]:
+        """
+        Skip verifying that a vm importer role exists (aws)
+        """
+        return pulumi.get(self, "skip_import_verify")
+
+    @skip_import_verify.setter
+    def skip_import_verify(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "skip_import_verify", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def spot(self) -> Optional[_builtins.bool]:
+        """
+        If spot provisioning should be used
+        """
+        return pulumi.get(self, "spot")
+
+    @spot.setter
+    def spot(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "spot", value)
+
+    @_builtins.property
+    @pulumi.getter(name="staticIP")
+    def static_ip(self) -> Optional[_builtins.str]:
+        """
+        The static public IP to assign
+        """
+        return pulumi.get(self, "static_ip")
+
+    @static_ip.setter
+    def static_ip(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "static_ip", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def subnet(self) -> Optional[_builtins.str]:
+        """
+        The subnet
+        """
+        return pulumi.get(self, "subnet")
+
+    @subnet.setter
+    def subnet(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "subnet", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence['OpsTag']]:
+        """
+        Tags (labels) for images and instances
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[Sequence['OpsTag']]):
+        pulumi.set(self, "tags", value)
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[_builtins.str]:
+        """
+        User data passed to the instance
+        """
+        return pulumi.get(self, "user_data")
+
+    @user_data.setter
+    def user_data(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "user_data", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def vpc(self) -> Optional[_builtins.str]:
+        """
+        The VPC
+        """
+        return pulumi.get(self, "vpc")
+
+    @vpc.setter
+    def vpc(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "vpc", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def zone(self) -> Optional[_builtins.str]:
+        """
+        The zone or region
+        """
+        return pulumi.get(self, "zone")
+
+    @zone.setter
+    def zone(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "zone", value)
+
+
+if not MYPY:
+    class OpsCloudConfigArgsDict(TypedDict):
+        """
+        The cloud provider specific configuration
+        """
+        bucket_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The bucket to store the image artifacts in
+        """
+        bucket_namespace: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The bucket namespace, required for oci
+        """
+        confidential_vm: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If confidential computing should be enabled
+        """
+        dedicated_host_id: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The ID of the dedicated host to run on
+        """
+        domain_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The domain name to create a DNS record for
+        """
+        enable_i_pv6: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If IPv6 should be enabled when creating a VPC
+        """
+        flavor: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The instance flavor or machine type
+        """
+        image_type: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The image type
+        """
+        instance_profile: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The IAM instance profile (aws)
+        """
+        kms: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The KMS key to encrypt images with, 'default' or an arn (aws)
+        """
+        platform: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The cloud platform
+        """
+        project_id: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The project ID (gcp)
+        """
+        root_volume: NotRequired[pulumi.Input['OpsCloudVolumeArgsDict']]
+        """
+        Settings for the root volume
+        """
+        security_group: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The security group
+        """
+        skip_import_verify: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        Skip verifying that a vm importer role exists (aws)
+        """
+        spot: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If spot provisioning should be used
+        """
+        static_ip: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The static public IP to assign
+        """
+        subnet: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The subnet
+        """
+        tags: NotRequired[pulumi.Input[Sequence[pulumi.Input['OpsTagArgsDict']]]]
+        """
+        Tags (labels) for images and instances
+        """
+        user_data: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        User data passed to the instance
+        """
+        vpc: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The VPC
+        """
+        zone: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The zone or region
+        """
+elif False:
+    OpsCloudConfigArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsCloudConfigArgs:
+    def __init__(__self__, *,
+                 bucket_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 bucket_namespace: Optional[pulumi.Input[_builtins.str]] = None,
+                 confidential_vm: Optional[pulumi.Input[_builtins.bool]] = None,
+                 dedicated_host_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 domain_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 enable_i_pv6: Optional[pulumi.Input[_builtins.bool]] = None,
+                 flavor: Optional[pulumi.Input[_builtins.str]] = None,
+                 image_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 instance_profile: Optional[pulumi.Input[_builtins.str]] = None,
+                 kms: Optional[pulumi.Input[_builtins.str]] = None,
+                 platform: Optional[pulumi.Input[_builtins.str]] = None,
+                 project_id: Optional[pulumi.Input[_builtins.str]] = None,
+                 root_volume: Optional[pulumi.Input['OpsCloudVolumeArgs']] = None,
+                 security_group: Optional[pulumi.Input[_builtins.str]] = None,
+                 skip_import_verify: Optional[pulumi.Input[_builtins.bool]] = None,
+                 spot: Optional[pulumi.Input[_builtins.bool]] = None,
+                 static_ip: Optional[pulumi.Input[_builtins.str]] = None,
+                 subnet: Optional[pulumi.Input[_builtins.str]] = None,
+                 tags: Optional[pulumi.Input[Sequence[pulumi.Input['OpsTagArgs']]]] = None,
+                 user_data: Optional[pulumi.Input[_builtins.str]] = None,
+                 vpc: Optional[pulumi.Input[_builtins.str]] = None,
+                 zone: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The cloud provider specific configuration
+        :param pulumi.Input[_builtins.str] bucket_name: The bucket to store the image artifacts in
+        :param pulumi.Input[_builtins.str] bucket_namespace: The bucket namespace, required for oci
+        :param pulumi.Input[_builtins.bool] confidential_vm: If confidential computing should be enabled
+        :param pulumi.Input[_builtins.str] dedicated_host_id: The ID of the dedicated host to run on
+        :param pulumi.Input[_builtins.str] domain_name: The domain name to create a DNS record for
+        :param pulumi.Input[_builtins.bool] enable_i_pv6: If IPv6 should be enabled when creating a VPC
+        :param pulumi.Input[_builtins.str] flavor: The instance flavor or machine type
+        :param pulumi.Input[_builtins.str] image_type: The image type
+        :param pulumi.Input[_builtins.str] instance_profile: The IAM instance profile (aws)
+        :param pulumi.Input[_builtins.str] kms: The KMS key to encrypt images with, 'default' or an arn (aws)
+        :param pulumi.Input[_builtins.str] platform: The cloud platform
+        :param pulumi.Input[_builtins.str] project_id: The project ID (gcp)
+        :param pulumi.Input['OpsCloudVolumeArgs'] root_volume: Settings for the root volume
+        :param pulumi.Input[_builtins.str] security_group: The security group
+        :param pulumi.Input[_builtins.bool] skip_import_verify: Skip verifying that a vm importer role exists (aws)
+        :param pulumi.Input[_builtins.bool] spot: If spot provisioning should be used
+        :param pulumi.Input[_builtins.str] static_ip: The static public IP to assign
+        :param pulumi.Input[_builtins.str] subnet: The subnet
+        :param pulumi.Input[Sequence[pulumi.Input['OpsTagArgs']]] tags: Tags (labels) for images and instances
+        :param pulumi.Input[_builtins.str] user_data: User data passed to the instance
+        :param pulumi.Input[_builtins.str] vpc: The VPC
+        :param pulumi.Input[_builtins.str] zone: The zone or region
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if bucket_namespace is not None:
+            pulumi.set(__self__, "bucket_namespace", bucket_namespace)
+        if confidential_vm is not None:
+            pulumi.set(__self__, "confidential_vm", confidential_vm)
+        if dedicated_host_id is not None:
+            pulumi.set(__self__, "dedicated_host_id", dedicated_host_id)
+        if domain_name is not None:
+            pulumi.set(__self__, "domain_name", domain_name)
+        if enable_i_pv6 is not None:
+            pulumi.set(__self__, "enable_i_pv6", enable_i_pv6)
+        if flavor is not None:
+            pulumi.set(__self__, "flavor", flavor)
+        if image_type is not None:
+            pulumi.set(__self__, "image_type", image_type)
+        if instance_profile is not None:
+            pulumi.set(__self__, "instance_profile", instance_profile)
+        if kms is not None:
+            pulumi.set(__self__, "kms", kms)
+        if platform is not None:
+            pulumi.set(__self__, "platform", platform)
+        if project_id is not None:
+            pulumi.set(__self__, "project_id", project_id)
+        if root_volume is not None:
+            pulumi.set(__self__, "root_volume", root_volume)
+        if security_group is not None:
+            pulumi.set(__self__, "security_group", security_group)
+        if skip_import_verify is not None:
+            pulumi.set(__self__, "skip_import_verify", skip_import_verify)
+        if spot is not None:
+            pulumi.set(__self__, "spot", spot)
+        if static_ip is not None:
+            pulumi.set(__self__, "static_ip", static_ip)
+        if subnet is not None:
+            pulumi.set(__self__, "subnet", subnet)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+        if vpc is not None:
+            pulumi.set(__self__, "vpc", vpc)
+        if zone is not None:
+            pulumi.set(__self__, "zone", zone)
+
+    @_builtins.property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The bucket to store the image artifacts in
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @bucket_name.setter
+    def bucket_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "bucket_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bucketNamespace")
+    def bucket_namespace(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The bucket namespace, required for oci
+        """
+        return pulumi.get(self, "bucket_namespace")
+
+    @bucket_namespace.setter
+    def bucket_namespace(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "bucket_namespace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="confidentialVM")
+    def confidential_vm(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If confidential computing should be enabled
+        """
+        return pulumi.get(self, "confidential_vm")
+
+    @confidential_vm.setter
+    def confidential_vm(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "confidential_vm", value)
+
+    @_builtins.property
+    @pulumi.getter(name="dedicatedHostID")
+    def dedicated_host_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The ID of the dedicated host to run on
+        """
+        return pulumi.get(self, "dedicated_host_id")
+
+    @dedicated_host_id.setter
+    def dedicated_host_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "dedicated_host_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="domainName")
+    def domain_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The domain name to create a DNS record for
+        """
+        return pulumi.get(self, "domain_name")
+
+    @domain_name.setter
+    def domain_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "domain_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="enableIPv6")
+    def enable_i_pv6(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If IPv6 should be enabled when creating a VPC
+        """
+        return pulumi.get(self, "enable_i_pv6")
+
+    @enable_i_pv6.setter
+    def enable_i_pv6(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "enable_i_pv6", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def flavor(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The instance flavor or machine type
+        """
+        return pulumi.get(self, "flavor")
+
+    @flavor.setter
+    def flavor(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "flavor", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageType")
+    def image_type(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The image type
+        """
+        return pulumi.get(self, "image_type")
+
+    @image_type.setter
+    def image_type(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "image_type", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The IAM instance profile (aws)
+        """
+        return pulumi.get(self, "instance_profile")
+
+    @instance_profile.setter
+    def instance_profile(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "instance_profile", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kms(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The KMS key to encrypt images with, 'default' or an arn (aws)
+        """
+        return pulumi.get(self, "kms")
+
+    @kms.setter
+    def kms(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kms", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def platform(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The cloud platform
+        """
+        return pulumi.get(self, "platform")
+
+    @platform.setter
+    def platform(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "platform", value)
+
+    @_builtins.property
+    @pulumi.getter(name="projectID")
+    def project_id(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The project ID (gcp)
+        """
+        return pulumi.get(self, "project_id")
+
+    @project_id.setter
+    def project_id(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "project_id", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rootVolume")
+    def root_volume(self) -> Optional[pulumi.Input['OpsCloudVolumeArgs']]:
+        """
+        Settings for the root volume
+        """
+        return pulumi.get(self, "root_volume")
+
+    @root_volume.setter
+    def root_volume(self, value: Optional[pulumi.Input['OpsCloudVolumeArgs']]):
+        pulumi.set(self, "root_volume", value)
+
+    @_builtins.property
+    @pulumi.getter(name="securityGroup")
+    def security_group(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The security group
+        """
+        return pulumi.get(self, "security_group")
+
+    @security_group.setter
+    def security_group(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "security_group", value)
+
+    @_builtins.property
+    @pulumi.getter(name="skipImportVerify")
+    def skip_import_verify(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        Skip verifying that a vm importer role exists (aws)
+        """
+        return pulumi.get(self, "skip_import_verify")
+
+    @skip_import_verify.setter
+    def skip_import_verify(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "skip_import_verify", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def spot(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If spot provisioning should be used
+        """
+        return pulumi.get(self, "spot")
+
+    @spot.setter
+    def spot(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "spot", value)
+
+    @_builtins.property
+    @pulumi.getter(name="staticIP")
+    def static_ip(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The static public IP to assign
+        """
+        return pulumi.get(self, "static_ip")
+
+    @static_ip.setter
+    def static_ip(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "static_ip", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def subnet(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The subnet
+        """
+        return pulumi.get(self, "subnet")
+
+    @subnet.setter
+    def subnet(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "subnet", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['OpsTagArgs']]]]:
+        """
+        Tags (labels) for images and instances
+        """
+        return pulumi.get(self, "tags")
+
+    @tags.setter
+    def tags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['OpsTagArgs']]]]):
+        pulumi.set(self, "tags", value)
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        User data passed to the instance
+        """
+        return pulumi.get(self, "user_data")
+
+    @user_data.setter
+    def user_data(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "user_data", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def vpc(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The VPC
+        """
+        return pulumi.get(self, "vpc")
+
+    @vpc.setter
+    def vpc(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "vpc", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def zone(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The zone or region
+        """
+        return pulumi.get(self, "zone")
+
+    @zone.setter
+    def zone(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "zone", value)
+
+
+if not MYPY:
+    class OpsCloudVolumeDict(TypedDict):
+        """
+        Cloud volume settings
+        """
+        iops: NotRequired[_builtins.int]
+        """
+        The provisioned IOPS
+        """
+        name: NotRequired[_builtins.str]
+        """
+        The name of the volume
+        """
+        size: NotRequired[_builtins.int]
+        """
+        The size of the volume in GB
+        """
+        throughput: NotRequired[_builtins.int]
+        """
+        The provisioned throughput
+        """
+        typeof: NotRequired[_builtins.str]
+        """
+        The volume type
+        """
+elif False:
+    OpsCloudVolumeDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsCloudVolume:
+    def __init__(__self__, *,
+                 iops: Optional[_builtins.int] = None,
+                 name: Optional[_builtins.str] = None,
+                 size: Optional[_builtins.int] = None,
+                 throughput: Optional[_builtins.int] = None,
+                 typeof: Optional[_builtins.str] = None):
+        """
+        Cloud volume settings
+        :param _builtins.int iops: The provisioned IOPS
+        :param _builtins.str name: The name of the volume
+        :param _builtins.int size: The size of the volume in GB
+        :param _builtins.int throughput: The provisioned throughput
+        :param _builtins.str typeof: The volume type
+        """
+        if iops is not None:
+            pulumi.set(__self__, "iops", iops)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if throughput is not None:
+            pulumi.set(__self__, "throughput", throughput)
+        if typeof is not None:
+            pulumi.set(__self__, "typeof", typeof)
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> Optional[_builtins.int]:
+        """
+        The provisioned IOPS
+        """
+        return pulumi.get(self, "iops")
+
+    @iops.setter
+    def iops(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "iops", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[_builtins.str]:
+        """
+        The name of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> Optional[_builtins.int]:
+        """
+        The size of the volume in GB
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "size", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> Optional[_builtins.int]:
+        """
+        The provisioned throughput
+        """
+        return pulumi.get(self, "throughput")
+
+    @throughput.setter
+    def throughput(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "throughput", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def typeof(self) -> Optional[_builtins.str]:
+        """
+        The volume type
+        """
+        return pulumi.get(self, "typeof")
+
+    @typeof.setter
+    def typeof(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "typeof", value)
+
+
+if not MYPY:
+    class OpsCloudVolumeArgsDict(TypedDict):
+        """
+        Cloud volume settings
+        """
+        iops: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The provisioned IOPS
+        """
+        name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The name of the volume
+        """
+        size: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The size of the volume in GB
+        """
+        throughput: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The provisioned throughput
+        """
+        typeof: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The volume type
+        """
+elif False:
+    OpsCloudVolumeArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsCloudVolumeArgs:
+    def __init__(__self__, *,
+                 iops: Optional[pulumi.Input[_builtins.int]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 size: Optional[pulumi.Input[_builtins.int]] = None,
+                 throughput: Optional[pulumi.Input[_builtins.int]] = None,
+                 typeof: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        Cloud volume settings
+        :param pulumi.Input[_builtins.int] iops: The provisioned IOPS
+        :param pulumi.Input[_builtins.str] name: The name of the volume
+        :param pulumi.Input[_builtins.int] size: The size of the volume in GB
+        :param pulumi.Input[_builtins.int] throughput: The provisioned throughput
+        :param pulumi.Input[_builtins.str] typeof: The volume type
+        """
+        if iops is not None:
+            pulumi.set(__self__, "iops", iops)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if throughput is not None:
+            pulumi.set(__self__, "throughput", throughput)
+        if typeof is not None:
+            pulumi.set(__self__, "typeof", typeof)
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The provisioned IOPS
+        """
+        return pulumi.get(self, "iops")
+
+    @iops.setter
+    def iops(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "iops", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The size of the volume in GB
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "size", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The provisioned throughput
+        """
+        return pulumi.get(self, "throughput")
+
+    @throughput.setter
+    def throughput(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "throughput", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def typeof(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The volume type
+        """
+        return pulumi.get(self, "typeof")
+
+    @typeof.setter
+    def typeof(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "typeof", value)
+
+
+if not MYPY:
+    class OpsConfigDict(TypedDict):
+        """
+        The nanovms ops configuration, mirrors the ops JSON configuration file
+        """
+        args: NotRequired[Sequence[_builtins.str]]
+        """
+        The arguments passed to the program when the image is launched
+        """
+        base_volume_sz: NotRequired[_builtins.str]
+        """
+        The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        """
+        boot: NotRequired[_builtins.str]
+        """
+        The path to the boot image
+        """
+        cloud_config: NotRequired['OpsCloudConfigDict']
+        """
+        The cloud provider specific configuration
+        """
+        debugflags: NotRequired[Sequence[_builtins.str]]
+        """
+        The kernel debug flags
+        """
+        description: NotRequired[_builtins.str]
+        """
+        The description of the image
+        """
+        dirs: NotRequired[Sequence[_builtins.str]]
+        """
+        Local directories to include into the image
+        """
+        env: NotRequired[Mapping[str, _builtins.str]]
+        """
+        Environment variables for the image runtime
+        """
+        files: NotRequired[Sequence[_builtins.str]]
+        """
+        Local files to include into the image
+        """
+        kernel: NotRequired[_builtins.str]
+        """
+        The path to the kernel image
+        """
+        klib_dir: NotRequired[_builtins.str]
+        """
+        The host location of the klibs
+        """
+        klibs: NotRequired[Sequence[_builtins.str]]
+        """
+        The klibs to include into the image (e.g. 'tls', 'ntp')
+        """
+        language: NotRequired[_builtins.str]
+        """
+        The language of the program
+        """
+        local_files_parent_directory: NotRequired[_builtins.str]
+        """
+        The parent directory of the files and directories specified in files and dirs
+        """
+        manifest_passthrough: NotRequired[Mapping[str, Any]]
+        """
+        Options passed straight through to the image manifest
+        """
+        map_dirs: NotRequired[Mapping[str, _builtins.str]]
+        """
+        Local directories (keys) to map to a path in the image (values)
+        """
+        mounts: NotRequired[Mapping[str, _builtins.str]]
+        """
+        Volumes (keys) to mount at a path in the image (values)
+        """
+        name_servers: NotRequired[Sequence[_builtins.str]]
+        """
+        The DNS servers to use, defaults to '8.8.8.8'
+        """
+        nanos_version: NotRequired[_builtins.str]
+        """
+        The nanos kernel version
+        """
+        nightly_build: NotRequired[_builtins.bool]
+        """
+        If the nightly kernel build should be used
+        """
+        no_trace: NotRequired[Sequence[_builtins.str]]
+        """
+        Syscalls to exclude from tracing
+        """
+        reboot_on_exit: NotRequired[_builtins.bool]
+        """
+        If the image should reboot automatically when an error occurs
+        """
+        run_config: NotRequired['OpsRunConfigDict']
+        """
+        The runtime configuration
+        """
+        target_config: NotRequired[Mapping[str, _builtins.str]]
+        """
+        Configuration specific to the target provider
+        """
+        target_root: NotRequired[_builtins.str]
+        """
+        The target root filesystem
+        """
+        tfsv4: NotRequired[_builtins.bool]
+        """
+        If the deprecated TFS version 4 encoding should be used
+        """
+        uefi: NotRequired[_builtins.bool]
+        """
+        If the image should support booting via UEFI
+        """
+        version: NotRequired[_builtins.str]
+        """
+        The version of the image
+        """
+elif False:
+    OpsConfigDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsConfig:
+    def __init__(__self__, *,
+                 args: Optional[Sequence[_builtins.str]] = None,
+                 base_volume_sz: Optional[_builtins.str] = None,
+                 boot: Optional[_builtins.str] = None,
+                 cloud_config: Optional['OpsCloudConfig'] = None,
+                 debugflags: Optional[Sequence[_builtins.str]] = None,
+                 description: Optional[_builtins.str] = None,
+                 dirs: Optional[Sequence[_builtins.str]] = None,
+                 env: Optional[Mapping[str, _builtins.str]] = None,
+                 files: Optional[Sequence[_builtins.str]] = None,
+                 kernel: Optional[_builtins.str] = None,
+                 klib_dir: Optional[_builtins.str] = None,
+                 klibs: Optional[Sequence[_builtins.str]] = None,
+                 language: Optional[_builtins.str] = None,
+                 local_files_parent_directory: Optional[_builtins.str] = None,
+                 manifest_passthrough: Optional[Mapping[str, Any]] = None,
+                 map_dirs: Optional[Mapping[str, _builtins.str]] = None,
+                 mounts: Optional[Mapping[str, _builtins.str]] = None,
+                 name_servers: Optional[Sequence[_builtins.str]] = None,
+                 nanos_version: Optional[_builtins.str] = None,
+                 nightly_build: Optional[_builtins.bool] = None,
+                 no_trace: Optional[Sequence[_builtins.str]] = None,
+                 reboot_on_exit: Optional[_builtins.bool] = None,
+                 run_config: Optional['OpsRunConfig'] = None,
+                 target_config: Optional[Mapping[str, _builtins.str]] = None,
+                 target_root: Optional[_builtins.str] = None,
+                 tfsv4: Optional[_builtins.bool] = None,
+                 uefi: Optional[_builtins.bool] = None,
+                 version: Optional[_builtins.str] = None):
+        """
+        The nanovms ops configuration, mirrors the ops JSON configuration file
+        :param Sequence[_builtins.str] args: The arguments passed to the program when the image is launched
+        :param _builtins.str base_volume_sz: The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        :param _builtins.str boot: The path to the boot image
+        :param 'OpsCloudConfig' cloud_config: The cloud provider specific configuration
+        :param Sequence[_builtins.str] debugflags: The kernel debug flags
+        :param _builtins.str description: The description of the image
+        :param Sequence[_builtins.str] dirs: Local directories to include into the image
+        :param Mapping[str, _builtins.str] env: Environment variables for the image runtime
+        :param Sequence[_builtins.str] files: Local files to include into the image
+        :param _builtins.str kernel: The path to the kernel image
+        :param _builtins.str klib_dir: The host location of the klibs
+        :param Sequence[_builtins.str] klibs: The klibs to include into the image (e.g. 'tls', 'ntp')
+        :param _builtins.str language: The language of the program
+        :param _builtins.str local_files_parent_directory: The parent directory of the files and directories specified in files and dirs
+        :param Mapping[str, Any] manifest_passthrough: Options passed straight through to the image manifest
+        :param Mapping[str, _builtins.str] map_dirs: Local directories (keys) to map to a path in the image (values)
+        :param Mapping[str, _builtins.str] mounts: Volumes (keys) to mount at a path in the image (values)
+        :param Sequence[_builtins.str] name_servers: The DNS servers to use, defaults to '8.8.8.8'
+        :param _builtins.str nanos_version: The nanos kernel version
+        :param _builtins.bool nightly_build: If the nightly kernel build should be used
+        :param Sequence[_builtins.str] no_trace: Syscalls to exclude from tracing
+        :param _builtins.bool reboot_on_exit: If the image should reboot automatically when an error occurs
+        :param 'OpsRunConfig' run_config: The runtime configuration
+        :param Mapping[str, _builtins.str] target_config: Configuration specific to the target provider
+        :param _builtins.str target_root: The target root filesystem
+        :param _builtins.bool tfsv4: If the deprecated TFS version 4 encoding should be used
+        :param _builtins.bool uefi: If the image should support booting via UEFI
+        :param _builtins.str version: The version of the image
+        """
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if base_volume_sz is not None:
+            pulumi.set(__self__, "base_volume_sz", base_volume_sz)
+        if boot is not None:
+            pulumi.set(__self__, "boot", boot)
+        if cloud_config is not None:
+            pulumi.set(__self__, "cloud_config", cloud_config)
+        if debugflags is not None:
+            pulumi.set(__self__, "debugflags", debugflags)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if dirs is not None:
+            pulumi.set(__self__, "dirs", dirs)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if files is not None:
+            pulumi.set(__self__, "files", files)
+        if kernel is not None:
+            pulumi.set(__self__, "kernel", kernel)
+        if klib_dir is not None:
+            pulumi.set(__self__, "klib_dir", klib_dir)
+        if klibs is not None:
+            pulumi.set(__self__, "klibs", klibs)
+        if language is not None:
+            pulumi.set(__self__, "language", language)
+        if local_files_parent_directory is not None:
+            pulumi.set(__self__, "local_files_parent_directory", local_files_parent_directory)
+        if manifest_passthrough is not None:
+            pulumi.set(__self__, "manifest_passthrough", manifest_passthrough)
+        if map_dirs is not None:
+            pulumi.set(__self__, "map_dirs", map_dirs)
+        if mounts is not None:
+            pulumi.set(__self__, "mounts", mounts)
+        if name_servers is not None:
+            pulumi.set(__self__, "name_servers", name_servers)
+        if nanos_version is not None:
+            pulumi.set(__self__, "nanos_version", nanos_version)
+        if nightly_build is not None:
+            pulumi.set(__self__, "nightly_build", nightly_build)
+        if no_trace is not None:
+            pulumi.set(__self__, "no_trace", no_trace)
+        if reboot_on_exit is not None:
+            pulumi.set(__self__, "reboot_on_exit", reboot_on_exit)
+        if run_config is not None:
+            pulumi.set(__self__, "run_config", run_config)
+        if target_config is not None:
+            pulumi.set(__self__, "target_config", target_config)
+        if target_root is not None:
+            pulumi.set(__self__, "target_root", target_root)
+        if tfsv4 is not None:
+            pulumi.set(__self__, "tfsv4", tfsv4)
+        if uefi is not None:
+            pulumi.set(__self__, "uefi", uefi)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The arguments passed to the program when the image is launched
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "args", value)
+
+    @_builtins.property
+    @pulumi.getter(name="baseVolumeSz")
+    def base_volume_sz(self) -> Optional[_builtins.str]:
+        """
+        The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        """
+        return pulumi.get(self, "base_volume_sz")
+
+    @base_volume_sz.setter
+    def base_volume_sz(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "base_volume_sz", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def boot(self) -> Optional[_builtins.str]:
+        """
+        The path to the boot image
+        """
+        return pulumi.get(self, "boot")
+
+    @boot.setter
+    def boot(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "boot", value)
+
+    @_builtins.property
+    @pulumi.getter(name="cloudConfig")
+    def cloud_config(self) -> Optional['OpsCloudConfig']:
+        """
+        The cloud provider specific configuration
+        """
+        return pulumi.get(self, "cloud_config")
+
+    @cloud_config.setter
+    def cloud_config(self, value: Optional['OpsCloudConfig']):
+        pulumi.set(self, "cloud_config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def debugflags(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The kernel debug flags
+        """
+        return pulumi.get(self, "debugflags")
+
+    @debugflags.setter
+    def debugflags(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "debugflags", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def description(self) -> Optional[_builtins.str]:
+        """
+        The description of the image
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "description", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def dirs(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Local directories to include into the image
+        """
+        return pulumi.get(self, "dirs")
+
+    @dirs.setter
+    def dirs(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "dirs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Environment variables for the image runtime
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[Mapping[str, _builtins.str]]):
+        pulumi.set(self, "env", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def files(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Local files to include into the image
+        """
+        return pulumi.get(self, "files")
+
+    @files.setter
+    def files(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "files", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kernel(self) -> Optional[_builtins.str]:
+        """
+        The path to the kernel image
+        """
+        return pulumi.get(self, "kernel")
+
+    @kernel.setter
+    def kernel(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "kernel", value)
+
+    @_builtins.property
+    @pulumi.getter(name="klibDir")
+    def klib_dir(self) -> Optional[_builtins.str]:
+        """
+        The host location of the klibs
+        """
+        return pulumi.get(self, "klib_dir")
+
+    @klib_dir.setter
+    def klib_dir(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "klib_dir", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def klibs(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The klibs to include into the image (e.g. 'tls', 'ntp')
+        """
+        return pulumi.get(self, "klibs")
+
+    @klibs.setter
+    def klibs(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "klibs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def language(self) -> Optional[_builtins.str]:
+        """
+        The language of the program
+        """
+        return pulumi.get(self, "language")
+
+    @language.setter
+    def language(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "language", value)
+
+    @_builtins.property
+    @pulumi.getter(name="localFilesParentDirectory")
+    def local_files_parent_directory(self) -> Optional[_builtins.str]:
+        """
+        The parent directory of the files and directories specified in files and dirs
+        """
+        return pulumi.get(self, "local_files_parent_directory")
+
+    @local_files_parent_directory.setter
+    def local_files_parent_directory(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "local_files_parent_directory", value)
+
+    @_builtins.property
+    @pulumi.getter(name="manifestPassthrough")
+    def manifest_passthrough(self) -> Optional[Mapping[str, Any]]:
+        """
+        Options passed straight through to the image manifest
+        """
+        return pulumi.get(self, "manifest_passthrough")
+
+    @manifest_passthrough.setter
+    def manifest_passthrough(self, value: Optional[Mapping[str, Any]]):
+        pulumi.set(self, "manifest_passthrough", value)
+
+    @_builtins.property
+    @pulumi.getter(name="mapDirs")
+    def map_dirs(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Local directories (keys) to map to a path in the image (values)
+        """
+        return pulumi.get(self, "map_dirs")
+
+    @map_dirs.setter
+    def map_dirs(self, value: Optional[Mapping[str, _builtins.str]]):
+        pulumi.set(self, "map_dirs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mounts(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Volumes (keys) to mount at a path in the image (values)
+        """
+        return pulumi.get(self, "mounts")
+
+    @mounts.setter
+    def mounts(self, value: Optional[Mapping[str, _builtins.str]]):
+        pulumi.set(self, "mounts", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nameServers")
+    def name_servers(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The DNS servers to use, defaults to '8.8.8.8'
+        """
+        return pulumi.get(self, "name_servers")
+
+    @name_servers.setter
+    def name_servers(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "name_servers", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nanosVersion")
+    def nanos_version(self) -> Optional[_builtins.str]:
+        """
+        The nanos kernel version
+        """
+        return pulumi.get(self, "nanos_version")
+
+    @nanos_version.setter
+    def nanos_version(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "nanos_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nightlyBuild")
+    def nightly_build(self) -> Optional[_builtins.bool]:
+        """
+        If the nightly kernel build should be used
+        """
+        return pulumi.get(self, "nightly_build")
+
+    @nightly_build.setter
+    def nightly_build(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "nightly_build", value)
+
+    @_builtins.property
+    @pulumi.getter(name="noTrace")
+    def no_trace(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Syscalls to exclude from tracing
+        """
+        return pulumi.get(self, "no_trace")
+
+    @no_trace.setter
+    def no_trace(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "no_trace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rebootOnExit")
+    def reboot_on_exit(self) -> Optional[_builtins.bool]:
+        """
+        If the image should reboot automatically when an error occurs
+        """
+        return pulumi.get(self, "reboot_on_exit")
+
+    @reboot_on_exit.setter
+    def reboot_on_exit(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "reboot_on_exit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="runConfig")
+    def run_config(self) -> Optional['OpsRunConfig']:
+        """
+        The runtime configuration
+        """
+        return pulumi.get(self, "run_config")
+
+    @run_config.setter
+    def run_config(self, value: Optional['OpsRunConfig']):
+        pulumi.set(self, "run_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="targetConfig")
+    def target_config(self) -> Optional[Mapping[str, _builtins.str]]:
+        """
+        Configuration specific to the target provider
+        """
+        return pulumi.get(self, "target_config")
+
+    @target_config.setter
+    def target_config(self, value: Optional[Mapping[str, _builtins.str]]):
+        pulumi.set(self, "target_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="targetRoot")
+    def target_root(self) -> Optional[_builtins.str]:
+        """
+        The target root filesystem
+        """
+        return pulumi.get(self, "target_root")
+
+    @target_root.setter
+    def target_root(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "target_root", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tfsv4(self) -> Optional[_builtins.bool]:
+        """
+        If the deprecated TFS version 4 encoding should be used
+        """
+        return pulumi.get(self, "tfsv4")
+
+    @tfsv4.setter
+    def tfsv4(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "tfsv4", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def uefi(self) -> Optional[_builtins.bool]:
+        """
+        If the image should support booting via UEFI
+        """
+        return pulumi.get(self, "uefi")
+
+    @uefi.setter
+    def uefi(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "uefi", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> Optional[_builtins.str]:
+        """
+        The version of the image
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "version", value)
+
+
+if not MYPY:
+    class OpsConfigArgsDict(TypedDict):
+        """
+        The nanovms ops configuration, mirrors the ops JSON configuration file
+        """
+        args: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The arguments passed to the program when the image is launched
+        """
+        base_volume_sz: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        """
+        boot: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The path to the boot image
+        """
+        cloud_config: NotRequired[pulumi.Input['OpsCloudConfigArgsDict']]
+        """
+        The cloud provider specific configuration
+        """
+        debugflags: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The kernel debug flags
+        """
+        description: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The description of the image
+        """
+        dirs: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        Local directories to include into the image
+        """
+        env: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+        """
+        Environment variables for the image runtime
+        """
+        files: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        Local files to include into the image
+        """
+        kernel: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The path to the kernel image
+        """
+        klib_dir: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The host location of the klibs
+        """
+        klibs: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The klibs to include into the image (e.g. 'tls', 'ntp')
+        """
+        language: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The language of the program
+        """
+        local_files_parent_directory: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The parent directory of the files and directories specified in files and dirs
+        """
+        manifest_passthrough: NotRequired[pulumi.Input[Mapping[str, Any]]]
+        """
+        Options passed straight through to the image manifest
+        """
+        map_dirs: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+        """
+        Local directories (keys) to map to a path in the image (values)
+        """
+        mounts: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+        """
+        Volumes (keys) to mount at a path in the image (values)
+        """
+        name_servers: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The DNS servers to use, defaults to '8.8.8.8'
+        """
+        nanos_version: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The nanos kernel version
+        """
+        nightly_build: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the nightly kernel build should be used
+        """
+        no_trace: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        Syscalls to exclude from tracing
+        """
+        reboot_on_exit: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the image should reboot automatically when an error occurs
+        """
+        run_config: NotRequired[pulumi.Input['OpsRunConfigArgsDict']]
+        """
+        The runtime configuration
+        """
+        target_config: NotRequired[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]
+        """
+        Configuration specific to the target provider
+        """
+        target_root: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The target root filesystem
+        """
+        tfsv4: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the deprecated TFS version 4 encoding should be used
+        """
+        uefi: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the image should support booting via UEFI
+        """
+        version: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The version of the image
+        """
+elif False:
+    OpsConfigArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsConfigArgs:
+    def __init__(__self__, *,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 base_volume_sz: Optional[pulumi.Input[_builtins.str]] = None,
+                 boot: Optional[pulumi.Input[_builtins.str]] = None,
+                 cloud_config: Optional[pulumi.Input['OpsCloudConfigArgs']] = None,
+                 debugflags: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 description: Optional[pulumi.Input[_builtins.str]] = None,
+                 dirs: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 files: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 kernel: Optional[pulumi.Input[_builtins.str]] = None,
+                 klib_dir: Optional[pulumi.Input[_builtins.str]] = None,
+                 klibs: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 language: Optional[pulumi.Input[_builtins.str]] = None,
+                 local_files_parent_directory: Optional[pulumi.Input[_builtins.str]] = None,
+                 manifest_passthrough: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 map_dirs: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 mounts: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 name_servers: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 nanos_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 nightly_build: Optional[pulumi.Input[_builtins.bool]] = None,
+                 no_trace: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 reboot_on_exit: Optional[pulumi.Input[_builtins.bool]] = None,
+                 run_config: Optional[pulumi.Input['OpsRunConfigArgs']] = None,
+                 target_config: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 target_root: Optional[pulumi.Input[_builtins.str]] = None,
+                 tfsv4: Optional[pulumi.Input[_builtins.bool]] = None,
+                 uefi: Optional[pulumi.Input[_builtins.bool]] = None,
+                 version: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The nanovms ops configuration, mirrors the ops JSON configuration file
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] args: The arguments passed to the program when the image is launched
+        :param pulumi.Input[_builtins.str] base_volume_sz: The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        :param pulumi.Input[_builtins.str] boot: The path to the boot image
+        :param pulumi.Input['OpsCloudConfigArgs'] cloud_config: The cloud provider specific configuration
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] debugflags: The kernel debug flags
+        :param pulumi.Input[_builtins.str] description: The description of the image
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] dirs: Local directories to include into the image
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: Environment variables for the image runtime
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] files: Local files to include into the image
+        :param pulumi.Input[_builtins.str] kernel: The path to the kernel image
+        :param pulumi.Input[_builtins.str] klib_dir: The host location of the klibs
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] klibs: The klibs to include into the image (e.g. 'tls', 'ntp')
+        :param pulumi.Input[_builtins.str] language: The language of the program
+        :param pulumi.Input[_builtins.str] local_files_parent_directory: The parent directory of the files and directories specified in files and dirs
+        :param pulumi.Input[Mapping[str, Any]] manifest_passthrough: Options passed straight through to the image manifest
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] map_dirs: Local directories (keys) to map to a path in the image (values)
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] mounts: Volumes (keys) to mount at a path in the image (values)
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] name_servers: The DNS servers to use, defaults to '8.8.8.8'
+        :param pulumi.Input[_builtins.str] nanos_version: The nanos kernel version
+        :param pulumi.Input[_builtins.bool] nightly_build: If the nightly kernel build should be used
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] no_trace: Syscalls to exclude from tracing
+        :param pulumi.Input[_builtins.bool] reboot_on_exit: If the image should reboot automatically when an error occurs
+        :param pulumi.Input['OpsRunConfigArgs'] run_config: The runtime configuration
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] target_config: Configuration specific to the target provider
+        :param pulumi.Input[_builtins.str] target_root: The target root filesystem
+        :param pulumi.Input[_builtins.bool] tfsv4: If the deprecated TFS version 4 encoding should be used
+        :param pulumi.Input[_builtins.bool] uefi: If the image should support booting via UEFI
+        :param pulumi.Input[_builtins.str] version: The version of the image
+        """
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if base_volume_sz is not None:
+            pulumi.set(__self__, "base_volume_sz", base_volume_sz)
+        if boot is not None:
+            pulumi.set(__self__, "boot", boot)
+        if cloud_config is not None:
+            pulumi.set(__self__, "cloud_config", cloud_config)
+        if debugflags is not None:
+            pulumi.set(__self__, "debugflags", debugflags)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if dirs is not None:
+            pulumi.set(__self__, "dirs", dirs)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if files is not None:
+            pulumi.set(__self__, "files", files)
+        if kernel is not None:
+            pulumi.set(__self__, "kernel", kernel)
+        if klib_dir is not None:
+            pulumi.set(__self__, "klib_dir", klib_dir)
+        if klibs is not None:
+            pulumi.set(__self__, "klibs", klibs)
+        if language is not None:
+            pulumi.set(__self__, "language", language)
+        if local_files_parent_directory is not None:
+            pulumi.set(__self__, "local_files_parent_directory", local_files_parent_directory)
+        if manifest_passthrough is not None:
+            pulumi.set(__self__, "manifest_passthrough", manifest_passthrough)
+        if map_dirs is not None:
+            pulumi.set(__self__, "map_dirs", map_dirs)
+        if mounts is not None:
+            pulumi.set(__self__, "mounts", mounts)
+        if name_servers is not None:
+            pulumi.set(__self__, "name_servers", name_servers)
+        if nanos_version is not None:
+            pulumi.set(__self__, "nanos_version", nanos_version)
+        if nightly_build is not None:
+            pulumi.set(__self__, "nightly_build", nightly_build)
+        if no_trace is not None:
+            pulumi.set(__self__, "no_trace", no_trace)
+        if reboot_on_exit is not None:
+            pulumi.set(__self__, "reboot_on_exit", reboot_on_exit)
+        if run_config is not None:
+            pulumi.set(__self__, "run_config", run_config)
+        if target_config is not None:
+            pulumi.set(__self__, "target_config", target_config)
+        if target_root is not None:
+            pulumi.set(__self__, "target_root", target_root)
+        if tfsv4 is not None:
+            pulumi.set(__self__, "tfsv4", tfsv4)
+        if uefi is not None:
+            pulumi.set(__self__, "uefi", uefi)
+        if version is not None:
+            pulumi.set(__self__, "version", version)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The arguments passed to the program when the image is launched
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "args", value)
+
+    @_builtins.property
+    @pulumi.getter(name="baseVolumeSz")
+    def base_volume_sz(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The size of the base volume (e.g. '100m'), defaults to the end of blocks written by TFS
+        """
+        return pulumi.get(self, "base_volume_sz")
+
+    @base_volume_sz.setter
+    def base_volume_sz(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "base_volume_sz", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def boot(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path to the boot image
+        """
+        return pulumi.get(self, "boot")
+
+    @boot.setter
+    def boot(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "boot", value)
+
+    @_builtins.property
+    @pulumi.getter(name="cloudConfig")
+    def cloud_config(self) -> Optional[pulumi.Input['OpsCloudConfigArgs']]:
+        """
+        The cloud provider specific configuration
+        """
+        return pulumi.get(self, "cloud_config")
+
+    @cloud_config.setter
+    def cloud_config(self, value: Optional[pulumi.Input['OpsCloudConfigArgs']]):
+        pulumi.set(self, "cloud_config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def debugflags(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The kernel debug flags
+        """
+        return pulumi.get(self, "debugflags")
+
+    @debugflags.setter
+    def debugflags(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "debugflags", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def description(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The description of the image
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "description", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def dirs(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Local directories to include into the image
+        """
+        return pulumi.get(self, "dirs")
+
+    @dirs.setter
+    def dirs(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "dirs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Environment variables for the image runtime
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "env", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def files(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Local files to include into the image
+        """
+        return pulumi.get(self, "files")
+
+    @files.setter
+    def files(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "files", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def kernel(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path to the kernel image
+        """
+        return pulumi.get(self, "kernel")
+
+    @kernel.setter
+    def kernel(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kernel", value)
+
+    @_builtins.property
+    @pulumi.getter(name="klibDir")
+    def klib_dir(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The host location of the klibs
+        """
+        return pulumi.get(self, "klib_dir")
+
+    @klib_dir.setter
+    def klib_dir(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "klib_dir", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def klibs(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The klibs to include into the image (e.g. 'tls', 'ntp')
+        """
+        return pulumi.get(self, "klibs")
+
+    @klibs.setter
+    def klibs(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "klibs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def language(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The language of the program
+        """
+        return pulumi.get(self, "language")
+
+    @language.setter
+    def language(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "language", value)
+
+    @_builtins.property
+    @pulumi.getter(name="localFilesParentDirectory")
+    def local_files_parent_directory(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The parent directory of the files and directories specified in files and dirs
+        """
+        return pulumi.get(self, "local_files_parent_directory")
+
+    @local_files_parent_directory.setter
+    def local_files_parent_directory(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "local_files_parent_directory", value)
+
+    @_builtins.property
+    @pulumi.getter(name="manifestPassthrough")
+    def manifest_passthrough(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Options passed straight through to the image manifest
+        """
+        return pulumi.get(self, "manifest_passthrough")
+
+    @manifest_passthrough.setter
+    def manifest_passthrough(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "manifest_passthrough", value)
+
+    @_builtins.property
+    @pulumi.getter(name="mapDirs")
+    def map_dirs(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Local directories (keys) to map to a path in the image (values)
+        """
+        return pulumi.get(self, "map_dirs")
+
+    @map_dirs.setter
+    def map_dirs(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "map_dirs", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mounts(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Volumes (keys) to mount at a path in the image (values)
+        """
+        return pulumi.get(self, "mounts")
+
+    @mounts.setter
+    def mounts(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "mounts", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nameServers")
+    def name_servers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The DNS servers to use, defaults to '8.8.8.8'
+        """
+        return pulumi.get(self, "name_servers")
+
+    @name_servers.setter
+    def name_servers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "name_servers", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nanosVersion")
+    def nanos_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The nanos kernel version
+        """
+        return pulumi.get(self, "nanos_version")
+
+    @nanos_version.setter
+    def nanos_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "nanos_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="nightlyBuild")
+    def nightly_build(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the nightly kernel build should be used
+        """
+        return pulumi.get(self, "nightly_build")
+
+    @nightly_build.setter
+    def nightly_build(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "nightly_build", value)
+
+    @_builtins.property
+    @pulumi.getter(name="noTrace")
+    def no_trace(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Syscalls to exclude from tracing
+        """
+        return pulumi.get(self, "no_trace")
+
+    @no_trace.setter
+    def no_trace(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "no_trace", value)
+
+    @_builtins.property
+    @pulumi.getter(name="rebootOnExit")
+    def reboot_on_exit(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the image should reboot automatically when an error occurs
+        """
+        return pulumi.get(self, "reboot_on_exit")
+
+    @reboot_on_exit.setter
+    def reboot_on_exit(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "reboot_on_exit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="runConfig")
+    def run_config(self) -> Optional[pulumi.Input['OpsRunConfigArgs']]:
+        """
+        The runtime configuration
+        """
+        return pulumi.get(self, "run_config")
+
+    @run_config.setter
+    def run_config(self, value: Optional[pulumi.Input['OpsRunConfigArgs']]):
+        pulumi.set(self, "run_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="targetConfig")
+    def target_config(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        Configuration specific to the target provider
+        """
+        return pulumi.get(self, "target_config")
+
+    @target_config.setter
+    def target_config(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "target_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="targetRoot")
+    def target_root(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The target root filesystem
+        """
+        return pulumi.get(self, "target_root")
+
+    @target_root.setter
+    def target_root(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "target_root", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def tfsv4(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the deprecated TFS version 4 encoding should be used
+        """
+        return pulumi.get(self, "tfsv4")
+
+    @tfsv4.setter
+    def tfsv4(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "tfsv4", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def uefi(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the image should support booting via UEFI
+        """
+        return pulumi.get(self, "uefi")
+
+    @uefi.setter
+    def uefi(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "uefi", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The version of the image
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "version", value)
+
+
+if not MYPY:
+    class OpsNicDict(TypedDict):
+        """
+        A network card configuration
+        """
+        bridge_name: NotRequired[_builtins.str]
+        """
+        The name of the bridge
+        """
+        gateway: NotRequired[_builtins.str]
+        """
+        The gateway IP address
+        """
+        ip_address: NotRequired[_builtins.str]
+        """
+        The IP address
+        """
+        ipv6_address: NotRequired[_builtins.str]
+        """
+        The IPv6 address
+        """
+        net_mask: NotRequired[_builtins.str]
+        """
+        The network mask
+        """
+elif False:
+    OpsNicDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsNic:
+    def __init__(__self__, *,
+                 bridge_name: Optional[_builtins.str] = None,
+                 gateway: Optional[_builtins.str] = None,
+                 ip_address: Optional[_builtins.str] = None,
+                 ipv6_address: Optional[_builtins.str] = None,
+                 net_mask: Optional[_builtins.str] = None):
+        """
+        A network card configuration
+        :param _builtins.str bridge_name: The name of the bridge
+        :param _builtins.str gateway: The gateway IP address
+        :param _builtins.str ip_address: The IP address
+        :param _builtins.str ipv6_address: The IPv6 address
+        :param _builtins.str net_mask: The network mask
+        """
+        if bridge_name is not None:
+            pulumi.set(__self__, "bridge_name", bridge_name)
+        if gateway is not None:
+            pulumi.set(__self__, "gateway", gateway)
+        if ip_address is not None:
+            pulumi.set(__self__, "ip_address", ip_address)
+        if ipv6_address is not None:
+            pulumi.set(__self__, "ipv6_address", ipv6_address)
+        if net_mask is not None:
+            pulumi.set(__self__, "net_mask", net_mask)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeName")
+    def bridge_name(self) -> Optional[_builtins.str]:
+        """
+        The name of the bridge
+        """
+        return pulumi.get(self, "bridge_name")
+
+    @bridge_name.setter
+    def bridge_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "bridge_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gateway(self) -> Optional[_builtins.str]:
+        """
+        The gateway IP address
+        """
+        return pulumi.get(self, "gateway")
+
+    @gateway.setter
+    def gateway(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "gateway", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipAddress")
+    def ip_address(self) -> Optional[_builtins.str]:
+        """
+        The IP address
+        """
+        return pulumi.get(self, "ip_address")
+
+    @ip_address.setter
+    def ip_address(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipv6Address")
+    def ipv6_address(self) -> Optional[_builtins.str]:
+        """
+        The IPv6 address
+        """
+        return pulumi.get(self, "ipv6_address")
+
+    @ipv6_address.setter
+    def ipv6_address(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "ipv6_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="netMask")
+    def net_mask(self) -> Optional[_builtins.str]:
+        """
+        The network mask
+        """
+        return pulumi.get(self, "net_mask")
+
+    @net_mask.setter
+    def net_mask(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "net_mask", value)
+
+
+if not MYPY:
+    class OpsNicArgsDict(TypedDict):
+        """
+        A network card configuration
+        """
+        bridge_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The name of the bridge
+        """
+        gateway: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The gateway IP address
+        """
+        ip_address: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The IP address
+        """
+        ipv6_address: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The IPv6 address
+        """
+        net_mask: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The network mask
+        """
+elif False:
+    OpsNicArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsNicArgs:
+    def __init__(__self__, *,
+                 bridge_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 gateway: Optional[pulumi.Input[_builtins.str]] = None,
+                 ip_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 ipv6_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 net_mask: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        A network card configuration
+        :param pulumi.Input[_builtins.str] bridge_name: The name of the bridge
+        :param pulumi.Input[_builtins.str] gateway: The gateway IP address
+        :param pulumi.Input[_builtins.str] ip_address: The IP address
+        :param pulumi.Input[_builtins.str] ipv6_address: The IPv6 address
+        :param pulumi.Input[_builtins.str] net_mask: The network mask
+        """
+        if bridge_name is not None:
+            pulumi.set(__self__, "bridge_name", bridge_name)
+        if gateway is not None:
+            pulumi.set(__self__, "gateway", gateway)
+        if ip_address is not None:
+            pulumi.set(__self__, "ip_address", ip_address)
+        if ipv6_address is not None:
+            pulumi.set(__self__, "ipv6_address", ipv6_address)
+        if net_mask is not None:
+            pulumi.set(__self__, "net_mask", net_mask)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeName")
+    def bridge_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the bridge
+        """
+        return pulumi.get(self, "bridge_name")
+
+    @bridge_name.setter
+    def bridge_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "bridge_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gateway(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The gateway IP address
+        """
+        return pulumi.get(self, "gateway")
+
+    @gateway.setter
+    def gateway(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "gateway", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipAddress")
+    def ip_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The IP address
+        """
+        return pulumi.get(self, "ip_address")
+
+    @ip_address.setter
+    def ip_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipv6Address")
+    def ipv6_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The IPv6 address
+        """
+        return pulumi.get(self, "ipv6_address")
+
+    @ipv6_address.setter
+    def ipv6_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ipv6_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="netMask")
+    def net_mask(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The network mask
+        """
+        return pulumi.get(self, "net_mask")
+
+    @net_mask.setter
+    def net_mask(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "net_mask", value)
+
+
+if not MYPY:
+    class OpsRunConfigDict(TypedDict):
+        """
+        The runtime configuration
+        """
+        accel: NotRequired[_builtins.bool]
+        """
+        If hardware acceleration should be enabled
+        """
+        at_exit: NotRequired[_builtins.str]
+        """
+        A hook to run after the instance stops
+        """
+        attach_volume_on_instance_create: NotRequired[_builtins.bool]
+        """
+        If the volumes in mounts should be attached when the instance is created
+        """
+        bridge_ip_address: NotRequired[_builtins.str]
+        """
+        The IP address of the bridge
+        """
+        bridge_name: NotRequired[_builtins.str]
+        """
+        The name of the bridge
+        """
+        bridged: NotRequired[_builtins.bool]
+        """
+        If bridged networking should be used
+        """
+        can_ip_forward: NotRequired[_builtins.bool]
+        """
+        If IP forwarding should be enabled (gcp)
+        """
+        cpus: NotRequired[_builtins.int]
+        """
+        The number of CPU cores
+        """
+        debug: NotRequired[_builtins.bool]
+        """
+        If debugging should be enabled
+        """
+        gateway: NotRequired[_builtins.str]
+        """
+        The gateway IP address
+        """
+        gdb_port: NotRequired[_builtins.int]
+        """
+        The port for the gdb server
+        """
+        gpu_type: NotRequired[_builtins.str]
+        """
+        The GPU type
+        """
+        gpus: NotRequired[_builtins.int]
+        """
+        The number of GPUs
+        """
+        instance_group: NotRequired[_builtins.str]
+        """
+        The instance group
+        """
+        instance_name: NotRequired[_builtins.str]
+        """
+        The name of the instance
+        """
+        ip_address: NotRequired[_builtins.str]
+        """
+        The static IP address
+        """
+        ipv6_address: NotRequired[_builtins.str]
+        """
+        The static IPv6 address
+        """
+        memory: NotRequired[_builtins.str]
+        """
+        The amount of memory, optionally suffixed with 'M' or 'G'
+        """
+        mgmt: NotRequired[_builtins.str]
+        """
+        The management port for QMP access (onprem)
+        """
+        mounts: NotRequired[Sequence[_builtins.str]]
+        """
+        Volumes to mount, in the form '<volume>:<path>'
+        """
+        net_mask: NotRequired[_builtins.str]
+        """
+        The network mask
+        """
+        nics: NotRequired[Sequence['OpsNicDict']]
+        """
+        Pre-configured network cards (proxmox)
+        """
+        ports: NotRequired[Sequence[_builtins.str]]
+        """
+        The TCP ports to expose
+        """
+        qmp: NotRequired[_builtins.bool]
+        """
+        If the QMP interface should be enabled (onprem)
+        """
+        show_debug: NotRequired[_builtins.bool]
+        """
+        If debug messages should be shown
+        """
+        show_errors: NotRequired[_builtins.bool]
+        """
+        If errors should be shown
+        """
+        show_warnings: NotRequired[_builtins.bool]
+        """
+        If warnings should be shown
+        """
+        tap_name: NotRequired[_builtins.str]
+        """
+        The name of the tap device
+        """
+        threads_per_core: NotRequired[_builtins.int]
+        """
+        The number of threads per physical core
+        """
+        udp_ports: NotRequired[Sequence[_builtins.str]]
+        """
+        The UDP ports to expose
+        """
+        verbose: NotRequired[_builtins.bool]
+        """
+        If verbose logging should be enabled
+        """
+        vga: NotRequired[_builtins.bool]
+        """
+        If a VGA output device should be emulated
+        """
+        volume_size_in_gb: NotRequired[_builtins.int]
+        """
+        The volume size in GB (openstack)
+        """
+elif False:
+    OpsRunConfigDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsRunConfig:
+    def __init__(__self__, *,
+                 accel: Optional[_builtins.bool] = None,
+                 at_exit: Optional[_builtins.str] = None,
+                 attach_volume_on_instance_create: Optional[_builtins.bool] = None,
+                 bridge_ip_address: Optional[_builtins.str] = None,
+                 bridge_name: Optional[_builtins.str] = None,
+                 bridged: Optional[_builtins.bool] = None,
+                 can_ip_forward: Optional[_builtins.bool] = None,
+                 cpus: Optional[_builtins.int] = None,
+                 debug: Optional[_builtins.bool] = None,
+                 gateway: Optional[_builtins.str] = None,
+                 gdb_port: Optional[_builtins.int] = None,
+                 gpu_type: Optional[_builtins.str] = None,
+                 gpus: Optional[_builtins.int] = None,
+                 instance_group: Optional[_builtins.str] = None,
+                 instance_name: Optional[_builtins.str] = None,
+                 ip_address: Optional[_builtins.str] = None,
+                 ipv6_address: Optional[_builtins.str] = None,
+                 memory: Optional[_builtins.str] = None,
+                 mgmt: Optional[_builtins.str] = None,
+                 mounts: Optional[Sequence[_builtins.str]] = None,
+                 net_mask: Optional[_builtins.str] = None,
+                 nics: Optional[Sequence['OpsNic']] = None,
+                 ports: Optional[Sequence[_builtins.str]] = None,
+                 qmp: Optional[_builtins.bool] = None,
+                 show_debug: Optional[_builtins.bool] = None,
+                 show_errors: Optional[_builtins.bool] = None,
+                 show_warnings: Optional[_builtins.bool] = None,
+                 tap_name: Optional[_builtins.str] = None,
+                 threads_per_core: Optional[_builtins.int] = None,
+                 udp_ports: Optional[Sequence[_builtins.str]] = None,
+                 verbose: Optional[_builtins.bool] = None,
+                 vga: Optional[_builtins.bool] = None,
+                 volume_size_in_gb: Optional[_builtins.int] = None):
+        """
+        The runtime configuration
+        :param _builtins.bool accel: If hardware acceleration should be enabled
+        :param _builtins.str at_exit: A hook to run after the instance stops
+        :param _builtins.bool attach_volume_on_instance_create: If the volumes in mounts should be attached when the instance is created
+        :param _builtins.str bridge_ip_address: The IP address of the bridge
+        :param _builtins.str bridge_name: The name of the bridge
+        :param _builtins.bool bridged: If bridged networking should be used
+        :param _builtins.bool can_ip_forward: If IP forwarding should be enabled (gcp)
+        :param _builtins.int cpus: The number of CPU cores
+        :param _builtins.bool debug: If debugging should be enabled
+        :param _builtins.str gateway: The gateway IP address
+        :param _builtins.int gdb_port: The port for the gdb server
+        :param _builtins.str gpu_type: The GPU type
+        :param _builtins.int gpus: The number of GPUs
+        :param _builtins.str instance_group: The instance group
+        :param _builtins.str instance_name: The name of the instance
+        :param _builtins.str ip_address: The static IP address
+        :param _builtins.str ipv6_address: The static IPv6 address
+        :param _builtins.str memory: The amount of memory, optionally suffixed with 'M' or 'G'
+        :param _builtins.str mgmt: The management port for QMP access (onprem)
+        :param Sequence[_builtins.str] mounts: Volumes to mount, in the form '<volume>:<path>'
+        :param _builtins.str net_mask: The network mask
+        :param Sequence['OpsNic'] nics: Pre-configured network cards (proxmox)
+        :param Sequence[_builtins.str] ports: The TCP ports to expose
+        :param _builtins.bool qmp: If the QMP interface should be enabled (onprem)
+        :param _builtins.bool show_debug: If debug messages should be shown
+        :param _builtins.bool show_errors: If errors should be shown
+        :param _builtins.bool show_warnings: If warnings should be shown
+        :param _builtins.str tap_name: The name of the tap device
+        :param _builtins.int threads_per_core: The number of threads per physical core
+        :param Sequence[_builtins.str] udp_ports: The UDP ports to expose
+        :param _builtins.bool verbose: If verbose logging should be enabled
+        :param _builtins.bool vga: If a VGA output device should be emulated
+        :param _builtins.int volume_size_in_gb: The volume size in GB (openstack)
+        """
+        if accel is not None:
+            pulumi.set(__self__, "accel", accel)
+        if at_exit is not None:
+            pulumi.set(__self__, "at_exit", at_exit)
+        if attach_volume_on_instance_create is not None:
+            pulumi.set(__self__, "attach_volume_on_instance_create", attach_volume_on_instance_create)
+        if bridge_ip_address is not None:
+            pulumi.set(__self__, "bridge_ip_address", bridge_ip_address)
+        if bridge_name is not None:
+            pulumi.set(__self__, "bridge_name", bridge_name)
+        if bridged is not None:
+            pulumi.set(__self__, "bridged", bridged)
+        if can_ip_forward is not None:
+            pulumi.set(__self__, "can_ip_forward", can_ip_forward)
+        if cpus is not None:
+            pulumi.set(__self__, "cpus", cpus)
+        if debug is not None:
+            pulumi.set(__self__, "debug", debug)
+        if gateway is not None:
+            pulumi.set(__self__, "gateway", gateway)
+        if gdb_port is not None:
+            pulumi.set(__self__, "gdb_port", gdb_port)
+        if gpu_type is not None:
+            pulumi.set(__self__, "gpu_type", gpu_type)
+        if gpus is not None:
+            pulumi.set(__self__, "gpus", gpus)
+        if instance_group is not None:
+            pulumi.set(__self__, "instance_group", instance_group)
+        if instance_name is not None:
+            pulumi.set(__self__, "instance_name", instance_name)
+        if ip_address is not None:
+            pulumi.set(__self__, "ip_address", ip_address)
+        if ipv6_address is not None:
+            pulumi.set(__self__, "ipv6_address", ipv6_address)
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if mgmt is not None:
+            pulumi.set(__self__, "mgmt", mgmt)
+        if mounts is not None:
+            pulumi.set(__self__, "mounts", mounts)
+        if net_mask is not None:
+            pulumi.set(__self__, "net_mask", net_mask)
+        if nics is not None:
+            pulumi.set(__self__, "nics", nics)
+        if ports is not None:
+            pulumi.set(__self__, "ports", ports)
+        if qmp is not None:
+            pulumi.set(__self__, "qmp", qmp)
+        if show_debug is not None:
+            pulumi.set(__self__, "show_debug", show_debug)
+        if show_errors is not None:
+            pulumi.set(__self__, "show_errors", show_errors)
+        if show_warnings is not None:
+            pulumi.set(__self__, "show_warnings", show_warnings)
+        if tap_name is not None:
+            pulumi.set(__self__, "tap_name", tap_name)
+        if threads_per_core is not None:
+            pulumi.set(__self__, "threads_per_core", threads_per_core)
+        if udp_ports is not None:
+            pulumi.set(__self__, "udp_ports", udp_ports)
+        if verbose is not None:
+            pulumi.set(__self__, "verbose", verbose)
+        if vga is not None:
+            pulumi.set(__self__, "vga", vga)
+        if volume_size_in_gb is not None:
+            pulumi.set(__self__, "volume_size_in_gb", volume_size_in_gb)
+
+    @_builtins.property
+    @pulumi.getter
+    def accel(self) -> Optional[_builtins.bool]:
+        """
+        If hardware acceleration should be enabled
+        """
+        return pulumi.get(self, "accel")
+
+    @accel.setter
+    def accel(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "accel", value)
+
+    @_builtins.property
+    @pulumi.getter(name="atExit")
+    def at_exit(self) -> Optional[_builtins.str]:
+        """
+        A hook to run after the instance stops
+        """
+        return pulumi.get(self, "at_exit")
+
+    @at_exit.setter
+    def at_exit(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "at_exit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="attachVolumeOnInstanceCreate")
+    def attach_volume_on_instance_create(self) -> Optional[_builtins.bool]:
+        """
+        If the volumes in mounts should be attached when the instance is created
+        """
+        return pulumi.get(self, "attach_volume_on_instance_create")
+
+    @attach_volume_on_instance_create.setter
+    def attach_volume_on_instance_create(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "attach_volume_on_instance_create", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeIPAddress")
+    def bridge_ip_address(self) -> Optional[_builtins.str]:
+        """
+        The IP address of the bridge
+        """
+        return pulumi.get(self, "bridge_ip_address")
+
+    @bridge_ip_address.setter
+    def bridge_ip_address(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "bridge_ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeName")
+    def bridge_name(self) -> Optional[_builtins.str]:
+        """
+        The name of the bridge
+        """
+        return pulumi.get(self, "bridge_name")
+
+    @bridge_name.setter
+    def bridge_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "bridge_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def bridged(self) -> Optional[_builtins.bool]:
+        """
+        If bridged networking should be used
+        """
+        return pulumi.get(self, "bridged")
+
+    @bridged.setter
+    def bridged(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "bridged", value)
+
+    @_builtins.property
+    @pulumi.getter(name="canIPForward")
+    def can_ip_forward(self) -> Optional[_builtins.bool]:
+        """
+        If IP forwarding should be enabled (gcp)
+        """
+        return pulumi.get(self, "can_ip_forward")
+
+    @can_ip_forward.setter
+    def can_ip_forward(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "can_ip_forward", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def cpus(self) -> Optional[_builtins.int]:
+        """
+        The number of CPU cores
+        """
+        return pulumi.get(self, "cpus")
+
+    @cpus.setter
+    def cpus(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "cpus", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def debug(self) -> Optional[_builtins.bool]:
+        """
+        If debugging should be enabled
+        """
+        return pulumi.get(self, "debug")
+
+    @debug.setter
+    def debug(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "debug", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gateway(self) -> Optional[_builtins.str]:
+        """
+        The gateway IP address
+        """
+        return pulumi.get(self, "gateway")
+
+    @gateway.setter
+    def gateway(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "gateway", value)
+
+    @_builtins.property
+    @pulumi.getter(name="gdbPort")
+    def gdb_port(self) -> Optional[_builtins.int]:
+        """
+        The port for the gdb server
+        """
+        return pulumi.get(self, "gdb_port")
+
+    @gdb_port.setter
+    def gdb_port(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "gdb_port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="gpuType")
+    def gpu_type(self) -> Optional[_builtins.str]:
+        """
+        The GPU type
+        """
+        return pulumi.get(self, "gpu_type")
+
+    @gpu_type.setter
+    def gpu_type(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "gpu_type", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gpus(self) -> Optional[_builtins.int]:
+        """
+        The number of GPUs
+        """
+        return pulumi.get(self, "gpus")
+
+    @gpus.setter
+    def gpus(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "gpus", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceGroup")
+    def instance_group(self) -> Optional[_builtins.str]:
+        """
+        The instance group
+        """
+        return pulumi.get(self, "instance_group")
+
+    @instance_group.setter
+    def instance_group(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "instance_group", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceName")
+    def instance_name(self) -> Optional[_builtins.str]:
+        """
+        The name of the instance
+        """
+        return pulumi.get(self, "instance_name")
+
+    @instance_name.setter
+    def instance_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "instance_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipAddress")
+    def ip_address(self) -> Optional[_builtins.str]:
+        """
+        The static IP address
+        """
+        return pulumi.get(self, "ip_address")
+
+    @ip_address.setter
+    def ip_address(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipv6Address")
+    def ipv6_address(self) -> Optional[_builtins.str]:
+        """
+        The static IPv6 address
+        """
+        return pulumi.get(self, "ipv6_address")
+
+    @ipv6_address.setter
+    def ipv6_address(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "ipv6_address", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def memory(self) -> Optional[_builtins.str]:
+        """
+        The amount of memory, optionally suffixed with 'M' or 'G'
+        """
+        return pulumi.get(self, "memory")
+
+    @memory.setter
+    def memory(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "memory", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mgmt(self) -> Optional[_builtins.str]:
+        """
+        The management port for QMP access (onprem)
+        """
+        return pulumi.get(self, "mgmt")
+
+    @mgmt.setter
+    def mgmt(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "mgmt", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mounts(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        Volumes to mount, in the form '<volume>:<path>'
+        """
+        return pulumi.get(self, "mounts")
+
+    @mounts.setter
+    def mounts(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "mounts", value)
+
+    @_builtins.property
+    @pulumi.getter(name="netMask")
+    def net_mask(self) -> Optional[_builtins.str]:
+        """
+        The network mask
+        """
+        return pulumi.get(self, "net_mask")
+
+    @net_mask.setter
+    def net_mask(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "net_mask", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def nics(self) -> Optional[Sequence['OpsNic']]:
+        """
+        Pre-configured network cards (proxmox)
+        """
+        return pulumi.get(self, "nics")
+
+    @nics.setter
+    def nics(self, value: Optional[Sequence['OpsNic']]):
+        pulumi.set(self, "nics", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def ports(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The TCP ports to expose
+        """
+        return pulumi.get(self, "ports")
+
+    @ports.setter
+    def ports(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "ports", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def qmp(self) -> Optional[_builtins.bool]:
+        """
+        If the QMP interface should be enabled (onprem)
+        """
+        return pulumi.get(self, "qmp")
+
+    @qmp.setter
+    def qmp(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "qmp", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showDebug")
+    def show_debug(self) -> Optional[_builtins.bool]:
+        """
+        If debug messages should be shown
+        """
+        return pulumi.get(self, "show_debug")
+
+    @show_debug.setter
+    def show_debug(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "show_debug", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showErrors")
+    def show_errors(self) -> Optional[_builtins.bool]:
+        """
+        If errors should be shown
+        """
+        return pulumi.get(self, "show_errors")
+
+    @show_errors.setter
+    def show_errors(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "show_errors", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showWarnings")
+    def show_warnings(self) -> Optional[_builtins.bool]:
+        """
+        If warnings should be shown
+        """
+        return pulumi.get(self, "show_warnings")
+
+    @show_warnings.setter
+    def show_warnings(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "show_warnings", value)
+
+    @_builtins.property
+    @pulumi.getter(name="tapName")
+    def tap_name(self) -> Optional[_builtins.str]:
+        """
+        The name of the tap device
+        """
+        return pulumi.get(self, "tap_name")
+
+    @tap_name.setter
+    def tap_name(self, value: Optional[_builtins.str]):
+        pulumi.set(self, "tap_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="threadsPerCore")
+    def threads_per_core(self) -> Optional[_builtins.int]:
+        """
+        The number of threads per physical core
+        """
+        return pulumi.get(self, "threads_per_core")
+
+    @threads_per_core.setter
+    def threads_per_core(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "threads_per_core", value)
+
+    @_builtins.property
+    @pulumi.getter(name="udpPorts")
+    def udp_ports(self) -> Optional[Sequence[_builtins.str]]:
+        """
+        The UDP ports to expose
+        """
+        return pulumi.get(self, "udp_ports")
+
+    @udp_ports.setter
+    def udp_ports(self, value: Optional[Sequence[_builtins.str]]):
+        pulumi.set(self, "udp_ports", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def verbose(self) -> Optional[_builtins.bool]:
+        """
+        If verbose logging should be enabled
+        """
+        return pulumi.get(self, "verbose")
+
+    @verbose.setter
+    def verbose(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "verbose", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def vga(self) -> Optional[_builtins.bool]:
+        """
+        If a VGA output device should be emulated
+        """
+        return pulumi.get(self, "vga")
+
+    @vga.setter
+    def vga(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "vga", value)
+
+    @_builtins.property
+    @pulumi.getter(name="volumeSizeInGb")
+    def volume_size_in_gb(self) -> Optional[_builtins.int]:
+        """
+        The volume size in GB (openstack)
+        """
+        return pulumi.get(self, "volume_size_in_gb")
+
+    @volume_size_in_gb.setter
+    def volume_size_in_gb(self, value: Optional[_builtins.int]):
+        pulumi.set(self, "volume_size_in_gb", value)
+
+
+if not MYPY:
+    class OpsRunConfigArgsDict(TypedDict):
+        """
+        The runtime configuration
+        """
+        accel: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If hardware acceleration should be enabled
+        """
+        at_exit: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        A hook to run after the instance stops
+        """
+        attach_volume_on_instance_create: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the volumes in mounts should be attached when the instance is created
+        """
+        bridge_ip_address: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The IP address of the bridge
+        """
+        bridge_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The name of the bridge
+        """
+        bridged: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If bridged networking should be used
+        """
+        can_ip_forward: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If IP forwarding should be enabled (gcp)
+        """
+        cpus: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The number of CPU cores
+        """
+        debug: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If debugging should be enabled
+        """
+        gateway: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The gateway IP address
+        """
+        gdb_port: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The port for the gdb server
+        """
+        gpu_type: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The GPU type
+        """
+        gpus: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The number of GPUs
+        """
+        instance_group: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The instance group
+        """
+        instance_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The name of the instance
+        """
+        ip_address: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The static IP address
+        """
+        ipv6_address: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The static IPv6 address
+        """
+        memory: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The amount of memory, optionally suffixed with 'M' or 'G'
+        """
+        mgmt: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The management port for QMP access (onprem)
+        """
+        mounts: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        Volumes to mount, in the form '<volume>:<path>'
+        """
+        net_mask: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The network mask
+        """
+        nics: NotRequired[pulumi.Input[Sequence[pulumi.Input['OpsNicArgsDict']]]]
+        """
+        Pre-configured network cards (proxmox)
+        """
+        ports: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The TCP ports to expose
+        """
+        qmp: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the QMP interface should be enabled (onprem)
+        """
+        show_debug: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If debug messages should be shown
+        """
+        show_errors: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If errors should be shown
+        """
+        show_warnings: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If warnings should be shown
+        """
+        tap_name: NotRequired[pulumi.Input[_builtins.str]]
+        """
+        The name of the tap device
+        """
+        threads_per_core: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The number of threads per physical core
+        """
+        udp_ports: NotRequired[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]
+        """
+        The UDP ports to expose
+        """
+        verbose: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If verbose logging should be enabled
+        """
+        vga: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If a VGA output device should be emulated
+        """
+        volume_size_in_gb: NotRequired[pulumi.Input[_builtins.int]]
+        """
+        The volume size in GB (openstack)
+        """
+elif False:
+    OpsRunConfigArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsRunConfigArgs:
+    def __init__(__self__, *,
+                 accel: Optional[pulumi.Input[_builtins.bool]] = None,
+                 at_exit: Optional[pulumi.Input[_builtins.str]] = None,
+                 attach_volume_on_instance_create: Optional[pulumi.Input[_builtins.bool]] = None,
+                 bridge_ip_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 bridge_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 bridged: Optional[pulumi.Input[_builtins.bool]] = None,
+                 can_ip_forward: Optional[pulumi.Input[_builtins.bool]] = None,
+                 cpus: Optional[pulumi.Input[_builtins.int]] = None,
+                 debug: Optional[pulumi.Input[_builtins.bool]] = None,
+                 gateway: Optional[pulumi.Input[_builtins.str]] = None,
+                 gdb_port: Optional[pulumi.Input[_builtins.int]] = None,
+                 gpu_type: Optional[pulumi.Input[_builtins.str]] = None,
+                 gpus: Optional[pulumi.Input[_builtins.int]] = None,
+                 instance_group: Optional[pulumi.Input[_builtins.str]] = None,
+                 instance_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ip_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 ipv6_address: Optional[pulumi.Input[_builtins.str]] = None,
+                 memory: Optional[pulumi.Input[_builtins.str]] = None,
+                 mgmt: Optional[pulumi.Input[_builtins.str]] = None,
+                 mounts: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 net_mask: Optional[pulumi.Input[_builtins.str]] = None,
+                 nics: Optional[pulumi.Input[Sequence[pulumi.Input['OpsNicArgs']]]] = None,
+                 ports: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 qmp: Optional[pulumi.Input[_builtins.bool]] = None,
+                 show_debug: Optional[pulumi.Input[_builtins.bool]] = None,
+                 show_errors: Optional[pulumi.Input[_builtins.bool]] = None,
+                 show_warnings: Optional[pulumi.Input[_builtins.bool]] = None,
+                 tap_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 threads_per_core: Optional[pulumi.Input[_builtins.int]] = None,
+                 udp_ports: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 verbose: Optional[pulumi.Input[_builtins.bool]] = None,
+                 vga: Optional[pulumi.Input[_builtins.bool]] = None,
+                 volume_size_in_gb: Optional[pulumi.Input[_builtins.int]] = None):
+        """
+        The runtime configuration
+        :param pulumi.Input[_builtins.bool] accel: If hardware acceleration should be enabled
+        :param pulumi.Input[_builtins.str] at_exit: A hook to run after the instance stops
+        :param pulumi.Input[_builtins.bool] attach_volume_on_instance_create: If the volumes in mounts should be attached when the instance is created
+        :param pulumi.Input[_builtins.str] bridge_ip_address: The IP address of the bridge
+        :param pulumi.Input[_builtins.str] bridge_name: The name of the bridge
+        :param pulumi.Input[_builtins.bool] bridged: If bridged networking should be used
+        :param pulumi.Input[_builtins.bool] can_ip_forward: If IP forwarding should be enabled (gcp)
+        :param pulumi.Input[_builtins.int] cpus: The number of CPU cores
+        :param pulumi.Input[_builtins.bool] debug: If debugging should be enabled
+        :param pulumi.Input[_builtins.str] gateway: The gateway IP address
+        :param pulumi.Input[_builtins.int] gdb_port: The port for the gdb server
+        :param pulumi.Input[_builtins.str] gpu_type: The GPU type
+        :param pulumi.Input[_builtins.int] gpus: The number of GPUs
+        :param pulumi.Input[_builtins.str] instance_group: The instance group
+        :param pulumi.Input[_builtins.str] instance_name: The name of the instance
+        :param pulumi.Input[_builtins.str] ip_address: The static IP address
+        :param pulumi.Input[_builtins.str] ipv6_address: The static IPv6 address
+        :param pulumi.Input[_builtins.str] memory: The amount of memory, optionally suffixed with 'M' or 'G'
+        :param pulumi.Input[_builtins.str] mgmt: The management port for QMP access (onprem)
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] mounts: Volumes to mount, in the form '<volume>:<path>'
+        :param pulumi.Input[_builtins.str] net_mask: The network mask
+        :param pulumi.Input[Sequence[pulumi.Input['OpsNicArgs']]] nics: Pre-configured network cards (proxmox)
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] ports: The TCP ports to expose
+        :param pulumi.Input[_builtins.bool] qmp: If the QMP interface should be enabled (onprem)
+        :param pulumi.Input[_builtins.bool] show_debug: If debug messages should be shown
+        :param pulumi.Input[_builtins.bool] show_errors: If errors should be shown
+        :param pulumi.Input[_builtins.bool] show_warnings: If warnings should be shown
+        :param pulumi.Input[_builtins.str] tap_name: The name of the tap device
+        :param pulumi.Input[_builtins.int] threads_per_core: The number of threads per physical core
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] udp_ports: The UDP ports to expose
+        :param pulumi.Input[_builtins.bool] verbose: If verbose logging should be enabled
+        :param pulumi.Input[_builtins.bool] vga: If a VGA output device should be emulated
+        :param pulumi.Input[_builtins.int] volume_size_in_gb: The volume size in GB (openstack)
+        """
+        if accel is not None:
+            pulumi.set(__self__, "accel", accel)
+        if at_exit is not None:
+            pulumi.set(__self__, "at_exit", at_exit)
+        if attach_volume_on_instance_create is not None:
+            pulumi.set(__self__, "attach_volume_on_instance_create", attach_volume_on_instance_create)
+        if bridge_ip_address is not None:
+            pulumi.set(__self__, "bridge_ip_address", bridge_ip_address)
+        if bridge_name is not None:
+            pulumi.set(__self__, "bridge_name", bridge_name)
+        if bridged is not None:
+            pulumi.set(__self__, "bridged", bridged)
+        if can_ip_forward is not None:
+            pulumi.set(__self__, "can_ip_forward", can_ip_forward)
+        if cpus is not None:
+            pulumi.set(__self__, "cpus", cpus)
+        if debug is not None:
+            pulumi.set(__self__, "debug", debug)
+        if gateway is not None:
+            pulumi.set(__self__, "gateway", gateway)
+        if gdb_port is not None:
+            pulumi.set(__self__, "gdb_port", gdb_port)
+        if gpu_type is not None:
+            pulumi.set(__self__, "gpu_type", gpu_type)
+        if gpus is not None:
+            pulumi.set(__self__, "gpus", gpus)
+        if instance_group is not None:
+            pulumi.set(__self__, "instance_group", instance_group)
+        if instance_name is not None:
+            pulumi.set(__self__, "instance_name", instance_name)
+        if ip_address is not None:
+            pulumi.set(__self__, "ip_address", ip_address)
+        if ipv6_address is not None:
+            pulumi.set(__self__, "ipv6_address", ipv6_address)
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if mgmt is not None:
+            pulumi.set(__self__, "mgmt", mgmt)
+        if mounts is not None:
+            pulumi.set(__self__, "mounts", mounts)
+        if net_mask is not None:
+            pulumi.set(__self__, "net_mask", net_mask)
+        if nics is not None:
+            pulumi.set(__self__, "nics", nics)
+        if ports is not None:
+            pulumi.set(__self__, "ports", ports)
+        if qmp is not None:
+            pulumi.set(__self__, "qmp", qmp)
+        if show_debug is not None:
+            pulumi.set(__self__, "show_debug", show_debug)
+        if show_errors is not None:
+            pulumi.set(__self__, "show_errors", show_errors)
+        if show_warnings is not None:
+            pulumi.set(__self__, "show_warnings", show_warnings)
+        if tap_name is not None:
+            pulumi.set(__self__, "tap_name", tap_name)
+        if threads_per_core is not None:
+            pulumi.set(__self__, "threads_per_core", threads_per_core)
+        if udp_ports is not None:
+            pulumi.set(__self__, "udp_ports", udp_ports)
+        if verbose is not None:
+            pulumi.set(__self__, "verbose", verbose)
+        if vga is not None:
+            pulumi.set(__self__, "vga", vga)
+        if volume_size_in_gb is not None:
+            pulumi.set(__self__, "volume_size_in_gb", volume_size_in_gb)
+
+    @_builtins.property
+    @pulumi.getter
+    def accel(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If hardware acceleration should be enabled
+        """
+        return pulumi.get(self, "accel")
+
+    @accel.setter
+    def accel(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "accel", value)
+
+    @_builtins.property
+    @pulumi.getter(name="atExit")
+    def at_exit(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        A hook to run after the instance stops
+        """
+        return pulumi.get(self, "at_exit")
+
+    @at_exit.setter
+    def at_exit(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "at_exit", value)
+
+    @_builtins.property
+    @pulumi.getter(name="attachVolumeOnInstanceCreate")
+    def attach_volume_on_instance_create(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the volumes in mounts should be attached when the instance is created
+        """
+        return pulumi.get(self, "attach_volume_on_instance_create")
+
+    @attach_volume_on_instance_create.setter
+    def attach_volume_on_instance_create(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "attach_volume_on_instance_create", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeIPAddress")
+    def bridge_ip_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The IP address of the bridge
+        """
+        return pulumi.get(self, "bridge_ip_address")
+
+    @bridge_ip_address.setter
+    def bridge_ip_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "bridge_ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="bridgeName")
+    def bridge_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the bridge
+        """
+        return pulumi.get(self, "bridge_name")
+
+    @bridge_name.setter
+    def bridge_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "bridge_name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def bridged(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If bridged networking should be used
+        """
+        return pulumi.get(self, "bridged")
+
+    @bridged.setter
+    def bridged(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "bridged", value)
+
+    @_builtins.property
+    @pulumi.getter(name="canIPForward")
+    def can_ip_forward(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If IP forwarding should be enabled (gcp)
+        """
+        return pulumi.get(self, "can_ip_forward")
+
+    @can_ip_forward.setter
+    def can_ip_forward(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "can_ip_forward", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def cpus(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of CPU cores
+        """
+        return pulumi.get(self, "cpus")
+
+    @cpus.setter
+    def cpus(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "cpus", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def debug(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If debugging should be enabled
+        """
+        return pulumi.get(self, "debug")
+
+    @debug.setter
+    def debug(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "debug", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gateway(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The gateway IP address
+        """
+        return pulumi.get(self, "gateway")
+
+    @gateway.setter
+    def gateway(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "gateway", value)
+
+    @_builtins.property
+    @pulumi.getter(name="gdbPort")
+    def gdb_port(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The port for the gdb server
+        """
+        return pulumi.get(self, "gdb_port")
+
+    @gdb_port.setter
+    def gdb_port(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "gdb_port", value)
+
+    @_builtins.property
+    @pulumi.getter(name="gpuType")
+    def gpu_type(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The GPU type
+        """
+        return pulumi.get(self, "gpu_type")
+
+    @gpu_type.setter
+    def gpu_type(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "gpu_type", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def gpus(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of GPUs
+        """
+        return pulumi.get(self, "gpus")
+
+    @gpus.setter
+    def gpus(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "gpus", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceGroup")
+    def instance_group(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The instance group
+        """
+        return pulumi.get(self, "instance_group")
+
+    @instance_group.setter
+    def instance_group(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "instance_group", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceName")
+    def instance_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the instance
+        """
+        return pulumi.get(self, "instance_name")
+
+    @instance_name.setter
+    def instance_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "instance_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipAddress")
+    def ip_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The static IP address
+        """
+        return pulumi.get(self, "ip_address")
+
+    @ip_address.setter
+    def ip_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ip_address", value)
+
+    @_builtins.property
+    @pulumi.getter(name="ipv6Address")
+    def ipv6_address(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The static IPv6 address
+        """
+        return pulumi.get(self, "ipv6_address")
+
+    @ipv6_address.setter
+    def ipv6_address(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ipv6_address", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def memory(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The amount of memory, optionally suffixed with 'M' or 'G'
+        """
+        return pulumi.get(self, "memory")
+
+    @memory.setter
+    def memory(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "memory", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mgmt(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The management port for QMP access (onprem)
+        """
+        return pulumi.get(self, "mgmt")
+
+    @mgmt.setter
+    def mgmt(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "mgmt", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def mounts(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        Volumes to mount, in the form '<volume>:<path>'
+        """
+        return pulumi.get(self, "mounts")
+
+    @mounts.setter
+    def mounts(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "mounts", value)
+
+    @_builtins.property
+    @pulumi.getter(name="netMask")
+    def net_mask(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The network mask
+        """
+        return pulumi.get(self, "net_mask")
+
+    @net_mask.setter
+    def net_mask(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "net_mask", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def nics(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['OpsNicArgs']]]]:
+        """
+        Pre-configured network cards (proxmox)
+        """
+        return pulumi.get(self, "nics")
+
+    @nics.setter
+    def nics(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['OpsNicArgs']]]]):
+        pulumi.set(self, "nics", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def ports(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The TCP ports to expose
+        """
+        return pulumi.get(self, "ports")
+
+    @ports.setter
+    def ports(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "ports", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def qmp(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the QMP interface should be enabled (onprem)
+        """
+        return pulumi.get(self, "qmp")
+
+    @qmp.setter
+    def qmp(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "qmp", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showDebug")
+    def show_debug(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If debug messages should be shown
+        """
+        return pulumi.get(self, "show_debug")
+
+    @show_debug.setter
+    def show_debug(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "show_debug", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showErrors")
+    def show_errors(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If errors should be shown
+        """
+        return pulumi.get(self, "show_errors")
+
+    @show_errors.setter
+    def show_errors(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "show_errors", value)
+
+    @_builtins.property
+    @pulumi.getter(name="showWarnings")
+    def show_warnings(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If warnings should be shown
+        """
+        return pulumi.get(self, "show_warnings")
+
+    @show_warnings.setter
+    def show_warnings(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "show_warnings", value)
+
+    @_builtins.property
+    @pulumi.getter(name="tapName")
+    def tap_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the tap device
+        """
+        return pulumi.get(self, "tap_name")
+
+    @tap_name.setter
+    def tap_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "tap_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="threadsPerCore")
+    def threads_per_core(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of threads per physical core
+        """
+        return pulumi.get(self, "threads_per_core")
+
+    @threads_per_core.setter
+    def threads_per_core(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "threads_per_core", value)
+
+    @_builtins.property
+    @pulumi.getter(name="udpPorts")
+    def udp_ports(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The UDP ports to expose
+        """
+        return pulumi.get(self, "udp_ports")
+
+    @udp_ports.setter
+    def udp_ports(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "udp_ports", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def verbose(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If verbose logging should be enabled
+        """
+        return pulumi.get(self, "verbose")
+
+    @verbose.setter
+    def verbose(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "verbose", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def vga(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If a VGA output device should be emulated
+        """
+        return pulumi.get(self, "vga")
+
+    @vga.setter
+    def vga(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "vga", value)
+
+    @_builtins.property
+    @pulumi.getter(name="volumeSizeInGb")
+    def volume_size_in_gb(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The volume size in GB (openstack)
+        """
+        return pulumi.get(self, "volume_size_in_gb")
+
+    @volume_size_in_gb.setter
+    def volume_size_in_gb(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "volume_size_in_gb", value)
+
+
+if not MYPY:
+    class OpsTagDict(TypedDict):
+        """
+        A tag (label) for images and instances
+        """
+        key: _builtins.str
+        """
+        The tag key
+        """
+        value: _builtins.str
+        """
+        The tag value
+        """
+        image_label: NotRequired[_builtins.bool]
+        """
+        If the tag should be used as an image label
+        """
+        instance_label: NotRequired[_builtins.bool]
+        """
+        If the tag should be used as an instance label
+        """
+        instance_metadata: NotRequired[_builtins.bool]
+        """
+        If the tag should be used as instance metadata
+        """
+        instance_network: NotRequired[_builtins.bool]
+        """
+        If the tag value should be used as an instance network tag
+        """
+elif False:
+    OpsTagDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsTag:
+    def __init__(__self__, *,
+                 key: _builtins.str,
+                 value: _builtins.str,
+                 image_label: Optional[_builtins.bool] = None,
+                 instance_label: Optional[_builtins.bool] = None,
+                 instance_metadata: Optional[_builtins.bool] = None,
+                 instance_network: Optional[_builtins.bool] = None):
+        """
+        A tag (label) for images and instances
+        :param _builtins.str key: The tag key
+        :param _builtins.str value: The tag value
+        :param _builtins.bool image_label: If the tag should be used as an image label
+        :param _builtins.bool instance_label: If the tag should be used as an instance label
+        :param _builtins.bool instance_metadata: If the tag should be used as instance metadata
+        :param _builtins.bool instance_network: If the tag value should be used as an instance network tag
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "value", value)
+        if image_label is not None:
+            pulumi.set(__self__, "image_label", image_label)
+        if instance_label is not None:
+            pulumi.set(__self__, "instance_label", instance_label)
+        if instance_metadata is not None:
+            pulumi.set(__self__, "instance_metadata", instance_metadata)
+        if instance_network is not None:
+            pulumi.set(__self__, "instance_network", instance_network)
+
+    @_builtins.property
+    @pulumi.getter
+    def key(self) -> _builtins.str:
+        """
+        The tag key
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: _builtins.str):
+        pulumi.set(self, "key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def value(self) -> _builtins.str:
+        """
+        The tag value
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: _builtins.str):
+        pulumi.set(self, "value", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageLabel")
+    def image_label(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as an image label
+        """
+        return pulumi.get(self, "image_label")
+
+    @image_label.setter
+    def image_label(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "image_label", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceLabel")
+    def instance_label(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as an instance label
+        """
+        return pulumi.get(self, "instance_label")
+
+    @instance_label.setter
+    def instance_label(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "instance_label", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceMetadata")
+    def instance_metadata(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as instance metadata
+        """
+        return pulumi.get(self, "instance_metadata")
+
+    @instance_metadata.setter
+    def instance_metadata(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "instance_metadata", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceNetwork")
+    def instance_network(self) -> Optional[_builtins.bool]:
+        """
+        If the tag value should be used as an instance network tag
+        """
+        return pulumi.get(self, "instance_network")
+
+    @instance_network.setter
+    def instance_network(self, value: Optional[_builtins.bool]):
+        pulumi.set(self, "instance_network", value)
+
+
+if not MYPY:
+    class OpsTagArgsDict(TypedDict):
+        """
+        A tag (label) for images and instances
+        """
+        key: pulumi.Input[_builtins.str]
+        """
+        The tag key
+        """
+        value: pulumi.Input[_builtins.str]
+        """
+        The tag value
+        """
+        image_label: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the tag should be used as an image label
+        """
+        instance_label: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the tag should be used as an instance label
+        """
+        instance_metadata: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the tag should be used as instance metadata
+        """
+        instance_network: NotRequired[pulumi.Input[_builtins.bool]]
+        """
+        If the tag value should be used as an instance network tag
+        """
+elif False:
+    OpsTagArgsDict: TypeAlias = Mapping[str, Any]
+
+@pulumi.input_type
+class OpsTagArgs:
+    def __init__(__self__, *,
+                 key: pulumi.Input[_builtins.str],
+                 value: pulumi.Input[_builtins.str],
+                 image_label: Optional[pulumi.Input[_builtins.bool]] = None,
+                 instance_label: Optional[pulumi.Input[_builtins.bool]] = None,
+                 instance_metadata: Optional[pulumi.Input[_builtins.bool]] = None,
+                 instance_network: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        A tag (label) for images and instances
+        :param pulumi.Input[_builtins.str] key: The tag key
+        :param pulumi.Input[_builtins.str] value: The tag value
+        :param pulumi.Input[_builtins.bool] image_label: If the tag should be used as an image label
+        :param pulumi.Input[_builtins.bool] instance_label: If the tag should be used as an instance label
+        :param pulumi.Input[_builtins.bool] instance_metadata: If the tag should be used as instance metadata
+        :param pulumi.Input[_builtins.bool] instance_network: If the tag value should be used as an instance network tag
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "value", value)
+        if image_label is not None:
+            pulumi.set(__self__, "image_label", image_label)
+        if instance_label is not None:
+            pulumi.set(__self__, "instance_label", instance_label)
+        if instance_metadata is not None:
+            pulumi.set(__self__, "instance_metadata", instance_metadata)
+        if instance_network is not None:
+            pulumi.set(__self__, "instance_network", instance_network)
+
+    @_builtins.property
+    @pulumi.getter
+    def key(self) -> pulumi.Input[_builtins.str]:
+        """
+        The tag key
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "key", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def value(self) -> pulumi.Input[_builtins.str]:
+        """
+        The tag value
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "value", value)
+
+    @_builtins.property
+    @pulumi.getter(name="imageLabel")
+    def image_label(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the tag should be used as an image label
+        """
+        return pulumi.get(self, "image_label")
+
+    @image_label.setter
+    def image_label(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "image_label", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceLabel")
+    def instance_label(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the tag should be used as an instance label
+        """
+        return pulumi.get(self, "instance_label")
+
+    @instance_label.setter
+    def instance_label(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "instance_label", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceMetadata")
+    def instance_metadata(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the tag should be used as instance metadata
+        """
+        return pulumi.get(self, "instance_metadata")
+
+    @instance_metadata.setter
+    def instance_metadata(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "instance_metadata", value)
+
+    @_builtins.property
+    @pulumi.getter(name="instanceNetwork")
+    def instance_network(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the tag value should be used as an instance network tag
+        """
+        return pulumi.get(self, "instance_network")
+
+    @instance_network.setter
+    def instance_network(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "instance_network", value)
+
+
@@ -0,0 +1,52 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from .. import outputs as _root_outputs
+
+architecture: Optional[str]
+"""
+The default target architecture of package images (amd64 or arm64)
+"""
+
+cloudConfig: Optional[str]
+"""
+The default cloud provider settings, merged under the configuration of each resource
+"""
+
+defaultProvider: Optional[str]
+"""
+The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+"""
+
+kernelVersion: Optional[str]
+"""
+The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+"""
+
+mirrorDir: str
+"""
+A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+"""
+
+offline: bool
+"""
+If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+"""
+
+opsHome: Optional[str]
+"""
+The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+"""
+
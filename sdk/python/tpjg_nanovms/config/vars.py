@@ -0,0 +1,72 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from .. import outputs as _root_outputs
+
+import types
+
+__config__ = pulumi.Config('nanovms')
+
+
+class _ExportableConfig(types.ModuleType):
+    @_builtins.property
+    def architecture(self) -> Optional[str]:
+        """
+        The default target architecture of package images (amd64 or arm64)
+        """
+        return __config__.get('architecture')
+
+    @_builtins.property
+    def cloud_config(self) -> Optional[str]:
+        """
+        The default cloud provider settings, merged under the configuration of each resource
+        """
+        return __config__.get('cloudConfig')
+
+    @_builtins.property
+    def default_provider(self) -> Optional[str]:
+        """
+        The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        """
+        return __config__.get('defaultProvider')
+
+    @_builtins.property
+    def kernel_version(self) -> Optional[str]:
+        """
+        The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        """
+        return __config__.get('kernelVersion')
+
+    @_builtins.property
+    def mirror_dir(self) -> str:
+        """
+        A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        """
+        return __config__.get('mirrorDir') or (_utilities.get_env('NANOVMS_MIRROR_DIR') or '')
+
+    @_builtins.property
+    def offline(self) -> bool:
+        """
+        If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        """
+        return __config__.get_bool('offline') or (_utilities.get_env_bool('NANOVMS_OFFLINE') or False)
+
+    @_builtins.property
+    def ops_home(self) -> Optional[str]:
+        """
+        The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        """
+        return __config__.get('opsHome')
+
@@ -0,0 +1,180 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = [
+    'GetImageResult',
+    'AwaitableGetImageResult',
+    'get_image',
+    'get_image_output',
+]
+
+@pulumi.output_type
+class GetImageResult:
+    def __init__(__self__, created=None, image_id=None, labels=None, name=None, path=None, size=None, status=None):
+        if created and not isinstance(created, str):
+            raise TypeError("Expected argument 'created' to be a str")
+        pulumi.set(__self__, "created", created)
+        if image_id and not isinstance(image_id, str):
+            raise TypeError("Expected argument 'image_id' to be a str")
+        pulumi.set(__self__, "image_id", image_id)
+        if labels and not isinstance(labels, list):
+            raise TypeError("Expected argument 'labels' to be a list")
+        pulumi.set(__self__, "labels", labels)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if path and not isinstance(path, str):
+            raise TypeError("Expected argument 'path' to be a str")
+        pulumi.set(__self__, "path", path)
+        if size and not isinstance(size, int):
+            raise TypeError("Expected argument 'size' to be a int")
+        pulumi.set(__self__, "size", size)
+        if status and not isinstance(status, str):
+            raise TypeError("Expected argument 'status' to be a str")
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.str:
+        """
+        The creation time of the image (RFC 3339)
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter(name="imageID")
+    def image_id(self) -> _builtins.str:
+        """
+        The provider ID of the image
+        """
+        return pulumi.get(self, "image_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def labels(self) -> Sequence[_builtins.str]:
+        """
+        The labels of the image
+        """
+        return pulumi.get(self, "labels")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the image
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> _builtins.str:
+        """
+        The (local) path of the image
+        """
+        return pulumi.get(self, "path")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> _builtins.int:
+        """
+        The size of the image in bytes
+        """
+        return pulumi.get(self, "size")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        """
+        The status of the image
+        """
+        return pulumi.get(self, "status")
+
+
+class AwaitableGetImageResult(GetImageResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetImageResult(
+            created=self.created,
+            image_id=self.image_id,
+            labels=self.labels,
+            name=self.name,
+            path=self.path,
+            size=self.size,
+            status=self.status)
+
+
+def get_image(config: Optional[_builtins.str] = None,
+              name: Optional[_builtins.str] = None,
+              ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+              provider: Optional[_builtins.str] = None,
+              opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetImageResult:
+    """
+    Looks up an image of a cloud provider by name
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str name: The name of the image
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider of the image
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['name'] = name
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getImage', __args__, opts=opts, typ=GetImageResult).value
+
+    return AwaitableGetImageResult(
+        created=pulumi.get(__ret__, 'created'),
+        image_id=pulumi.get(__ret__, 'image_id'),
+        labels=pulumi.get(__ret__, 'labels'),
+        name=pulumi.get(__ret__, 'name'),
+        path=pulumi.get(__ret__, 'path'),
+        size=pulumi.get(__ret__, 'size'),
+        status=pulumi.get(__ret__, 'status'))
+def get_image_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                     name: Optional[pulumi.Input[_builtins.str]] = None,
+                     ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                     provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                     opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetImageResult]:
+    """
+    Looks up an image of a cloud provider by name
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str name: The name of the image
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider of the image
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['name'] = name
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getImage', __args__, opts=opts, typ=GetImageResult)
+    return __ret__.apply(lambda __response__: GetImageResult(
+        created=pulumi.get(__response__, 'created'),
+        image_id=pulumi.get(__response__, 'image_id'),
+        labels=pulumi.get(__response__, 'labels'),
+        name=pulumi.get(__response__, 'name'),
+        path=pulumi.get(__response__, 'path'),
+        size=pulumi.get(__response__, 'size'),
+        status=pulumi.get(__response__, 'status')))
@@ -0,0 +1,97 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetImagesResult',
+    'AwaitableGetImagesResult',
+    'get_images',
+    'get_images_output',
+]
+
+@pulumi.output_type
+class GetImagesResult:
+    def __init__(__self__, images=None):
+        if images and not isinstance(images, list):
+            raise TypeError("Expected argument 'images' to be a list")
+        pulumi.set(__self__, "images", images)
+
+    @_builtins.property
+    @pulumi.getter
+    def images(self) -> Sequence['outputs.ImageInfo']:
+        """
+        The images
+        """
+        return pulumi.get(self, "images")
+
+
+class AwaitableGetImagesResult(GetImagesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetImagesResult(
+            images=self.images)
+
+
+def get_images(config: Optional[_builtins.str] = None,
+               filter: Optional[_builtins.str] = None,
+               ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+               provider: Optional[_builtins.str] = None,
+               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetImagesResult:
+    """
+    Lists the images of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str filter: Only return images matching the filter, the format depends on the cloud provider
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the images of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['filter'] = filter
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getImages', __args__, opts=opts, typ=GetImagesResult).value
+
+    return AwaitableGetImagesResult(
+        images=pulumi.get(__ret__, 'images'))
+def get_images_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                      filter: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                      ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                      provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                      opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetImagesResult]:
+    """
+    Lists the images of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str filter: Only return images matching the filter, the format depends on the cloud provider
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the images of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['filter'] = filter
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getImages', __args__, opts=opts, typ=GetImagesResult)
+    return __ret__.apply(lambda __response__: GetImagesResult(
+        images=pulumi.get(__response__, 'images')))
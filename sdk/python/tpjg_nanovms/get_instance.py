@@ -0,0 +1,194 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = [
+    'GetInstanceResult',
+    'AwaitableGetInstanceResult',
+    'get_instance',
+    'get_instance_output',
+]
+
+@pulumi.output_type
+class GetInstanceResult:
+    def __init__(__self__, created=None, image=None, name=None, pid=None, ports=None, private_ips=None, public_ips=None, status=None):
+        if created and not isinstance(created, str):
+            raise TypeError("Expected argument 'created' to be a str")
+        pulumi.set(__self__, "created", created)
+        if image and not isinstance(image, str):
+            raise TypeError("Expected argument 'image' to be a str")
+        pulumi.set(__self__, "image", image)
+        if name and not isinstance(name, str):
+            raise TypeError("Expected argument 'name' to be a str")
+        pulumi.set(__self__, "name", name)
+        if pid and not isinstance(pid, str):
+            raise TypeError("Expected argument 'pid' to be a str")
+        pulumi.set(__self__, "pid", pid)
+        if ports and not isinstance(ports, list):
+            raise TypeError("Expected argument 'ports' to be a list")
+        pulumi.set(__self__, "ports", ports)
+        if private_ips and not isinstance(private_ips, list):
+            raise TypeError("Expected argument 'private_ips' to be a list")
+        pulumi.set(__self__, "private_ips", private_ips)
+        if public_ips and not isinstance(public_ips, list):
+            raise TypeError("Expected argument 'public_ips' to be a list")
+        pulumi.set(__self__, "public_ips", public_ips)
+        if status and not isinstance(status, str):
+            raise TypeError("Expected argument 'status' to be a str")
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.str:
+        """
+        The creation time of the instance as reported by the cloud provider
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def image(self) -> _builtins.str:
+        """
+        The image the instance runs
+        """
+        return pulumi.get(self, "image")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the instance
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def pid(self) -> _builtins.str:
+        """
+        The provider instance ID
+        """
+        return pulumi.get(self, "pid")
+
+    @_builtins.property
+    @pulumi.getter
+    def ports(self) -> Sequence[_builtins.str]:
+        """
+        The ports of the instance
+        """
+        return pulumi.get(self, "ports")
+
+    @_builtins.property
+    @pulumi.getter
+    def private_ips(self) -> Sequence[_builtins.str]:
+        """
+        The private IP addresses of the instance
+        """
+        return pulumi.get(self, "private_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def public_ips(self) -> Sequence[_builtins.str]:
+        """
+        The public IP addresses of the instance
+        """
+        return pulumi.get(self, "public_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        """
+        The status of the instance
+        """
+        return pulumi.get(self, "status")
+
+
+class AwaitableGetInstanceResult(GetInstanceResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInstanceResult(
+            created=self.created,
+            image=self.image,
+            name=self.name,
+            pid=self.pid,
+            ports=self.ports,
+            private_ips=self.private_ips,
+            public_ips=self.public_ips,
+            status=self.status)
+
+
+def get_instance(config: Optional[_builtins.str] = None,
+                 name: Optional[_builtins.str] = None,
+                 ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+                 provider: Optional[_builtins.str] = None,
+                 opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInstanceResult:
+    """
+    Looks up an instance of a cloud provider by name
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str name: The name of the instance
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider of the instance
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['name'] = name
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getInstance', __args__, opts=opts, typ=GetInstanceResult).value
+
+    return AwaitableGetInstanceResult(
+        created=pulumi.get(__ret__, 'created'),
+        image=pulumi.get(__ret__, 'image'),
+        name=pulumi.get(__ret__, 'name'),
+        pid=pulumi.get(__ret__, 'pid'),
+        ports=pulumi.get(__ret__, 'ports'),
+        private_ips=pulumi.get(__ret__, 'private_ips'),
+        public_ips=pulumi.get(__ret__, 'public_ips'),
+        status=pulumi.get(__ret__, 'status'))
+def get_instance_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                        name: Optional[pulumi.Input[_builtins.str]] = None,
+                        ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                        provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                        opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetInstanceResult]:
+    """
+    Looks up an instance of a cloud provider by name
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param _builtins.str name: The name of the instance
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider of the instance
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['name'] = name
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getInstance', __args__, opts=opts, typ=GetInstanceResult)
+    return __ret__.apply(lambda __response__: GetInstanceResult(
+        created=pulumi.get(__response__, 'created'),
+        image=pulumi.get(__response__, 'image'),
+        name=pulumi.get(__response__, 'name'),
+        pid=pulumi.get(__response__, 'pid'),
+        ports=pulumi.get(__response__, 'ports'),
+        private_ips=pulumi.get(__response__, 'private_ips'),
+        public_ips=pulumi.get(__response__, 'public_ips'),
+        status=pulumi.get(__response__, 'status')))
@@ -0,0 +1,102 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = [
+    'GetInstanceLogsResult',
+    'AwaitableGetInstanceLogsResult',
+    'get_instance_logs',
+    'get_instance_logs_output',
+]
+
+@pulumi.output_type
+class GetInstanceLogsResult:
+    def __init__(__self__, logs=None):
+        if logs and not isinstance(logs, str):
+            raise TypeError("Expected argument 'logs' to be a str")
+        pulumi.set(__self__, "logs", logs)
+
+    @_builtins.property
+    @pulumi.getter
+    def logs(self) -> _builtins.str:
+        """
+        The console output of the instance
+        """
+        return pulumi.get(self, "logs")
+
+
+class AwaitableGetInstanceLogsResult(GetInstanceLogsResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInstanceLogsResult(
+            logs=self.logs)
+
+
+def get_instance_logs(config: Optional[_builtins.str] = None,
+                      instance_id: Optional[_builtins.str] = None,
+                      lines: Optional[_builtins.int] = None,
+                      ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+                      provider: Optional[_builtins.str] = None,
+                      opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInstanceLogsResult:
+    """
+    Retrieves the console output of a NanoVMs instance
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, e.g. the config output of the instance
+    :param _builtins.str instance_id: The ID (name) of the instance
+    :param _builtins.int lines: Only return the last number of lines, all output is returned if not set
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as zone
+    :param _builtins.str provider: The provider (type) of the instance
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['instanceID'] = instance_id
+    __args__['lines'] = lines
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getInstanceLogs', __args__, opts=opts, typ=GetInstanceLogsResult).value
+
+    return AwaitableGetInstanceLogsResult(
+        logs=pulumi.get(__ret__, 'logs'))
+def get_instance_logs_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                             instance_id: Optional[pulumi.Input[_builtins.str]] = None,
+                             lines: Optional[pulumi.Input[Optional[_builtins.int]]] = None,
+                             ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                             provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                             opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetInstanceLogsResult]:
+    """
+    Retrieves the console output of a NanoVMs instance
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, e.g. the config output of the instance
+    :param _builtins.str instance_id: The ID (name) of the instance
+    :param _builtins.int lines: Only return the last number of lines, all output is returned if not set
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as zone
+    :param _builtins.str provider: The provider (type) of the instance
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['instanceID'] = instance_id
+    __args__['lines'] = lines
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getInstanceLogs', __args__, opts=opts, typ=GetInstanceLogsResult)
+    return __ret__.apply(lambda __response__: GetInstanceLogsResult(
+        logs=pulumi.get(__response__, 'logs')))
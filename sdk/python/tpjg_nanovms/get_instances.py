@@ -0,0 +1,91 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetInstancesResult',
+    'AwaitableGetInstancesResult',
+    'get_instances',
+    'get_instances_output',
+]
+
+@pulumi.output_type
+class GetInstancesResult:
+    def __init__(__self__, instances=None):
+        if instances and not isinstance(instances, list):
+            raise TypeError("Expected argument 'instances' to be a list")
+        pulumi.set(__self__, "instances", instances)
+
+    @_builtins.property
+    @pulumi.getter
+    def instances(self) -> Sequence['outputs.InstanceInfo']:
+        """
+        The instances
+        """
+        return pulumi.get(self, "instances")
+
+
+class AwaitableGetInstancesResult(GetInstancesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetInstancesResult(
+            instances=self.instances)
+
+
+def get_instances(config: Optional[_builtins.str] = None,
+                  ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+                  provider: Optional[_builtins.str] = None,
+                  opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetInstancesResult:
+    """
+    Lists the instances of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the instances of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getInstances', __args__, opts=opts, typ=GetInstancesResult).value
+
+    return AwaitableGetInstancesResult(
+        instances=pulumi.get(__ret__, 'instances'))
+def get_instances_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                         ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                         provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                         opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetInstancesResult]:
+    """
+    Lists the instances of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the instances of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getInstances', __args__, opts=opts, typ=GetInstancesResult)
+    return __ret__.apply(lambda __response__: GetInstancesResult(
+        instances=pulumi.get(__response__, 'instances')))
@@ -0,0 +1,91 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+from ._inputs import *
+
+__all__ = [
+    'GetVolumesResult',
+    'AwaitableGetVolumesResult',
+    'get_volumes',
+    'get_volumes_output',
+]
+
+@pulumi.output_type
+class GetVolumesResult:
+    def __init__(__self__, volumes=None):
+        if volumes and not isinstance(volumes, list):
+            raise TypeError("Expected argument 'volumes' to be a list")
+        pulumi.set(__self__, "volumes", volumes)
+
+    @_builtins.property
+    @pulumi.getter
+    def volumes(self) -> Sequence['outputs.VolumeInfo']:
+        """
+        The volumes
+        """
+        return pulumi.get(self, "volumes")
+
+
+class AwaitableGetVolumesResult(GetVolumesResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetVolumesResult(
+            volumes=self.volumes)
+
+
+def get_volumes(config: Optional[_builtins.str] = None,
+                ops_config: Optional[Union['OpsConfig', 'OpsConfigDict']] = None,
+                provider: Optional[_builtins.str] = None,
+                opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetVolumesResult:
+    """
+    Lists the volumes of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the volumes of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('nanovms:index:getVolumes', __args__, opts=opts, typ=GetVolumesResult).value
+
+    return AwaitableGetVolumesResult(
+        volumes=pulumi.get(__ret__, 'volumes'))
+def get_volumes_output(config: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                       ops_config: Optional[pulumi.Input[Optional[Union['OpsConfig', 'OpsConfigDict']]]] = None,
+                       provider: Optional[pulumi.Input[Optional[_builtins.str]]] = None,
+                       opts: Optional[Union[pulumi.InvokeOptions, pulumi.InvokeOutputOptions]] = None) -> pulumi.Output[GetVolumesResult]:
+    """
+    Lists the volumes of a cloud provider
+
+
+    :param _builtins.str config: The configuration as a JSON encoded string, merged on top of opsConfig
+    :param Union['OpsConfig', 'OpsConfigDict'] ops_config: The configuration, used for the cloud provider settings such as project and zone
+    :param _builtins.str provider: The cloud provider to list the volumes of
+    """
+    __args__ = dict()
+    __args__['config'] = config
+    __args__['opsConfig'] = ops_config
+    __args__['provider'] = provider
+    opts = pulumi.InvokeOutputOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke_output('nanovms:index:getVolumes', __args__, opts=opts, typ=GetVolumesResult)
+    return __ret__.apply(lambda __response__: GetVolumesResult(
+        volumes=pulumi.get(__response__, 'volumes')))
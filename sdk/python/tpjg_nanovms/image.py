@@ -0,0 +1,428 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['ImageArgs', 'Image']
+
+@pulumi.input_type
+class ImageArgs:
+    def __init__(__self__, *,
+                 elf: pulumi.Input[_builtins.str],
+                 name: pulumi.Input[_builtins.str],
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 include_ldd: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input['OpsConfigArgs']] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysroot: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a Image resource.
+        :param pulumi.Input[_builtins.str] elf: The path to the executable file
+        :param pulumi.Input[_builtins.str] name: The name of the image
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] args: The arguments the program is started with, appended to the args of the configuration
+        :param pulumi.Input[_builtins.str] config: The configuration as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.bool] force: If an already existing image should be deleted if it exists
+        :param pulumi.Input[_builtins.bool] include_ldd: If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+        :param pulumi.Input[_builtins.str] kernel_version: The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        :param pulumi.Input['OpsConfigArgs'] ops_config: The configuration of the image
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.str] sysroot: The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+        :param pulumi.Input[_builtins.bool] use_latest_kernel: If the latest kernel should be used, download it if necessary
+        """
+        pulumi.set(__self__, "elf", elf)
+        pulumi.set(__self__, "name", name)
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if config is not None:
+            warnings.warn("""use opsConfig instead""", DeprecationWarning)
+            pulumi.log.warn("""config is deprecated: use opsConfig instead""")
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if force is not None:
+            pulumi.set(__self__, "force", force)
+        if include_ldd is not None:
+            pulumi.set(__self__, "include_ldd", include_ldd)
+        if kernel_version is not None:
+            pulumi.set(__self__, "kernel_version", kernel_version)
+        if ops_config is not None:
+            pulumi.set(__self__, "ops_config", ops_config)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if sysroot is not None:
+            pulumi.set(__self__, "sysroot", sysroot)
+        if use_latest_kernel is not None:
+            pulumi.set(__self__, "use_latest_kernel", use_latest_kernel)
+
+    @_builtins.property
+    @pulumi.getter
+    def elf(self) -> pulumi.Input[_builtins.str]:
+        """
+        The path to the executable file
+        """
+        return pulumi.get(self, "elf")
+
+    @elf.setter
+    def elf(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "elf", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name of the image
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The arguments the program is started with, appended to the args of the configuration
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "args", value)
+
+    @_builtins.property
+    @pulumi.getter
+    @_utilities.deprecated("""use opsConfig instead""")
+    def config(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The configuration as a JSON encoded string, merged on top of opsConfig
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def force(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If an already existing image should be deleted if it exists
+        """
+        return pulumi.get(self, "force")
+
+    @force.setter
+    def force(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "force", value)
+
+    @_builtins.property
+    @pulumi.getter(name="includeLdd")
+    def include_ldd(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+        """
+        return pulumi.get(self, "include_ldd")
+
+    @include_ldd.setter
+    def include_ldd(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "include_ldd", value)
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @kernel_version.setter
+    def kernel_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kernel_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsConfig")
+    def ops_config(self) -> Optional[pulumi.Input['OpsConfigArgs']]:
+        """
+        The configuration of the image
+        """
+        return pulumi.get(self, "ops_config")
+
+    @ops_config.setter
+    def ops_config(self, value: Optional[pulumi.Input['OpsConfigArgs']]):
+        pulumi.set(self, "ops_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "provider", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def sysroot(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+        """
+        return pulumi.get(self, "sysroot")
+
+    @sysroot.setter
+    def sysroot(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "sysroot", value)
+
+    @_builtins.property
+    @pulumi.getter(name="useLatestKernel")
+    def use_latest_kernel(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the latest kernel should be used, download it if necessary
+        """
+        return pulumi.get(self, "use_latest_kernel")
+
+    @use_latest_kernel.setter
+    def use_latest_kernel(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "use_latest_kernel", value)
+
+
+@pulumi.type_token("nanovms:index:Image")
+class Image(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 elf: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 include_ldd: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysroot: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None,
+                 __props__=None):
+        """
+        A NanoVMs image resource for building unikernel images
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] args: The arguments the program is started with, appended to the args of the configuration
+        :param pulumi.Input[_builtins.str] config: The configuration as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.str] elf: The path to the executable file
+        :param pulumi.Input[_builtins.bool] force: If an already existing image should be deleted if it exists
+        :param pulumi.Input[_builtins.bool] include_ldd: If the dynamic loader and shared libraries needed by a dynamically linked elf should be added to the image
+        :param pulumi.Input[_builtins.str] kernel_version: The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        :param pulumi.Input[_builtins.str] name: The name of the image
+        :param pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']] ops_config: The configuration of the image
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.str] sysroot: The root directory shared libraries are resolved in when includeLdd is set, used as the targetRoot of the image. Defaults to the targetRoot of the configuration or /
+        :param pulumi.Input[_builtins.bool] use_latest_kernel: If the latest kernel should be used, download it if necessary
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: ImageArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A NanoVMs image resource for building unikernel images
+
+        :param str resource_name: The name of the resource.
+        :param ImageArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ImageArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 elf: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 include_ldd: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysroot: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ImageArgs.__new__(ImageArgs)
+
+            __props__.__dict__["args"] = args
+            __props__.__dict__["config"] = config
+            if elf is None and not opts.urn:
+                raise TypeError("Missing required property 'elf'")
+            __props__.__dict__["elf"] = elf
+            __props__.__dict__["force"] = force
+            __props__.__dict__["include_ldd"] = include_ldd
+            __props__.__dict__["kernel_version"] = kernel_version
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["ops_config"] = ops_config
+            __props__.__dict__["ops_home"] = ops_home
+            __props__.__dict__["provider"] = provider
+            __props__.__dict__["sysroot"] = sysroot
+            __props__.__dict__["use_latest_kernel"] = use_latest_kernel
+            __props__.__dict__["content_hash"] = None
+            __props__.__dict__["image_name"] = None
+            __props__.__dict__["image_path"] = None
+            __props__.__dict__["shared_libraries"] = None
+        super(Image, __self__).__init__(
+            'nanovms:index:Image',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Image':
+        """
+        Get an existing Image resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = ImageArgs.__new__(ImageArgs)
+
+        __props__.__dict__["args"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["content_hash"] = None
+        __props__.__dict__["image_name"] = None
+        __props__.__dict__["image_path"] = None
+        __props__.__dict__["kernel_version"] = None
+        __props__.__dict__["provider"] = None
+        __props__.__dict__["shared_libraries"] = None
+        __props__.__dict__["use_latest_kernel"] = None
+        return Image(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The command line of the program in the image, the program name followed by its arguments
+        """
+        return pulumi.get(self, "args")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[_builtins.str]:
+        """
+        The configuration of the built image as a JSON encoded string
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter(name="contentHash")
+    def content_hash(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The digest of the elf and all files and directories included into the image
+        """
+        return pulumi.get(self, "content_hash")
+
+    @_builtins.property
+    @pulumi.getter(name="imageName")
+    def image_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the built image
+        """
+        return pulumi.get(self, "image_name")
+
+    @_builtins.property
+    @pulumi.getter(name="imagePath")
+    def image_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path to the built image
+        """
+        return pulumi.get(self, "image_path")
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The nanos kernel version the image is built with
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[_builtins.str]:
+        """
+        The cloud provider of the built image
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter(name="sharedLibraries")
+    def shared_libraries(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The paths of the dynamic loader and shared libraries added to the image because of includeLdd
+        """
+        return pulumi.get(self, "shared_libraries")
+
+    @_builtins.property
+    @pulumi.getter(name="useLatestKernel")
+    def use_latest_kernel(self) -> pulumi.Output[_builtins.bool]:
+        """
+        If the latest kernel should be used, download it if necessary
+        """
+        return pulumi.get(self, "use_latest_kernel")
+
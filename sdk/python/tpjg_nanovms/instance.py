@@ -0,0 +1,376 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['InstanceArgs', 'Instance']
+
+@pulumi.input_type
+class InstanceArgs:
+    def __init__(__self__, *,
+                 capture_logs_on_failure: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 image: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input['OpsConfigArgs']] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 readiness: Optional[pulumi.Input['InstanceReadinessArgs']] = None):
+        """
+        The set of arguments for constructing a Instance resource.
+        :param pulumi.Input[_builtins.int] capture_logs_on_failure: The number of console log lines to include in the error when the instance does not become ready
+        :param pulumi.Input[_builtins.str] config: The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.str] image: The name of the image to deploy
+        :param pulumi.Input[_builtins.str] name: The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+        :param pulumi.Input[_builtins.str] name_prefix: The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+        :param pulumi.Input['OpsConfigArgs'] ops_config: The configuration for the instance
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The provider for the instance
+        :param pulumi.Input['InstanceReadinessArgs'] readiness: Wait for the instance to become ready before completing, so its status and IP addresses are known
+        """
+        if capture_logs_on_failure is not None:
+            pulumi.set(__self__, "capture_logs_on_failure", capture_logs_on_failure)
+        if config is not None:
+            warnings.warn("""use opsConfig instead""", DeprecationWarning)
+            pulumi.log.warn("""config is deprecated: use opsConfig instead""")
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if image is not None:
+            pulumi.set(__self__, "image", image)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if name_prefix is not None:
+            pulumi.set(__self__, "name_prefix", name_prefix)
+        if ops_config is not None:
+            pulumi.set(__self__, "ops_config", ops_config)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if readiness is not None:
+            pulumi.set(__self__, "readiness", readiness)
+
+    @_builtins.property
+    @pulumi.getter(name="captureLogsOnFailure")
+    def capture_logs_on_failure(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The number of console log lines to include in the error when the instance does not become ready
+        """
+        return pulumi.get(self, "capture_logs_on_failure")
+
+    @capture_logs_on_failure.setter
+    def capture_logs_on_failure(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "capture_logs_on_failure", value)
+
+    @_builtins.property
+    @pulumi.getter
+    @_utilities.deprecated("""use opsConfig instead""")
+    def config(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def image(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the image to deploy
+        """
+        return pulumi.get(self, "image")
+
+    @image.setter
+    def image(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "image", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="namePrefix")
+    def name_prefix(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+        """
+        return pulumi.get(self, "name_prefix")
+
+    @name_prefix.setter
+    def name_prefix(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "name_prefix", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsConfig")
+    def ops_config(self) -> Optional[pulumi.Input['OpsConfigArgs']]:
+        """
+        The configuration for the instance
+        """
+        return pulumi.get(self, "ops_config")
+
+    @ops_config.setter
+    def ops_config(self, value: Optional[pulumi.Input['OpsConfigArgs']]):
+        pulumi.set(self, "ops_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The provider for the instance
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "provider", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def readiness(self) -> Optional[pulumi.Input['InstanceReadinessArgs']]:
+        """
+        Wait for the instance to become ready before completing, so its status and IP addresses are known
+        """
+        return pulumi.get(self, "readiness")
+
+    @readiness.setter
+    def readiness(self, value: Optional[pulumi.Input['InstanceReadinessArgs']]):
+        pulumi.set(self, "readiness", value)
+
+
+@pulumi.type_token("nanovms:index:Instance")
+class Instance(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 capture_logs_on_failure: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 image: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 readiness: Optional[pulumi.Input[Union['InstanceReadinessArgs', 'InstanceReadinessArgsDict']]] = None,
+                 __props__=None):
+        """
+        A NanoVMs resource for deploying unikernel images
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.int] capture_logs_on_failure: The number of console log lines to include in the error when the instance does not become ready
+        :param pulumi.Input[_builtins.str] config: The configuration for the instance as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.str] image: The name of the image to deploy
+        :param pulumi.Input[_builtins.str] name: The name of the instance. Defaults to the instanceName of the configuration or a name generated from namePrefix, changing it replaces the instance
+        :param pulumi.Input[_builtins.str] name_prefix: The prefix of the generated instance name, followed by a random suffix. Defaults to the resource name
+        :param pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']] ops_config: The configuration for the instance
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The provider for the instance
+        :param pulumi.Input[Union['InstanceReadinessArgs', 'InstanceReadinessArgsDict']] readiness: Wait for the instance to become ready before completing, so its status and IP addresses are known
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[InstanceArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A NanoVMs resource for deploying unikernel images
+
+        :param str resource_name: The name of the resource.
+        :param InstanceArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(InstanceArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 capture_logs_on_failure: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 image: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 name_prefix: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 readiness: Optional[pulumi.Input[Union['InstanceReadinessArgs', 'InstanceReadinessArgsDict']]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = InstanceArgs.__new__(InstanceArgs)
+
+            __props__.__dict__["capture_logs_on_failure"] = capture_logs_on_failure
+            __props__.__dict__["config"] = config
+            __props__.__dict__["image"] = image
+            __props__.__dict__["name"] = name
+            __props__.__dict__["name_prefix"] = name_prefix
+            __props__.__dict__["ops_config"] = ops_config
+            __props__.__dict__["ops_home"] = ops_home
+            __props__.__dict__["provider"] = provider
+            __props__.__dict__["readiness"] = readiness
+            __props__.__dict__["instance_id"] = None
+            __props__.__dict__["pid"] = None
+            __props__.__dict__["private_ips"] = None
+            __props__.__dict__["public_ips"] = None
+            __props__.__dict__["status"] = None
+        super(Instance, __self__).__init__(
+            'nanovms:index:Instance',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Instance':
+        """
+        Get an existing Instance resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = InstanceArgs.__new__(InstanceArgs)
+
+        __props__.__dict__["config"] = None
+        __props__.__dict__["image"] = None
+        __props__.__dict__["instance_id"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["name_prefix"] = None
+        __props__.__dict__["pid"] = None
+        __props__.__dict__["private_ips"] = None
+        __props__.__dict__["provider"] = None
+        __props__.__dict__["public_ips"] = None
+        __props__.__dict__["status"] = None
+        return Instance(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[_builtins.str]:
+        """
+        The configuration for the instance
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter
+    def image(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the image deployed
+        """
+        return pulumi.get(self, "image")
+
+    @_builtins.property
+    @pulumi.getter(name="instanceID")
+    def instance_id(self) -> pulumi.Output[_builtins.str]:
+        """
+        The unique identifier for the instance
+        """
+        return pulumi.get(self, "instance_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The name the instance was given, through the name input or the configuration
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter(name="namePrefix")
+    def name_prefix(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The prefix the name of the instance was generated from
+        """
+        return pulumi.get(self, "name_prefix")
+
+    @_builtins.property
+    @pulumi.getter
+    def pid(self) -> pulumi.Output[_builtins.str]:
+        """
+        The provider instance ID
+        """
+        return pulumi.get(self, "pid")
+
+    @_builtins.property
+    @pulumi.getter
+    def private_ips(self) -> pulumi.Output[Sequence[_builtins.str]]:
+        """
+        The private IP addresses of the instance
+        """
+        return pulumi.get(self, "private_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[_builtins.str]:
+        """
+        The provider (type) for the instance
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter
+    def public_ips(self) -> pulumi.Output[Sequence[_builtins.str]]:
+        """
+        The public IP addresses of the instance
+        """
+        return pulumi.get(self, "public_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[_builtins.str]:
+        """
+        The status of the instance
+        """
+        return pulumi.get(self, "status")
+
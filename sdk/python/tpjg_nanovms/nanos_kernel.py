@@ -0,0 +1,254 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['NanosKernelArgs', 'NanosKernel']
+
+@pulumi.input_type
+class NanosKernelArgs:
+    def __init__(__self__, *,
+                 version: pulumi.Input[_builtins.str],
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 expected_kernel_sha256: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a NanosKernel resource.
+        :param pulumi.Input[_builtins.str] version: The nanos release version (e.g. '0.1.54')
+        :param pulumi.Input[_builtins.str] architecture: The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        :param pulumi.Input[_builtins.str] expected_kernel_sha256: The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        pulumi.set(__self__, "version", version)
+        if architecture is not None:
+            pulumi.set(__self__, "architecture", architecture)
+        if expected_kernel_sha256 is not None:
+            pulumi.set(__self__, "expected_kernel_sha256", expected_kernel_sha256)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> pulumi.Input[_builtins.str]:
+        """
+        The nanos release version (e.g. '0.1.54')
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "version", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        """
+        return pulumi.get(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "architecture", value)
+
+    @_builtins.property
+    @pulumi.getter(name="expectedKernelSha256")
+    def expected_kernel_sha256(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+        """
+        return pulumi.get(self, "expected_kernel_sha256")
+
+    @expected_kernel_sha256.setter
+    def expected_kernel_sha256(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "expected_kernel_sha256", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+
+@pulumi.type_token("nanovms:index:NanosKernel")
+class NanosKernel(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 expected_kernel_sha256: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 version: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] architecture: The architecture of the release (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        :param pulumi.Input[_builtins.str] expected_kernel_sha256: The SHA-256 digest kernel.img must have, e.g. of a release checked before, the release is rejected if it doesn't match. nanos releases have no published checksum to check against
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] version: The nanos release version (e.g. '0.1.54')
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: NanosKernelArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A nanos kernel release downloaded into the ops home, to pin the kernel version images are built with
+
+        :param str resource_name: The name of the resource.
+        :param NanosKernelArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(NanosKernelArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 expected_kernel_sha256: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 version: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = NanosKernelArgs.__new__(NanosKernelArgs)
+
+            __props__.__dict__["architecture"] = architecture
+            __props__.__dict__["expected_kernel_sha256"] = expected_kernel_sha256
+            __props__.__dict__["ops_home"] = ops_home
+            if version is None and not opts.urn:
+                raise TypeError("Missing required property 'version'")
+            __props__.__dict__["version"] = version
+            __props__.__dict__["boot_path"] = None
+            __props__.__dict__["boot_sha256"] = None
+            __props__.__dict__["kernel_path"] = None
+            __props__.__dict__["kernel_sha256"] = None
+            __props__.__dict__["klibs_dir"] = None
+        super(NanosKernel, __self__).__init__(
+            'nanovms:index:NanosKernel',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'NanosKernel':
+        """
+        Get an existing NanosKernel resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = NanosKernelArgs.__new__(NanosKernelArgs)
+
+        __props__.__dict__["architecture"] = None
+        __props__.__dict__["boot_path"] = None
+        __props__.__dict__["boot_sha256"] = None
+        __props__.__dict__["kernel_path"] = None
+        __props__.__dict__["kernel_sha256"] = None
+        __props__.__dict__["klibs_dir"] = None
+        __props__.__dict__["ops_home"] = None
+        __props__.__dict__["version"] = None
+        return NanosKernel(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> pulumi.Output[_builtins.str]:
+        """
+        The architecture of the release
+        """
+        return pulumi.get(self, "architecture")
+
+    @_builtins.property
+    @pulumi.getter(name="bootPath")
+    def boot_path(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The path of boot.img, not part of arm64 releases
+        """
+        return pulumi.get(self, "boot_path")
+
+    @_builtins.property
+    @pulumi.getter(name="bootSha256")
+    def boot_sha256(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The SHA-256 digest of boot.img
+        """
+        return pulumi.get(self, "boot_sha256")
+
+    @_builtins.property
+    @pulumi.getter(name="kernelPath")
+    def kernel_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path of kernel.img
+        """
+        return pulumi.get(self, "kernel_path")
+
+    @_builtins.property
+    @pulumi.getter(name="kernelSha256")
+    def kernel_sha256(self) -> pulumi.Output[_builtins.str]:
+        """
+        The SHA-256 digest of kernel.img
+        """
+        return pulumi.get(self, "kernel_sha256")
+
+    @_builtins.property
+    @pulumi.getter(name="klibsDir")
+    def klibs_dir(self) -> pulumi.Output[_builtins.str]:
+        """
+        The directory containing the klibs of the release
+        """
+        return pulumi.get(self, "klibs_dir")
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) the release is downloaded into
+        """
+        return pulumi.get(self, "ops_home")
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[_builtins.str]:
+        """
+        The nanos release version
+        """
+        return pulumi.get(self, "version")
+
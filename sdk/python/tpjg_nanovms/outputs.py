@@ -0,0 +1,716 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from . import outputs
+
+__all__ = [
+    'ImageInfo',
+    'InstanceInfo',
+    'OpsCloudConfig',
+    'OpsCloudVolume',
+    'OpsTag',
+    'VolumeInfo',
+]
+
+@pulumi.output_type
+class ImageInfo(dict):
+    def __init__(__self__, *,
+                 created: _builtins.str,
+                 image_id: _builtins.str,
+                 labels: Sequence[_builtins.str],
+                 name: _builtins.str,
+                 path: _builtins.str,
+                 size: _builtins.int,
+                 status: _builtins.str):
+        """
+        :param _builtins.str created: The creation time of the image (RFC 3339)
+        :param _builtins.str image_id: The provider ID of the image
+        :param Sequence[_builtins.str] labels: The labels of the image
+        :param _builtins.str name: The name of the image
+        :param _builtins.str path: The (local) path of the image
+        :param _builtins.int size: The size of the image in bytes
+        :param _builtins.str status: The status of the image
+        """
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "image_id", image_id)
+        pulumi.set(__self__, "labels", labels)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "path", path)
+        pulumi.set(__self__, "size", size)
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.str:
+        """
+        The creation time of the image (RFC 3339)
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter(name="imageID")
+    def image_id(self) -> _builtins.str:
+        """
+        The provider ID of the image
+        """
+        return pulumi.get(self, "image_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def labels(self) -> Sequence[_builtins.str]:
+        """
+        The labels of the image
+        """
+        return pulumi.get(self, "labels")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the image
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> _builtins.str:
+        """
+        The (local) path of the image
+        """
+        return pulumi.get(self, "path")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> _builtins.int:
+        """
+        The size of the image in bytes
+        """
+        return pulumi.get(self, "size")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        """
+        The status of the image
+        """
+        return pulumi.get(self, "status")
+
+
+@pulumi.output_type
+class InstanceInfo(dict):
+    def __init__(__self__, *,
+                 created: _builtins.str,
+                 image: _builtins.str,
+                 name: _builtins.str,
+                 pid: _builtins.str,
+                 ports: Sequence[_builtins.str],
+                 private_ips: Sequence[_builtins.str],
+                 public_ips: Sequence[_builtins.str],
+                 status: _builtins.str):
+        """
+        :param _builtins.str created: The creation time of the instance as reported by the cloud provider
+        :param _builtins.str image: The image the instance runs
+        :param _builtins.str name: The name of the instance
+        :param _builtins.str pid: The provider instance ID
+        :param Sequence[_builtins.str] ports: The ports of the instance
+        :param Sequence[_builtins.str] private_ips: The private IP addresses of the instance
+        :param Sequence[_builtins.str] public_ips: The public IP addresses of the instance
+        :param _builtins.str status: The status of the instance
+        """
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "image", image)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "pid", pid)
+        pulumi.set(__self__, "ports", ports)
+        pulumi.set(__self__, "private_ips", private_ips)
+        pulumi.set(__self__, "public_ips", public_ips)
+        pulumi.set(__self__, "status", status)
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.str:
+        """
+        The creation time of the instance as reported by the cloud provider
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def image(self) -> _builtins.str:
+        """
+        The image the instance runs
+        """
+        return pulumi.get(self, "image")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the instance
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def pid(self) -> _builtins.str:
+        """
+        The provider instance ID
+        """
+        return pulumi.get(self, "pid")
+
+    @_builtins.property
+    @pulumi.getter
+    def ports(self) -> Sequence[_builtins.str]:
+        """
+        The ports of the instance
+        """
+        return pulumi.get(self, "ports")
+
+    @_builtins.property
+    @pulumi.getter
+    def private_ips(self) -> Sequence[_builtins.str]:
+        """
+        The private IP addresses of the instance
+        """
+        return pulumi.get(self, "private_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def public_ips(self) -> Sequence[_builtins.str]:
+        """
+        The public IP addresses of the instance
+        """
+        return pulumi.get(self, "public_ips")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        """
+        The status of the instance
+        """
+        return pulumi.get(self, "status")
+
+
+@pulumi.output_type
+class OpsCloudConfig(dict):
+    """
+    The cloud provider specific configuration
+    """
+    def __init__(__self__, *,
+                 bucket_name: Optional[_builtins.str] = None,
+                 bucket_namespace: Optional[_builtins.str] = None,
+                 confidential_vm: Optional[_builtins.bool] = None,
+                 dedicated_host_id: Optional[_builtins.str] = None,
+                 domain_name: Optional[_builtins.str] = None,
+                 enable_i_pv6: Optional[_builtins.bool] = None,
+                 flavor: Optional[_builtins.str] = None,
+                 image_type: Optional[_builtins.str] = None,
+                 instance_profile: Optional[_builtins.str] = None,
+                 kms: Optional[_builtins.str] = None,
+                 platform: Optional[_builtins.str] = None,
+                 project_id: Optional[_builtins.str] = None,
+                 root_volume: Optional['outputs.OpsCloudVolume'] = None,
+                 security_group: Optional[_builtins.str] = None,
+                 skip_import_verify: Optional[_builtins.bool] = None,
+                 spot: Optional[_builtins.bool] = None,
+                 static_ip: Optional[_builtins.str] = None,
+                 subnet: Optional[_builtins.str] = None,
+                 tags: Optional[Sequence['outputs.OpsTag']] = None,
+                 user_data: Optional[_builtins.str] = None,
+                 vpc: Optional[_builtins.str] = None,
+                 zone: Optional[_builtins.str] = None):
+        """
+        The cloud provider specific configuration
+        :param _builtins.str bucket_name: The bucket to store the image artifacts in
+        :param _builtins.str bucket_namespace: The bucket namespace, required for oci
+        :param _builtins.bool confidential_vm: If confidential computing should be enabled
+        :param _builtins.str dedicated_host_id: The ID of the dedicated host to run on
+        :param _builtins.str domain_name: The domain name to create a DNS record for
+        :param _builtins.bool enable_i_pv6: If IPv6 should be enabled when creating a VPC
+        :param _builtins.str flavor: The instance flavor or machine type
+        :param _builtins.str image_type: The image type
+        :param _builtins.str instance_profile: The IAM instance profile (aws)
+        :param _builtins.str kms: The KMS key to encrypt images with, 'default' or an arn (aws)
+        :param _builtins.str platform: The cloud platform
+        :param _builtins.str project_id: The project ID (gcp)
+        :param 'OpsCloudVolume' root_volume: Settings for the root volume
+        :param _builtins.str security_group: The security group
+        :param _builtins.bool skip_import_verify: Skip verifying that a vm importer role exists (aws)
+        :param _builtins.bool spot: If spot provisioning should be used
+        :param _builtins.str static_ip: The static public IP to assign
+        :param _builtins.str subnet: The subnet
+        :param Sequence['OpsTag'] tags: Tags (labels) for images and instances
+        :param _builtins.str user_data: User data passed to the instance
+        :param _builtins.str vpc: The VPC
+        :param _builtins.str zone: The zone or region
+        """
+        if bucket_name is not None:
+            pulumi.set(__self__, "bucket_name", bucket_name)
+        if bucket_namespace is not None:
+            pulumi.set(__self__, "bucket_namespace", bucket_namespace)
+        if confidential_vm is not None:
+            pulumi.set(__self__, "confidential_vm", confidential_vm)
+        if dedicated_host_id is not None:
+            pulumi.set(__self__, "dedicated_host_id", dedicated_host_id)
+        if domain_name is not None:
+            pulumi.set(__self__, "domain_name", domain_name)
+        if enable_i_pv6 is not None:
+            pulumi.set(__self__, "enable_i_pv6", enable_i_pv6)
+        if flavor is not None:
+            pulumi.set(__self__, "flavor", flavor)
+        if image_type is not None:
+            pulumi.set(__self__, "image_type", image_type)
+        if instance_profile is not None:
+            pulumi.set(__self__, "instance_profile", instance_profile)
+        if kms is not None:
+            pulumi.set(__self__, "kms", kms)
+        if platform is not None:
+            pulumi.set(__self__, "platform", platform)
+        if project_id is not None:
+            pulumi.set(__self__, "project_id", project_id)
+        if root_volume is not None:
+            pulumi.set(__self__, "root_volume", root_volume)
+        if security_group is not None:
+            pulumi.set(__self__, "security_group", security_group)
+        if skip_import_verify is not None:
+            pulumi.set(__self__, "skip_import_verify", skip_import_verify)
+        if spot is not None:
+            pulumi.set(__self__, "spot", spot)
+        if static_ip is not None:
+            pulumi.set(__self__, "static_ip", static_ip)
+        if subnet is not None:
+            pulumi.set(__self__, "subnet", subnet)
+        if tags is not None:
+            pulumi.set(__self__, "tags", tags)
+        if user_data is not None:
+            pulumi.set(__self__, "user_data", user_data)
+        if vpc is not None:
+            pulumi.set(__self__, "vpc", vpc)
+        if zone is not None:
+            pulumi.set(__self__, "zone", zone)
+
+    @_builtins.property
+    @pulumi.getter(name="bucketName")
+    def bucket_name(self) -> Optional[_builtins.str]:
+        """
+        The bucket to store the image artifacts in
+        """
+        return pulumi.get(self, "bucket_name")
+
+    @_builtins.property
+    @pulumi.getter(name="bucketNamespace")
+    def bucket_namespace(self) -> Optional[_builtins.str]:
+        """
+        The bucket namespace, required for oci
+        """
+        return pulumi.get(self, "bucket_namespace")
+
+    @_builtins.property
+    @pulumi.getter(name="confidentialVM")
+    def confidential_vm(self) -> Optional[_builtins.bool]:
+        """
+        If confidential computing should be enabled
+        """
+        return pulumi.get(self, "confidential_vm")
+
+    @_builtins.property
+    @pulumi.getter(name="dedicatedHostID")
+    def dedicated_host_id(self) -> Optional[_builtins.str]:
+        """
+        The ID of the dedicated host to run on
+        """
+        return pulumi.get(self, "dedicated_host_id")
+
+    @_builtins.property
+    @pulumi.getter(name="domainName")
+    def domain_name(self) -> Optional[_builtins.str]:
+        """
+        The domain name to create a DNS record for
+        """
+        return pulumi.get(self, "domain_name")
+
+    @_builtins.property
+    @pulumi.getter(name="enableIPv6")
+    def enable_i_pv6(self) -> Optional[_builtins.bool]:
+        """
+        If IPv6 should be enabled when creating a VPC
+        """
+        return pulumi.get(self, "enable_i_pv6")
+
+    @_builtins.property
+    @pulumi.getter
+    def flavor(self) -> Optional[_builtins.str]:
+        """
+        The instance flavor or machine type
+        """
+        return pulumi.get(self, "flavor")
+
+    @_builtins.property
+    @pulumi.getter(name="imageType")
+    def image_type(self) -> Optional[_builtins.str]:
+        """
+        The image type
+        """
+        return pulumi.get(self, "image_type")
+
+    @_builtins.property
+    @pulumi.getter(name="instanceProfile")
+    def instance_profile(self) -> Optional[_builtins.str]:
+        """
+        The IAM instance profile (aws)
+        """
+        return pulumi.get(self, "instance_profile")
+
+    @_builtins.property
+    @pulumi.getter
+    def kms(self) -> Optional[_builtins.str]:
+        """
+        The KMS key to encrypt images with, 'default' or an arn (aws)
+        """
+        return pulumi.get(self, "kms")
+
+    @_builtins.property
+    @pulumi.getter
+    def platform(self) -> Optional[_builtins.str]:
+        """
+        The cloud platform
+        """
+        return pulumi.get(self, "platform")
+
+    @_builtins.property
+    @pulumi.getter(name="projectID")
+    def project_id(self) -> Optional[_builtins.str]:
+        """
+        The project ID (gcp)
+        """
+        return pulumi.get(self, "project_id")
+
+    @_builtins.property
+    @pulumi.getter(name="rootVolume")
+    def root_volume(self) -> Optional['outputs.OpsCloudVolume']:
+        """
+        Settings for the root volume
+        """
+        return pulumi.get(self, "root_volume")
+
+    @_builtins.property
+    @pulumi.getter(name="securityGroup")
+    def security_group(self) -> Optional[_builtins.str]:
+        """
+        The security group
+        """
+        return pulumi.get(self, "security_group")
+
+    @_builtins.property
+    @pulumi.getter(name="skipImportVerify")
+    def skip_import_verify(self) -> Optional[_builtins.bool]:
+        """
+        Skip verifying that a vm importer role exists (aws)
+        """
+        return pulumi.get(self, "skip_import_verify")
+
+    @_builtins.property
+    @pulumi.getter
+    def spot(self) -> Optional[_builtins.bool]:
+        """
+        If spot provisioning should be used
+        """
+        return pulumi.get(self, "spot")
+
+    @_builtins.property
+    @pulumi.getter(name="staticIP")
+    def static_ip(self) -> Optional[_builtins.str]:
+        """
+        The static public IP to assign
+        """
+        return pulumi.get(self, "static_ip")
+
+    @_builtins.property
+    @pulumi.getter
+    def subnet(self) -> Optional[_builtins.str]:
+        """
+        The subnet
+        """
+        return pulumi.get(self, "subnet")
+
+    @_builtins.property
+    @pulumi.getter
+    def tags(self) -> Optional[Sequence['outputs.OpsTag']]:
+        """
+        Tags (labels) for images and instances
+        """
+        return pulumi.get(self, "tags")
+
+    @_builtins.property
+    @pulumi.getter(name="userData")
+    def user_data(self) -> Optional[_builtins.str]:
+        """
+        User data passed to the instance
+        """
+        return pulumi.get(self, "user_data")
+
+    @_builtins.property
+    @pulumi.getter
+    def vpc(self) -> Optional[_builtins.str]:
+        """
+        The VPC
+        """
+        return pulumi.get(self, "vpc")
+
+    @_builtins.property
+    @pulumi.getter
+    def zone(self) -> Optional[_builtins.str]:
+        """
+        The zone or region
+        """
+        return pulumi.get(self, "zone")
+
+
+@pulumi.output_type
+class OpsCloudVolume(dict):
+    """
+    Cloud volume settings
+    """
+    def __init__(__self__, *,
+                 iops: Optional[_builtins.int] = None,
+                 name: Optional[_builtins.str] = None,
+                 size: Optional[_builtins.int] = None,
+                 throughput: Optional[_builtins.int] = None,
+                 typeof: Optional[_builtins.str] = None):
+        """
+        Cloud volume settings
+        :param _builtins.int iops: The provisioned IOPS
+        :param _builtins.str name: The name of the volume
+        :param _builtins.int size: The size of the volume in GB
+        :param _builtins.int throughput: The provisioned throughput
+        :param _builtins.str typeof: The volume type
+        """
+        if iops is not None:
+            pulumi.set(__self__, "iops", iops)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if throughput is not None:
+            pulumi.set(__self__, "throughput", throughput)
+        if typeof is not None:
+            pulumi.set(__self__, "typeof", typeof)
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> Optional[_builtins.int]:
+        """
+        The provisioned IOPS
+        """
+        return pulumi.get(self, "iops")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> Optional[_builtins.str]:
+        """
+        The name of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> Optional[_builtins.int]:
+        """
+        The size of the volume in GB
+        """
+        return pulumi.get(self, "size")
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> Optional[_builtins.int]:
+        """
+        The provisioned throughput
+        """
+        return pulumi.get(self, "throughput")
+
+    @_builtins.property
+    @pulumi.getter
+    def typeof(self) -> Optional[_builtins.str]:
+        """
+        The volume type
+        """
+        return pulumi.get(self, "typeof")
+
+
+@pulumi.output_type
+class OpsTag(dict):
+    """
+    A tag (label) for images and instances
+    """
+    def __init__(__self__, *,
+                 key: _builtins.str,
+                 value: _builtins.str,
+                 image_label: Optional[_builtins.bool] = None,
+                 instance_label: Optional[_builtins.bool] = None,
+                 instance_metadata: Optional[_builtins.bool] = None,
+                 instance_network: Optional[_builtins.bool] = None):
+        """
+        A tag (label) for images and instances
+        :param _builtins.str key: The tag key
+        :param _builtins.str value: The tag value
+        :param _builtins.bool image_label: If the tag should be used as an image label
+        :param _builtins.bool instance_label: If the tag should be used as an instance label
+        :param _builtins.bool instance_metadata: If the tag should be used as instance metadata
+        :param _builtins.bool instance_network: If the tag value should be used as an instance network tag
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "value", value)
+        if image_label is not None:
+            pulumi.set(__self__, "image_label", image_label)
+        if instance_label is not None:
+            pulumi.set(__self__, "instance_label", instance_label)
+        if instance_metadata is not None:
+            pulumi.set(__self__, "instance_metadata", instance_metadata)
+        if instance_network is not None:
+            pulumi.set(__self__, "instance_network", instance_network)
+
+    @_builtins.property
+    @pulumi.getter
+    def key(self) -> _builtins.str:
+        """
+        The tag key
+        """
+        return pulumi.get(self, "key")
+
+    @_builtins.property
+    @pulumi.getter
+    def value(self) -> _builtins.str:
+        """
+        The tag value
+        """
+        return pulumi.get(self, "value")
+
+    @_builtins.property
+    @pulumi.getter(name="imageLabel")
+    def image_label(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as an image label
+        """
+        return pulumi.get(self, "image_label")
+
+    @_builtins.property
+    @pulumi.getter(name="instanceLabel")
+    def instance_label(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as an instance label
+        """
+        return pulumi.get(self, "instance_label")
+
+    @_builtins.property
+    @pulumi.getter(name="instanceMetadata")
+    def instance_metadata(self) -> Optional[_builtins.bool]:
+        """
+        If the tag should be used as instance metadata
+        """
+        return pulumi.get(self, "instance_metadata")
+
+    @_builtins.property
+    @pulumi.getter(name="instanceNetwork")
+    def instance_network(self) -> Optional[_builtins.bool]:
+        """
+        If the tag value should be used as an instance network tag
+        """
+        return pulumi.get(self, "instance_network")
+
+
+@pulumi.output_type
+class VolumeInfo(dict):
+    def __init__(__self__, *,
+                 attached_to: _builtins.str,
+                 created: _builtins.str,
+                 name: _builtins.str,
+                 path: _builtins.str,
+                 size: _builtins.str,
+                 status: _builtins.str,
+                 volume_id: _builtins.str):
+        """
+        :param _builtins.str attached_to: The instance the volume is attached to
+        :param _builtins.str created: The creation time of the volume as reported by the cloud provider
+        :param _builtins.str name: The name of the volume
+        :param _builtins.str path: The (local) path of the volume
+        :param _builtins.str size: The size of the volume as reported by the cloud provider
+        :param _builtins.str status: The status of the volume
+        :param _builtins.str volume_id: The provider ID of the volume
+        """
+        pulumi.set(__self__, "attached_to", attached_to)
+        pulumi.set(__self__, "created", created)
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "path", path)
+        pulumi.set(__self__, "size", size)
+        pulumi.set(__self__, "status", status)
+        pulumi.set(__self__, "volume_id", volume_id)
+
+    @_builtins.property
+    @pulumi.getter(name="attachedTo")
+    def attached_to(self) -> _builtins.str:
+        """
+        The instance the volume is attached to
+        """
+        return pulumi.get(self, "attached_to")
+
+    @_builtins.property
+    @pulumi.getter
+    def created(self) -> _builtins.str:
+        """
+        The creation time of the volume as reported by the cloud provider
+        """
+        return pulumi.get(self, "created")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> _builtins.str:
+        """
+        The name of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> _builtins.str:
+        """
+        The (local) path of the volume
+        """
+        return pulumi.get(self, "path")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> _builtins.str:
+        """
+        The size of the volume as reported by the cloud provider
+        """
+        return pulumi.get(self, "size")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> _builtins.str:
+        """
+        The status of the volume
+        """
+        return pulumi.get(self, "status")
+
+    @_builtins.property
+    @pulumi.getter(name="volumeID")
+    def volume_id(self) -> _builtins.str:
+        """
+        The provider ID of the volume
+        """
+        return pulumi.get(self, "volume_id")
+
+
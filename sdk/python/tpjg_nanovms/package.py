@@ -0,0 +1,430 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+
+__all__ = ['PackageArgs', 'Package']
+
+@pulumi.input_type
+class PackageArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[_builtins.str],
+                 program: pulumi.Input[_builtins.str],
+                 sysroot: pulumi.Input[_builtins.str],
+                 version: pulumi.Input[_builtins.str],
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 description: Optional[pulumi.Input[_builtins.str]] = None,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 language: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a Package resource.
+        :param pulumi.Input[_builtins.str] name: The name of the package, without version
+        :param pulumi.Input[_builtins.str] program: The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        :param pulumi.Input[_builtins.str] sysroot: The directory with the files of the package, copied as its sysroot
+        :param pulumi.Input[_builtins.str] version: The version of the package
+        :param pulumi.Input[_builtins.str] architecture: The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] args: The arguments to start the program with, after the program name
+        :param pulumi.Input[_builtins.str] description: A description of the package
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: The environment variables of the program
+        :param pulumi.Input[_builtins.str] language: The language of the program
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        pulumi.set(__self__, "name", name)
+        pulumi.set(__self__, "program", program)
+        pulumi.set(__self__, "sysroot", sysroot)
+        pulumi.set(__self__, "version", version)
+        if architecture is not None:
+            pulumi.set(__self__, "architecture", architecture)
+        if args is not None:
+            pulumi.set(__self__, "args", args)
+        if description is not None:
+            pulumi.set(__self__, "description", description)
+        if env is not None:
+            pulumi.set(__self__, "env", env)
+        if language is not None:
+            pulumi.set(__self__, "language", language)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name of the package, without version
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def program(self) -> pulumi.Input[_builtins.str]:
+        """
+        The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        """
+        return pulumi.get(self, "program")
+
+    @program.setter
+    def program(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "program", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def sysroot(self) -> pulumi.Input[_builtins.str]:
+        """
+        The directory with the files of the package, copied as its sysroot
+        """
+        return pulumi.get(self, "sysroot")
+
+    @sysroot.setter
+    def sysroot(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "sysroot", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> pulumi.Input[_builtins.str]:
+        """
+        The version of the package
+        """
+        return pulumi.get(self, "version")
+
+    @version.setter
+    def version(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "version", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        """
+        return pulumi.get(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "architecture", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]:
+        """
+        The arguments to start the program with, after the program name
+        """
+        return pulumi.get(self, "args")
+
+    @args.setter
+    def args(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "args", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def description(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        A description of the package
+        """
+        return pulumi.get(self, "description")
+
+    @description.setter
+    def description(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "description", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]:
+        """
+        The environment variables of the program
+        """
+        return pulumi.get(self, "env")
+
+    @env.setter
+    def env(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]]):
+        pulumi.set(self, "env", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def language(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The language of the program
+        """
+        return pulumi.get(self, "language")
+
+    @language.setter
+    def language(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "language", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+
+@pulumi.type_token("nanovms:index:Package")
+class Package(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 description: Optional[pulumi.Input[_builtins.str]] = None,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 language: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 program: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysroot: Optional[pulumi.Input[_builtins.str]] = None,
+                 version: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        A local ops package built from a sysroot directory, to be used by a PackageImage
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] architecture: The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        :param pulumi.Input[Sequence[pulumi.Input[_builtins.str]]] args: The arguments to start the program with, after the program name
+        :param pulumi.Input[_builtins.str] description: A description of the package
+        :param pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]] env: The environment variables of the program
+        :param pulumi.Input[_builtins.str] language: The language of the program
+        :param pulumi.Input[_builtins.str] name: The name of the package, without version
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] program: The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        :param pulumi.Input[_builtins.str] sysroot: The directory with the files of the package, copied as its sysroot
+        :param pulumi.Input[_builtins.str] version: The version of the package
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: PackageArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A local ops package built from a sysroot directory, to be used by a PackageImage
+
+        :param str resource_name: The name of the resource.
+        :param PackageArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(PackageArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 args: Optional[pulumi.Input[Sequence[pulumi.Input[_builtins.str]]]] = None,
+                 description: Optional[pulumi.Input[_builtins.str]] = None,
+                 env: Optional[pulumi.Input[Mapping[str, pulumi.Input[_builtins.str]]]] = None,
+                 language: Optional[pulumi.Input[_builtins.str]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 program: Optional[pulumi.Input[_builtins.str]] = None,
+                 sysroot: Optional[pulumi.Input[_builtins.str]] = None,
+                 version: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = PackageArgs.__new__(PackageArgs)
+
+            __props__.__dict__["architecture"] = architecture
+            __props__.__dict__["args"] = args
+            __props__.__dict__["description"] = description
+            __props__.__dict__["env"] = env
+            __props__.__dict__["language"] = language
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["ops_home"] = ops_home
+            if program is None and not opts.urn:
+                raise TypeError("Missing required property 'program'")
+            __props__.__dict__["program"] = program
+            if sysroot is None and not opts.urn:
+                raise TypeError("Missing required property 'sysroot'")
+            __props__.__dict__["sysroot"] = sysroot
+            if version is None and not opts.urn:
+                raise TypeError("Missing required property 'version'")
+            __props__.__dict__["version"] = version
+            __props__.__dict__["archive_path"] = None
+            __props__.__dict__["content_hash"] = None
+            __props__.__dict__["package_name"] = None
+            __props__.__dict__["package_path"] = None
+        super(Package, __self__).__init__(
+            'nanovms:index:Package',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Package':
+        """
+        Get an existing Package resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = PackageArgs.__new__(PackageArgs)
+
+        __props__.__dict__["architecture"] = None
+        __props__.__dict__["archive_path"] = None
+        __props__.__dict__["args"] = None
+        __props__.__dict__["content_hash"] = None
+        __props__.__dict__["description"] = None
+        __props__.__dict__["env"] = None
+        __props__.__dict__["language"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["ops_home"] = None
+        __props__.__dict__["package_name"] = None
+        __props__.__dict__["package_path"] = None
+        __props__.__dict__["program"] = None
+        __props__.__dict__["sysroot"] = None
+        __props__.__dict__["version"] = None
+        return Package(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The architecture of the package (amd64 or arm64). Defaults to the architecture of the provider configuration or the current system architecture
+        """
+        return pulumi.get(self, "architecture")
+
+    @_builtins.property
+    @pulumi.getter(name="archivePath")
+    def archive_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path of the .tar.gz archive of the package
+        """
+        return pulumi.get(self, "archive_path")
+
+    @_builtins.property
+    @pulumi.getter
+    def args(self) -> pulumi.Output[Optional[Sequence[_builtins.str]]]:
+        """
+        The arguments to start the program with, after the program name
+        """
+        return pulumi.get(self, "args")
+
+    @_builtins.property
+    @pulumi.getter(name="contentHash")
+    def content_hash(self) -> pulumi.Output[_builtins.str]:
+        """
+        The digest of the manifest and all files in the sysroot of the package
+        """
+        return pulumi.get(self, "content_hash")
+
+    @_builtins.property
+    @pulumi.getter
+    def description(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A description of the package
+        """
+        return pulumi.get(self, "description")
+
+    @_builtins.property
+    @pulumi.getter
+    def env(self) -> pulumi.Output[Optional[Mapping[str, _builtins.str]]]:
+        """
+        The environment variables of the program
+        """
+        return pulumi.get(self, "env")
+
+    @_builtins.property
+    @pulumi.getter
+    def language(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The language of the program
+        """
+        return pulumi.get(self, "language")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the package, without version
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @_builtins.property
+    @pulumi.getter(name="packageName")
+    def package_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The qualified name of the package (name_version), to use as packageName of a PackageImage with localPackage set
+        """
+        return pulumi.get(self, "package_name")
+
+    @_builtins.property
+    @pulumi.getter(name="packagePath")
+    def package_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The directory of the package in the local packages of the ops home
+        """
+        return pulumi.get(self, "package_path")
+
+    @_builtins.property
+    @pulumi.getter
+    def program(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path of the executable inside the sysroot (e.g. '/usr/bin/app')
+        """
+        return pulumi.get(self, "program")
+
+    @_builtins.property
+    @pulumi.getter
+    def sysroot(self) -> pulumi.Output[_builtins.str]:
+        """
+        The directory with the files of the package, copied as its sysroot
+        """
+        return pulumi.get(self, "sysroot")
+
+    @_builtins.property
+    @pulumi.getter
+    def version(self) -> pulumi.Output[_builtins.str]:
+        """
+        The version of the package
+        """
+        return pulumi.get(self, "version")
+
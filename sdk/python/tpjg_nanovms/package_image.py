@@ -0,0 +1,483 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['PackageImageArgs', 'PackageImage']
+
+@pulumi.input_type
+class PackageImageArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[_builtins.str],
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 container_image: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 local_package: Optional[pulumi.Input[_builtins.bool]] = None,
+                 ops_config: Optional[pulumi.Input['OpsConfigArgs']] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None):
+        """
+        The set of arguments for constructing a PackageImage resource.
+        :param pulumi.Input[_builtins.str] name: The name of the image
+        :param pulumi.Input[_builtins.str] architecture: The target architecture (amd64 or arm64). If not specified, uses the current system architecture
+        :param pulumi.Input[_builtins.str] config: The configuration as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.str] container_image: The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+        :param pulumi.Input[_builtins.bool] force: If an already existing image should be deleted if it exists
+        :param pulumi.Input[_builtins.str] kernel_version: The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        :param pulumi.Input[_builtins.bool] local_package: If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+        :param pulumi.Input['OpsConfigArgs'] ops_config: The configuration of the image
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] package_name: The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+        :param pulumi.Input[_builtins.str] package_path: The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.bool] use_latest_kernel: If the latest kernel should be used, download it if necessary
+        """
+        pulumi.set(__self__, "name", name)
+        if architecture is not None:
+            pulumi.set(__self__, "architecture", architecture)
+        if config is not None:
+            warnings.warn("""use opsConfig instead""", DeprecationWarning)
+            pulumi.log.warn("""config is deprecated: use opsConfig instead""")
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if container_image is not None:
+            pulumi.set(__self__, "container_image", container_image)
+        if force is not None:
+            pulumi.set(__self__, "force", force)
+        if kernel_version is not None:
+            pulumi.set(__self__, "kernel_version", kernel_version)
+        if local_package is not None:
+            pulumi.set(__self__, "local_package", local_package)
+        if ops_config is not None:
+            pulumi.set(__self__, "ops_config", ops_config)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+        if package_name is not None:
+            pulumi.set(__self__, "package_name", package_name)
+        if package_path is not None:
+            pulumi.set(__self__, "package_path", package_path)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if use_latest_kernel is not None:
+            pulumi.set(__self__, "use_latest_kernel", use_latest_kernel)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name of the image
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The target architecture (amd64 or arm64). If not specified, uses the current system architecture
+        """
+        return pulumi.get(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "architecture", value)
+
+    @_builtins.property
+    @pulumi.getter
+    @_utilities.deprecated("""use opsConfig instead""")
+    def config(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The configuration as a JSON encoded string, merged on top of opsConfig
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="containerImage")
+    def container_image(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+        """
+        return pulumi.get(self, "container_image")
+
+    @container_image.setter
+    def container_image(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "container_image", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def force(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If an already existing image should be deleted if it exists
+        """
+        return pulumi.get(self, "force")
+
+    @force.setter
+    def force(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "force", value)
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @kernel_version.setter
+    def kernel_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kernel_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="localPackage")
+    def local_package(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+        """
+        return pulumi.get(self, "local_package")
+
+    @local_package.setter
+    def local_package(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "local_package", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsConfig")
+    def ops_config(self) -> Optional[pulumi.Input['OpsConfigArgs']]:
+        """
+        The configuration of the image
+        """
+        return pulumi.get(self, "ops_config")
+
+    @ops_config.setter
+    def ops_config(self, value: Optional[pulumi.Input['OpsConfigArgs']]):
+        pulumi.set(self, "ops_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+    @_builtins.property
+    @pulumi.getter(name="packageName")
+    def package_name(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+        """
+        return pulumi.get(self, "package_name")
+
+    @package_name.setter
+    def package_name(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "package_name", value)
+
+    @_builtins.property
+    @pulumi.getter(name="packagePath")
+    def package_path(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
+        """
+        return pulumi.get(self, "package_path")
+
+    @package_path.setter
+    def package_path(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "package_path", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "provider", value)
+
+    @_builtins.property
+    @pulumi.getter(name="useLatestKernel")
+    def use_latest_kernel(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If the latest kernel should be used, download it if necessary
+        """
+        return pulumi.get(self, "use_latest_kernel")
+
+    @use_latest_kernel.setter
+    def use_latest_kernel(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "use_latest_kernel", value)
+
+
+@pulumi.type_token("nanovms:index:PackageImage")
+class PackageImage(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 container_image: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 local_package: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None,
+                 __props__=None):
+        """
+        A NanoVMs package image resource for building unikernel images from packages
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] architecture: The target architecture (amd64 or arm64). If not specified, uses the current system architecture
+        :param pulumi.Input[_builtins.str] config: The configuration as a JSON encoded string, merged on top of opsConfig
+        :param pulumi.Input[_builtins.str] container_image: The path to an OCI image layout or a 'docker save' archive, as a directory or (gzip compressed) tarball, used instead of packageName. The layers are flattened into a local package and the program, arguments and environment are taken from the entrypoint, cmd and env of the image
+        :param pulumi.Input[_builtins.bool] force: If an already existing image should be deleted if it exists
+        :param pulumi.Input[_builtins.str] kernel_version: The nanos kernel version to use (e.g. '0.1.54'), downloaded if necessary. Defaults to the kernelVersion of the provider configuration
+        :param pulumi.Input[_builtins.bool] local_package: If packageName refers to a local package in the local_packages directory of the ops home, as created by 'ops pkg load' or 'ops pkg from-docker'
+        :param pulumi.Input[_builtins.str] name: The name of the image
+        :param pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']] ops_config: The configuration of the image
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] package_name: The name of the package to use (e.g., 'node_v18.7.0'). Required unless packagePath or containerImage is set
+        :param pulumi.Input[_builtins.str] package_path: The path to a package directory containing a package.manifest and a sysroot, used instead of packageName
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.bool] use_latest_kernel: If the latest kernel should be used, download it if necessary
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: PackageImageArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A NanoVMs package image resource for building unikernel images from packages
+
+        :param str resource_name: The name of the resource.
+        :param PackageImageArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(PackageImageArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 container_image: Optional[pulumi.Input[_builtins.str]] = None,
+                 force: Optional[pulumi.Input[_builtins.bool]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 local_package: Optional[pulumi.Input[_builtins.bool]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_name: Optional[pulumi.Input[_builtins.str]] = None,
+                 package_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 use_latest_kernel: Optional[pulumi.Input[_builtins.bool]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = PackageImageArgs.__new__(PackageImageArgs)
+
+            __props__.__dict__["architecture"] = architecture
+            __props__.__dict__["config"] = config
+            __props__.__dict__["container_image"] = container_image
+            __props__.__dict__["force"] = force
+            __props__.__dict__["kernel_version"] = kernel_version
+            __props__.__dict__["local_package"] = local_package
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["ops_config"] = ops_config
+            __props__.__dict__["ops_home"] = ops_home
+            __props__.__dict__["package_name"] = package_name
+            __props__.__dict__["package_path"] = package_path
+            __props__.__dict__["provider"] = provider
+            __props__.__dict__["use_latest_kernel"] = use_latest_kernel
+            __props__.__dict__["container_digest"] = None
+            __props__.__dict__["image_name"] = None
+            __props__.__dict__["image_path"] = None
+            __props__.__dict__["manifest_digest"] = None
+        super(PackageImage, __self__).__init__(
+            'nanovms:index:PackageImage',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'PackageImage':
+        """
+        Get an existing PackageImage resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = PackageImageArgs.__new__(PackageImageArgs)
+
+        __props__.__dict__["architecture"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["container_digest"] = None
+        __props__.__dict__["container_image"] = None
+        __props__.__dict__["image_name"] = None
+        __props__.__dict__["image_path"] = None
+        __props__.__dict__["kernel_version"] = None
+        __props__.__dict__["local_package"] = None
+        __props__.__dict__["manifest_digest"] = None
+        __props__.__dict__["package_name"] = None
+        __props__.__dict__["package_path"] = None
+        __props__.__dict__["provider"] = None
+        __props__.__dict__["use_latest_kernel"] = None
+        return PackageImage(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> pulumi.Output[_builtins.str]:
+        """
+        The target architecture of the built image
+        """
+        return pulumi.get(self, "architecture")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[_builtins.str]:
+        """
+        The configuration of the built image as a JSON encoded string
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter(name="containerDigest")
+    def container_digest(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The digest of the configuration of the container image used
+        """
+        return pulumi.get(self, "container_digest")
+
+    @_builtins.property
+    @pulumi.getter(name="containerImage")
+    def container_image(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The path to the container image used
+        """
+        return pulumi.get(self, "container_image")
+
+    @_builtins.property
+    @pulumi.getter(name="imageName")
+    def image_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the built image
+        """
+        return pulumi.get(self, "image_name")
+
+    @_builtins.property
+    @pulumi.getter(name="imagePath")
+    def image_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path to the built image
+        """
+        return pulumi.get(self, "image_path")
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The nanos kernel version the image is built with
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @_builtins.property
+    @pulumi.getter(name="localPackage")
+    def local_package(self) -> pulumi.Output[Optional[_builtins.bool]]:
+        """
+        If the package is a local package
+        """
+        return pulumi.get(self, "local_package")
+
+    @_builtins.property
+    @pulumi.getter(name="manifestDigest")
+    def manifest_digest(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The digest of the package.manifest of the package
+        """
+        return pulumi.get(self, "manifest_digest")
+
+    @_builtins.property
+    @pulumi.getter(name="packageName")
+    def package_name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the package used
+        """
+        return pulumi.get(self, "package_name")
+
+    @_builtins.property
+    @pulumi.getter(name="packagePath")
+    def package_path(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The path to the package directory used
+        """
+        return pulumi.get(self, "package_path")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[_builtins.str]:
+        """
+        The cloud provider of the built image
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter(name="useLatestKernel")
+    def use_latest_kernel(self) -> pulumi.Output[_builtins.bool]:
+        """
+        If the latest kernel should be used, download it if necessary
+        """
+        return pulumi.get(self, "use_latest_kernel")
+
@@ -0,0 +1,265 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['ProviderArgs', 'Provider']
+
+@pulumi.input_type
+class ProviderArgs:
+    def __init__(__self__, *,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 cloud_config: Optional[pulumi.Input['OpsCloudConfigArgs']] = None,
+                 default_provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 mirror_dir: Optional[pulumi.Input[_builtins.str]] = None,
+                 offline: Optional[pulumi.Input[_builtins.bool]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a Provider resource.
+        :param pulumi.Input[_builtins.str] architecture: The default target architecture of package images (amd64 or arm64)
+        :param pulumi.Input['OpsCloudConfigArgs'] cloud_config: The default cloud provider settings, merged under the configuration of each resource
+        :param pulumi.Input[_builtins.str] default_provider: The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        :param pulumi.Input[_builtins.str] kernel_version: The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        :param pulumi.Input[_builtins.str] mirror_dir: A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        :param pulumi.Input[_builtins.bool] offline: If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        """
+        if architecture is not None:
+            pulumi.set(__self__, "architecture", architecture)
+        if cloud_config is not None:
+            pulumi.set(__self__, "cloud_config", cloud_config)
+        if default_provider is not None:
+            pulumi.set(__self__, "default_provider", default_provider)
+        if kernel_version is not None:
+            pulumi.set(__self__, "kernel_version", kernel_version)
+        if mirror_dir is None:
+            mirror_dir = (_utilities.get_env('NANOVMS_MIRROR_DIR') or '')
+        if mirror_dir is not None:
+            pulumi.set(__self__, "mirror_dir", mirror_dir)
+        if offline is None:
+            offline = (_utilities.get_env_bool('NANOVMS_OFFLINE') or False)
+        if offline is not None:
+            pulumi.set(__self__, "offline", offline)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The default target architecture of package images (amd64 or arm64)
+        """
+        return pulumi.get(self, "architecture")
+
+    @architecture.setter
+    def architecture(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "architecture", value)
+
+    @_builtins.property
+    @pulumi.getter(name="cloudConfig")
+    def cloud_config(self) -> Optional[pulumi.Input['OpsCloudConfigArgs']]:
+        """
+        The default cloud provider settings, merged under the configuration of each resource
+        """
+        return pulumi.get(self, "cloud_config")
+
+    @cloud_config.setter
+    def cloud_config(self, value: Optional[pulumi.Input['OpsCloudConfigArgs']]):
+        pulumi.set(self, "cloud_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="defaultProvider")
+    def default_provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        """
+        return pulumi.get(self, "default_provider")
+
+    @default_provider.setter
+    def default_provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "default_provider", value)
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @kernel_version.setter
+    def kernel_version(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "kernel_version", value)
+
+    @_builtins.property
+    @pulumi.getter(name="mirrorDir")
+    def mirror_dir(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        """
+        return pulumi.get(self, "mirror_dir")
+
+    @mirror_dir.setter
+    def mirror_dir(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "mirror_dir", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def offline(self) -> Optional[pulumi.Input[_builtins.bool]]:
+        """
+        If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        """
+        return pulumi.get(self, "offline")
+
+    @offline.setter
+    def offline(self, value: Optional[pulumi.Input[_builtins.bool]]):
+        pulumi.set(self, "offline", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+
+@pulumi.type_token("pulumi:providers:nanovms")
+class Provider(pulumi.ProviderResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 cloud_config: Optional[pulumi.Input[Union['OpsCloudConfigArgs', 'OpsCloudConfigArgsDict']]] = None,
+                 default_provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 mirror_dir: Optional[pulumi.Input[_builtins.str]] = None,
+                 offline: Optional[pulumi.Input[_builtins.bool]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Create a Nanovms resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] architecture: The default target architecture of package images (amd64 or arm64)
+        :param pulumi.Input[Union['OpsCloudConfigArgs', 'OpsCloudConfigArgsDict']] cloud_config: The default cloud provider settings, merged under the configuration of each resource
+        :param pulumi.Input[_builtins.str] default_provider: The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        :param pulumi.Input[_builtins.str] kernel_version: The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        :param pulumi.Input[_builtins.str] mirror_dir: A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        :param pulumi.Input[_builtins.bool] offline: If nothing should be downloaded, builds fail listing the kernels, common files and packages missing in the ops home and mirror directory
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[ProviderArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Create a Nanovms resource with the given unique name, props, and options.
+        :param str resource_name: The name of the resource.
+        :param ProviderArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(ProviderArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 architecture: Optional[pulumi.Input[_builtins.str]] = None,
+                 cloud_config: Optional[pulumi.Input[Union['OpsCloudConfigArgs', 'OpsCloudConfigArgsDict']]] = None,
+                 default_provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 kernel_version: Optional[pulumi.Input[_builtins.str]] = None,
+                 mirror_dir: Optional[pulumi.Input[_builtins.str]] = None,
+                 offline: Optional[pulumi.Input[_builtins.bool]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = ProviderArgs.__new__(ProviderArgs)
+
+            __props__.__dict__["architecture"] = architecture
+            __props__.__dict__["cloud_config"] = pulumi.Output.from_input(cloud_config).apply(pulumi.runtime.to_json) if cloud_config is not None else None
+            __props__.__dict__["default_provider"] = default_provider
+            __props__.__dict__["kernel_version"] = kernel_version
+            if mirror_dir is None:
+                mirror_dir = (_utilities.get_env('NANOVMS_MIRROR_DIR') or '')
+            __props__.__dict__["mirror_dir"] = mirror_dir
+            if offline is None:
+                offline = (_utilities.get_env_bool('NANOVMS_OFFLINE') or False)
+            __props__.__dict__["offline"] = pulumi.Output.from_input(offline).apply(pulumi.runtime.to_json) if offline is not None else None
+            __props__.__dict__["ops_home"] = ops_home
+        super(Provider, __self__).__init__(
+            'nanovms',
+            resource_name,
+            __props__,
+            opts)
+
+    @_builtins.property
+    @pulumi.getter
+    def architecture(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The default target architecture of package images (amd64 or arm64)
+        """
+        return pulumi.get(self, "architecture")
+
+    @_builtins.property
+    @pulumi.getter(name="defaultProvider")
+    def default_provider(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The cloud provider used by resources that do not specify one (e.g., onprem, gcp, aws)
+        """
+        return pulumi.get(self, "default_provider")
+
+    @_builtins.property
+    @pulumi.getter(name="kernelVersion")
+    def kernel_version(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The default nanos kernel version (e.g. '0.1.54'), downloaded if necessary
+        """
+        return pulumi.get(self, "kernel_version")
+
+    @_builtins.property
+    @pulumi.getter(name="mirrorDir")
+    def mirror_dir(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        A directory laid out like an ops home (.ops) that missing kernels, common files and packages are copied from instead of downloading them
+        """
+        return pulumi.get(self, "mirror_dir")
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> pulumi.Output[Optional[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) used for kernels, images, packages and onprem instances, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
@@ -0,0 +1,391 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['VolumeArgs', 'Volume']
+
+@pulumi.input_type
+class VolumeArgs:
+    def __init__(__self__, *,
+                 name: pulumi.Input[_builtins.str],
+                 data: Optional[pulumi.Input[_builtins.str]] = None,
+                 iops: Optional[pulumi.Input[_builtins.int]] = None,
+                 ops_config: Optional[pulumi.Input['OpsConfigArgs']] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 size: Optional[pulumi.Input[_builtins.str]] = None,
+                 throughput: Optional[pulumi.Input[_builtins.int]] = None,
+                 typeof: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a Volume resource.
+        :param pulumi.Input[_builtins.str] name: The name (label) of the volume
+        :param pulumi.Input[_builtins.str] data: The path to a local directory to fill the volume with, an empty volume is created if not set
+        :param pulumi.Input[_builtins.int] iops: The provisioned IOPS for the volume
+        :param pulumi.Input['OpsConfigArgs'] ops_config: The configuration, used for the cloud provider settings such as zone and bucket
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.str] size: The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it
+        :param pulumi.Input[_builtins.int] throughput: The provisioned throughput for the volume
+        :param pulumi.Input[_builtins.str] typeof: The provider specific volume type
+        """
+        pulumi.set(__self__, "name", name)
+        if data is not None:
+            pulumi.set(__self__, "data", data)
+        if iops is not None:
+            pulumi.set(__self__, "iops", iops)
+        if ops_config is not None:
+            pulumi.set(__self__, "ops_config", ops_config)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+        if size is not None:
+            pulumi.set(__self__, "size", size)
+        if throughput is not None:
+            pulumi.set(__self__, "throughput", throughput)
+        if typeof is not None:
+            pulumi.set(__self__, "typeof", typeof)
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name (label) of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "name", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def data(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The path to a local directory to fill the volume with, an empty volume is created if not set
+        """
+        return pulumi.get(self, "data")
+
+    @data.setter
+    def data(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "data", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The provisioned IOPS for the volume
+        """
+        return pulumi.get(self, "iops")
+
+    @iops.setter
+    def iops(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "iops", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsConfig")
+    def ops_config(self) -> Optional[pulumi.Input['OpsConfigArgs']]:
+        """
+        The configuration, used for the cloud provider settings such as zone and bucket
+        """
+        return pulumi.get(self, "ops_config")
+
+    @ops_config.setter
+    def ops_config(self, value: Optional[pulumi.Input['OpsConfigArgs']]):
+        pulumi.set(self, "ops_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "provider", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it
+        """
+        return pulumi.get(self, "size")
+
+    @size.setter
+    def size(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "size", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The provisioned throughput for the volume
+        """
+        return pulumi.get(self, "throughput")
+
+    @throughput.setter
+    def throughput(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "throughput", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def typeof(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The provider specific volume type
+        """
+        return pulumi.get(self, "typeof")
+
+    @typeof.setter
+    def typeof(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "typeof", value)
+
+
+@pulumi.type_token("nanovms:index:Volume")
+class Volume(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 data: Optional[pulumi.Input[_builtins.str]] = None,
+                 iops: Optional[pulumi.Input[_builtins.int]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 size: Optional[pulumi.Input[_builtins.str]] = None,
+                 throughput: Optional[pulumi.Input[_builtins.int]] = None,
+                 typeof: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        A NanoVMs volume resource for persistent data disks
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.str] data: The path to a local directory to fill the volume with, an empty volume is created if not set
+        :param pulumi.Input[_builtins.int] iops: The provisioned IOPS for the volume
+        :param pulumi.Input[_builtins.str] name: The name (label) of the volume
+        :param pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']] ops_config: The configuration, used for the cloud provider settings such as zone and bucket
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The target cloud provider (e.g., onprem, gcp, aws, azure, oracle, openstack, vsphere, upcloud, do, linode, vultr)
+        :param pulumi.Input[_builtins.str] size: The size of the volume (e.g. '100m', '1g'), defaults to the minimum size required for the data. Growing an onprem volume grows its disk in place, but not the filesystem on it
+        :param pulumi.Input[_builtins.int] throughput: The provisioned throughput for the volume
+        :param pulumi.Input[_builtins.str] typeof: The provider specific volume type
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: VolumeArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A NanoVMs volume resource for persistent data disks
+
+        :param str resource_name: The name of the resource.
+        :param VolumeArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(VolumeArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 data: Optional[pulumi.Input[_builtins.str]] = None,
+                 iops: Optional[pulumi.Input[_builtins.int]] = None,
+                 name: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 size: Optional[pulumi.Input[_builtins.str]] = None,
+                 throughput: Optional[pulumi.Input[_builtins.int]] = None,
+                 typeof: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = VolumeArgs.__new__(VolumeArgs)
+
+            __props__.__dict__["data"] = data
+            __props__.__dict__["iops"] = iops
+            if name is None and not opts.urn:
+                raise TypeError("Missing required property 'name'")
+            __props__.__dict__["name"] = name
+            __props__.__dict__["ops_config"] = ops_config
+            __props__.__dict__["ops_home"] = ops_home
+            __props__.__dict__["provider"] = provider
+            __props__.__dict__["size"] = size
+            __props__.__dict__["throughput"] = throughput
+            __props__.__dict__["typeof"] = typeof
+            __props__.__dict__["attached_to"] = None
+            __props__.__dict__["config"] = None
+            __props__.__dict__["path"] = None
+            __props__.__dict__["status"] = None
+            __props__.__dict__["volume_id"] = None
+        super(Volume, __self__).__init__(
+            'nanovms:index:Volume',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'Volume':
+        """
+        Get an existing Volume resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = VolumeArgs.__new__(VolumeArgs)
+
+        __props__.__dict__["attached_to"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["data"] = None
+        __props__.__dict__["iops"] = None
+        __props__.__dict__["name"] = None
+        __props__.__dict__["path"] = None
+        __props__.__dict__["provider"] = None
+        __props__.__dict__["size"] = None
+        __props__.__dict__["status"] = None
+        __props__.__dict__["throughput"] = None
+        __props__.__dict__["typeof"] = None
+        __props__.__dict__["volume_id"] = None
+        return Volume(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="attachedTo")
+    def attached_to(self) -> pulumi.Output[_builtins.str]:
+        """
+        The instance the volume is attached to
+        """
+        return pulumi.get(self, "attached_to")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[_builtins.str]:
+        """
+        The configuration used to create the volume as a JSON encoded string
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter
+    def data(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path to the local directory the volume was filled with
+        """
+        return pulumi.get(self, "data")
+
+    @_builtins.property
+    @pulumi.getter
+    def iops(self) -> pulumi.Output[_builtins.int]:
+        """
+        The provisioned IOPS for the volume
+        """
+        return pulumi.get(self, "iops")
+
+    @_builtins.property
+    @pulumi.getter
+    def name(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name (label) of the volume
+        """
+        return pulumi.get(self, "name")
+
+    @_builtins.property
+    @pulumi.getter
+    def path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The local path of the volume (onprem)
+        """
+        return pulumi.get(self, "path")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[_builtins.str]:
+        """
+        The cloud provider of the volume
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter
+    def size(self) -> pulumi.Output[_builtins.str]:
+        """
+        The size of the volume
+        """
+        return pulumi.get(self, "size")
+
+    @_builtins.property
+    @pulumi.getter
+    def status(self) -> pulumi.Output[_builtins.str]:
+        """
+        The status of the volume
+        """
+        return pulumi.get(self, "status")
+
+    @_builtins.property
+    @pulumi.getter
+    def throughput(self) -> pulumi.Output[_builtins.int]:
+        """
+        The provisioned throughput for the volume
+        """
+        return pulumi.get(self, "throughput")
+
+    @_builtins.property
+    @pulumi.getter
+    def typeof(self) -> pulumi.Output[_builtins.str]:
+        """
+        The provider specific volume type
+        """
+        return pulumi.get(self, "typeof")
+
+    @_builtins.property
+    @pulumi.getter(name="volumeID")
+    def volume_id(self) -> pulumi.Output[_builtins.str]:
+        """
+        The provider ID of the volume (the UUID for onprem volumes)
+        """
+        return pulumi.get(self, "volume_id")
+
@@ -0,0 +1,314 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import builtins as _builtins
+import warnings
+import sys
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+if sys.version_info >= (3, 11):
+    from typing import NotRequired, TypedDict, TypeAlias
+else:
+    from typing_extensions import NotRequired, TypedDict, TypeAlias
+from . import _utilities
+from ._inputs import *
+
+__all__ = ['VolumeAttachmentArgs', 'VolumeAttachment']
+
+@pulumi.input_type
+class VolumeAttachmentArgs:
+    def __init__(__self__, *,
+                 instance: pulumi.Input[_builtins.str],
+                 mount_path: pulumi.Input[_builtins.str],
+                 volume: pulumi.Input[_builtins.str],
+                 attach_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input['OpsConfigArgs']] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None):
+        """
+        The set of arguments for constructing a VolumeAttachment resource.
+        :param pulumi.Input[_builtins.str] instance: The ID (name) of the instance to attach the volume to
+        :param pulumi.Input[_builtins.str] mount_path: The path the volume is mounted at in the instance, must match the mounts of the image
+        :param pulumi.Input[_builtins.str] volume: The name of the volume to attach
+        :param pulumi.Input[_builtins.int] attach_id: The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+        :param pulumi.Input[_builtins.str] config: The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+        :param pulumi.Input['OpsConfigArgs'] ops_config: The configuration, used for the cloud provider settings such as zone
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The cloud provider of the instance and volume
+        """
+        pulumi.set(__self__, "instance", instance)
+        pulumi.set(__self__, "mount_path", mount_path)
+        pulumi.set(__self__, "volume", volume)
+        if attach_id is not None:
+            pulumi.set(__self__, "attach_id", attach_id)
+        if config is not None:
+            pulumi.set(__self__, "config", config)
+        if ops_config is not None:
+            pulumi.set(__self__, "ops_config", ops_config)
+        if ops_home is not None:
+            pulumi.set(__self__, "ops_home", ops_home)
+        if provider is not None:
+            pulumi.set(__self__, "provider", provider)
+
+    @_builtins.property
+    @pulumi.getter
+    def instance(self) -> pulumi.Input[_builtins.str]:
+        """
+        The ID (name) of the instance to attach the volume to
+        """
+        return pulumi.get(self, "instance")
+
+    @instance.setter
+    def instance(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "instance", value)
+
+    @_builtins.property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> pulumi.Input[_builtins.str]:
+        """
+        The path the volume is mounted at in the instance, must match the mounts of the image
+        """
+        return pulumi.get(self, "mount_path")
+
+    @mount_path.setter
+    def mount_path(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "mount_path", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def volume(self) -> pulumi.Input[_builtins.str]:
+        """
+        The name of the volume to attach
+        """
+        return pulumi.get(self, "volume")
+
+    @volume.setter
+    def volume(self, value: pulumi.Input[_builtins.str]):
+        pulumi.set(self, "volume", value)
+
+    @_builtins.property
+    @pulumi.getter(name="attachID")
+    def attach_id(self) -> Optional[pulumi.Input[_builtins.int]]:
+        """
+        The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+        """
+        return pulumi.get(self, "attach_id")
+
+    @attach_id.setter
+    def attach_id(self, value: Optional[pulumi.Input[_builtins.int]]):
+        pulumi.set(self, "attach_id", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+        """
+        return pulumi.get(self, "config")
+
+    @config.setter
+    def config(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsConfig")
+    def ops_config(self) -> Optional[pulumi.Input['OpsConfigArgs']]:
+        """
+        The configuration, used for the cloud provider settings such as zone
+        """
+        return pulumi.get(self, "ops_config")
+
+    @ops_config.setter
+    def ops_config(self, value: Optional[pulumi.Input['OpsConfigArgs']]):
+        pulumi.set(self, "ops_config", value)
+
+    @_builtins.property
+    @pulumi.getter(name="opsHome")
+    def ops_home(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        """
+        return pulumi.get(self, "ops_home")
+
+    @ops_home.setter
+    def ops_home(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "ops_home", value)
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> Optional[pulumi.Input[_builtins.str]]:
+        """
+        The cloud provider of the instance and volume
+        """
+        return pulumi.get(self, "provider")
+
+    @provider.setter
+    def provider(self, value: Optional[pulumi.Input[_builtins.str]]):
+        pulumi.set(self, "provider", value)
+
+
+@pulumi.type_token("nanovms:index:VolumeAttachment")
+class VolumeAttachment(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 instance: Optional[pulumi.Input[_builtins.str]] = None,
+                 mount_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 volume: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        """
+        Attaches a NanoVMs volume to a running instance
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[_builtins.int] attach_id: The persistent disk ID to attach the volume as (e.g. 0 for 'persistent-disk-0')
+        :param pulumi.Input[_builtins.str] config: The configuration of the image the instance runs as a JSON encoded string (the config output of the image), merged on top of opsConfig and used to check the mountPath
+        :param pulumi.Input[_builtins.str] instance: The ID (name) of the instance to attach the volume to
+        :param pulumi.Input[_builtins.str] mount_path: The path the volume is mounted at in the instance, must match the mounts of the image
+        :param pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']] ops_config: The configuration, used for the cloud provider settings such as zone
+        :param pulumi.Input[_builtins.str] ops_home: The directory containing the ops home (.ops) to use instead of the one of the provider configuration, like OPS_HOME
+        :param pulumi.Input[_builtins.str] provider: The cloud provider of the instance and volume
+        :param pulumi.Input[_builtins.str] volume: The name of the volume to attach
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: VolumeAttachmentArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Attaches a NanoVMs volume to a running instance
+
+        :param str resource_name: The name of the resource.
+        :param VolumeAttachmentArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(VolumeAttachmentArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 attach_id: Optional[pulumi.Input[_builtins.int]] = None,
+                 config: Optional[pulumi.Input[_builtins.str]] = None,
+                 instance: Optional[pulumi.Input[_builtins.str]] = None,
+                 mount_path: Optional[pulumi.Input[_builtins.str]] = None,
+                 ops_config: Optional[pulumi.Input[Union['OpsConfigArgs', 'OpsConfigArgsDict']]] = None,
+                 ops_home: Optional[pulumi.Input[_builtins.str]] = None,
+                 provider: Optional[pulumi.Input[_builtins.str]] = None,
+                 volume: Optional[pulumi.Input[_builtins.str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = VolumeAttachmentArgs.__new__(VolumeAttachmentArgs)
+
+            __props__.__dict__["attach_id"] = attach_id
+            __props__.__dict__["config"] = config
+            if instance is None and not opts.urn:
+                raise TypeError("Missing required property 'instance'")
+            __props__.__dict__["instance"] = instance
+            if mount_path is None and not opts.urn:
+                raise TypeError("Missing required property 'mount_path'")
+            __props__.__dict__["mount_path"] = mount_path
+            __props__.__dict__["ops_config"] = ops_config
+            __props__.__dict__["ops_home"] = ops_home
+            __props__.__dict__["provider"] = provider
+            if volume is None and not opts.urn:
+                raise TypeError("Missing required property 'volume'")
+            __props__.__dict__["volume"] = volume
+        super(VolumeAttachment, __self__).__init__(
+            'nanovms:index:VolumeAttachment',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'VolumeAttachment':
+        """
+        Get an existing VolumeAttachment resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = VolumeAttachmentArgs.__new__(VolumeAttachmentArgs)
+
+        __props__.__dict__["attach_id"] = None
+        __props__.__dict__["config"] = None
+        __props__.__dict__["instance"] = None
+        __props__.__dict__["mount_path"] = None
+        __props__.__dict__["provider"] = None
+        __props__.__dict__["volume"] = None
+        return VolumeAttachment(resource_name, opts=opts, __props__=__props__)
+
+    @_builtins.property
+    @pulumi.getter(name="attachID")
+    def attach_id(self) -> pulumi.Output[Optional[_builtins.int]]:
+        """
+        The persistent disk ID the volume is attached as
+        """
+        return pulumi.get(self, "attach_id")
+
+    @_builtins.property
+    @pulumi.getter
+    def config(self) -> pulumi.Output[_builtins.str]:
+        """
+        The configuration used to attach the volume as a JSON encoded string
+        """
+        return pulumi.get(self, "config")
+
+    @_builtins.property
+    @pulumi.getter
+    def instance(self) -> pulumi.Output[_builtins.str]:
+        """
+        The ID (name) of the instance the volume is attached to
+        """
+        return pulumi.get(self, "instance")
+
+    @_builtins.property
+    @pulumi.getter(name="mountPath")
+    def mount_path(self) -> pulumi.Output[_builtins.str]:
+        """
+        The path the volume is mounted at in the instance
+        """
+        return pulumi.get(self, "mount_path")
+
+    @_builtins.property
+    @pulumi.getter
+    def provider(self) -> pulumi.Output[_builtins.str]:
+        """
+        The cloud provider of the instance and volume
+        """
+        return pulumi.get(self, "provider")
+
+    @_builtins.property
+    @pulumi.getter
+    def volume(self) -> pulumi.Output[_builtins.str]:
+        """
+        The name of the attached volume
+        """
+        return pulumi.get(self, "volume")
+